@@ -1,10 +1,18 @@
 package main
 
 import (
+	"bufio"
 	"context"
+	"crypto/rand"
 	"database/sql"
+	"encoding/hex"
+	"flag"
 	"fmt"
+	"io"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	echo "github.com/labstack/echo/v4"
@@ -14,10 +22,13 @@ import (
 	echo_recovery "github.com/takuoki/golib/middleware/http/echo/recovery"
 	echo_requestlog "github.com/takuoki/golib/middleware/http/echo/requestlog"
 	"github.com/takuoki/golib/recovery"
+	"github.com/takuoki/google-calendar-sync/api/domain/entity"
 	"github.com/takuoki/google-calendar-sync/api/domain/service"
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
 	echohandler "github.com/takuoki/google-calendar-sync/api/handler/echo"
 	"github.com/takuoki/google-calendar-sync/api/openapi"
 	"github.com/takuoki/google-calendar-sync/api/repository"
+	"github.com/takuoki/google-calendar-sync/api/repository/cloudkms"
 	"github.com/takuoki/google-calendar-sync/api/repository/cloudsql"
 	"github.com/takuoki/google-calendar-sync/api/repository/googlecalendar"
 	"github.com/takuoki/google-calendar-sync/api/repository/mysql"
@@ -27,13 +38,28 @@ import (
 func main() {
 	ctx := context.Background()
 
+	// サブコマンドの標準出力はエクスポート結果の出力先にもなるため、ログは標準エラー出力に書き込む
+	logOutput := os.Stdout
+	if len(os.Args) > 1 {
+		logOutput = os.Stderr
+	}
+
 	logger := applog.NewBasicLogger(
-		os.Stdout,
+		logOutput,
 		applog.LevelOption(
 			applog.ParseLevelWithDefault(os.Getenv("LOG_LEVEL"), applog.InfoLevel),
 		),
 	)
 
+	// サブコマンドが指定された場合は、API サーバーを起動せずにコマンドを実行する
+	if len(os.Args) > 1 {
+		if code, err := runCommand(ctx, os.Args[1], os.Args[2:], logger); err != nil {
+			logger.Criticalf(ctx, "fail to run command: %v", err)
+			os.Exit(code)
+		}
+		return
+	}
+
 	if code, err := run(ctx, logger); err != nil {
 		logger.Criticalf(ctx, "fail to run: %v", err)
 		os.Exit(code)
@@ -64,8 +90,12 @@ func run(ctx context.Context, logger applog.Logger) (exitCode int, er error) {
 
 	e := echo.New()
 	e.HideBanner = true
-	e.Pre(middleware.AddTrailingSlash())
+	e.Pre(middleware.AddTrailingSlashWithConfig(middleware.TrailingSlashConfig{
+		Skipper: echohandler.SkipTrailingSlash,
+	}))
 
+	// フィードのトークンをリクエストログに出力しない
+	e.Use(echohandler.RedactQueryMiddleware("token"))
 	e.Use(echo_requestlog.Middleware(logger))
 	e.Use(echohandler.ErrorMiddleware(logger))
 	e.Use(echo_recovery.Middleware(echo_recovery.RecoveryFunc(recovery.Recovery)))
@@ -87,6 +117,158 @@ func run(ctx context.Context, logger applog.Logger) (exitCode int, er error) {
 	return 0, nil
 }
 
+// commands are the subcommands that run instead of the API server.
+var commands = []string{"reencrypt", "export"}
+
+func runCommand(ctx context.Context, command string, args []string, logger applog.Logger) (exitCode int, er error) {
+
+	// 不明なコマンドは、データベースに接続する前に拒否する
+	if !slices.Contains(commands, command) {
+		return 3, fmt.Errorf("unknown command: %q (available commands: %s)", command, strings.Join(commands, ", "))
+	}
+
+	db, err := connectDB()
+	if err != nil {
+		return 1, fmt.Errorf("fail to connect db: %w", err)
+	}
+
+	defer func() {
+		if err := db.Close(); err != nil {
+			logger.Criticalf(ctx, "fail to close db: %v", err)
+		}
+	}()
+
+	if err := waitForDatabaseReady(ctx, db); err != nil {
+		return 2, fmt.Errorf("fail to wait for db ready: %w", err)
+	}
+
+	switch command {
+	case "reencrypt":
+		// 鍵のローテーション後に、全てのリフレッシュトークンを新しい鍵で暗号化し直す
+		cryptService, err := newCryptService(ctx)
+		if err != nil {
+			return 3, fmt.Errorf("fail to create crypt service: %w", err)
+		}
+
+		clockService, err := service.NewSystemClock("UTC")
+		if err != nil {
+			return 3, fmt.Errorf("fail to create clock service: %w", err)
+		}
+
+		mysqlRepo := mysql.NewMysqlRepository(db, clockService, cryptService, logger)
+		maintenanceUsecase := usecase.NewMaintenanceUsecase(mysqlRepo, logger)
+
+		if _, err := maintenanceUsecase.ReencryptRefreshTokens(ctx); err != nil {
+			return 4, fmt.Errorf("fail to reencrypt refresh tokens: %w", err)
+		}
+
+	case "export":
+		// 分析用に、同期済みのイベントをファイルに書き出す
+		query, output, err := parseExportArgs(args)
+		if err != nil {
+			return 3, fmt.Errorf("fail to parse arguments: %w", err)
+		}
+
+		clockService, err := service.NewSystemClock("UTC")
+		if err != nil {
+			return 3, fmt.Errorf("fail to create clock service: %w", err)
+		}
+
+		mysqlRepo := mysql.NewMysqlRepository(db, clockService, nil, logger)
+		exportUsecase := usecase.NewExportUsecase(mysqlRepo, logger)
+
+		if err := export(ctx, exportUsecase, query, output); err != nil {
+			return 4, fmt.Errorf("fail to export: %w", err)
+		}
+
+	default:
+		return 3, fmt.Errorf("unknown command: %q", command)
+	}
+
+	return 0, nil
+}
+
+// parseExportArgs parses the arguments of the export command, and returns the query and the output file path.
+func parseExportArgs(args []string) (entity.ExportQuery, string, error) {
+
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	target := fs.String("target", string(entity.ExportTargetEvents), "events or recurring_events")
+	format := fs.String("format", string(entity.ExportFormatCSV), "csv or jsonl")
+	calendarID := fs.String("calendar", "", "calendar ID to export (all calendars if empty)")
+	from := fs.String("from", "", "export events ending after this time (RFC 3339)")
+	to := fs.String("to", "", "export events starting before this time (RFC 3339)")
+	includeCancelled := fs.Bool("include-cancelled", false, "include cancelled events")
+	columns := fs.String("columns", "", "comma-separated columns (all columns if empty)")
+	timeZone := fs.String("time-zone", "UTC", "time zone in which times are written")
+	output := fs.String("output", "-", `output file path ("-" for stdout)`)
+	if err := fs.Parse(args); err != nil {
+		return entity.ExportQuery{}, "", err
+	}
+
+	query := entity.ExportQuery{
+		Target:  entity.ExportTarget(*target),
+		Format:  entity.ExportFormat(*format),
+		Filter:  entity.EventFilter{IncludeCancelled: *includeCancelled},
+		Columns: entity.ParseExportColumns(*columns),
+	}
+	if *calendarID != "" {
+		id := valueobject.CalendarID(*calendarID)
+		query.CalendarID = &id
+	}
+	var err error
+	if query.Filter.From, err = parseOptionalTime(*from); err != nil {
+		return entity.ExportQuery{}, "", fmt.Errorf("invalid from: %w", err)
+	}
+	if query.Filter.To, err = parseOptionalTime(*to); err != nil {
+		return entity.ExportQuery{}, "", fmt.Errorf("invalid to: %w", err)
+	}
+
+	query.Location, err = time.LoadLocation(*timeZone)
+	if err != nil {
+		return entity.ExportQuery{}, "", fmt.Errorf("invalid time zone: %w", err)
+	}
+
+	return query, *output, nil
+}
+
+// parseOptionalTime parses the time in RFC 3339, and returns nil if s is empty.
+func parseOptionalTime(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func export(ctx context.Context, exportUsecase usecase.ExportUsecase, query entity.ExportQuery, output string) (er error) {
+
+	var w io.Writer = os.Stdout
+	if output != "-" {
+		f, err := os.Create(output)
+		if err != nil {
+			return fmt.Errorf("fail to create output file: %w", err)
+		}
+		defer func() {
+			if err := f.Close(); err != nil && er == nil {
+				er = fmt.Errorf("fail to close output file: %w", err)
+			}
+		}()
+		w = f
+	}
+
+	bw := bufio.NewWriter(w)
+	if err := exportUsecase.Export(ctx, bw, query); err != nil {
+		return err
+	}
+
+	return bw.Flush()
+}
+
 func connectDB() (*sql.DB, error) {
 	switch os.Getenv("DB_TYPE") {
 	case "cloudsql":
@@ -140,14 +322,21 @@ func setupApplication(ctx context.Context, db *sql.DB, logger applog.Logger) (op
 	useOauth := oauthClientID != ""
 
 	// Service
-	clockService, err := service.NewSystemClock("Asia/Tokyo")
+	// Calendar-specific time zones take precedence when they are known
+	// The default is kept as Asia/Tokyo for the calendars registered before their time zones were stored
+	defaultTimeZone := os.Getenv("DEFAULT_TIME_ZONE")
+	if defaultTimeZone == "" {
+		defaultTimeZone = "Asia/Tokyo"
+	}
+
+	clockService, err := service.NewSystemClock(defaultTimeZone)
 	if err != nil {
 		return nil, fmt.Errorf("fail to create clock service: %w", err)
 	}
 
 	var cryptService service.Crypt
 	if useOauth {
-		cryptService, err = service.NewAESCrypt([]byte(os.Getenv("CRYPT_KEY")))
+		cryptService, err = newCryptService(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("fail to create crypt service: %w", err)
 		}
@@ -156,29 +345,168 @@ func setupApplication(ctx context.Context, db *sql.DB, logger applog.Logger) (op
 	// Repository
 	mysqlRepo := mysql.NewMysqlRepository(db, clockService, cryptService, logger)
 
-	var googleCalendarRepo repository.GoogleCalendarRepository
-	if !useOauth {
-		googleCalendarRepo, err = googlecalendar.NewGoogleCalendarRepository(
+	// 各カレンダーの認証方式に応じて、サービスアカウントと OAuth の実装を使い分ける
+	authTypes := []valueobject.AuthType{}
+
+	// OAuth を使用する場合、サービスアカウントは USE_SERVICE_ACCOUNT で明示的に有効化したときのみ使用する
+	// 認証情報の不備で意図せず無効化されないよう、有効な場合は作成に失敗したら起動しない
+	useServiceAccount := !useOauth
+	if v := os.Getenv("USE_SERVICE_ACCOUNT"); v != "" {
+		useServiceAccount, err = strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid USE_SERVICE_ACCOUNT: %w", err)
+		}
+	}
+
+	var serviceAccountRepo repository.GoogleCalendarRepository
+	if useServiceAccount {
+		serviceAccountRepo, err = googlecalendar.NewGoogleCalendarRepository(
 			ctx, os.Getenv("WEBHOOK_BASE_URL"), clockService, logger)
 		if err != nil {
 			return nil, fmt.Errorf("fail to create google calendar repository: %w", err)
 		}
-	} else {
-		googleCalendarRepo, err = googlecalendar.NewGoogleCalendarWithOauthRepository(
+		authTypes = append(authTypes, valueobject.AuthTypeServiceAccount)
+	}
+
+	var oauthGoogleCalendarRepo repository.GoogleCalendarRepository
+	var oauthRepo repository.OAuthRepository
+	if useOauth {
+		oauthGoogleCalendarRepo, err = googlecalendar.NewGoogleCalendarWithOauthRepository(
 			os.Getenv("WEBHOOK_BASE_URL"), oauthClientID, os.Getenv("OAUTH_CLIENT_SECRET"),
 			os.Getenv("OAUTH_REDIRECT_URL"), mysqlRepo, clockService, logger)
 		if err != nil {
 			return nil, fmt.Errorf("fail to create google calendar with oauth repository: %w", err)
 		}
+
+		oauthRepo, err = googlecalendar.NewOAuthRepository(
+			oauthClientID, os.Getenv("OAUTH_CLIENT_SECRET"), os.Getenv("OAUTH_REDIRECT_URL"))
+		if err != nil {
+			return nil, fmt.Errorf("fail to create oauth repository: %w", err)
+		}
+
+		authTypes = append(authTypes, valueobject.AuthTypeOAuth)
+	}
+
+	// ドメイン全体の委任では、ユーザーになりすますための署名にサービスアカウントの鍵を使用する
+	var delegationRepo repository.GoogleCalendarRepository
+	if delegationCredentialsFile := os.Getenv("DELEGATION_CREDENTIALS_FILE"); delegationCredentialsFile != "" {
+		credentialsJSON, err := os.ReadFile(delegationCredentialsFile)
+		if err != nil {
+			return nil, fmt.Errorf("fail to read delegation credentials file: %w", err)
+		}
+
+		delegationRepo, err = googlecalendar.NewGoogleCalendarWithDelegationRepository(
+			os.Getenv("WEBHOOK_BASE_URL"), credentialsJSON, mysqlRepo, clockService, logger)
+		if err != nil {
+			return nil, fmt.Errorf("fail to create google calendar with delegation repository: %w", err)
+		}
+
+		authTypes = append(authTypes, valueobject.AuthTypeDelegation)
+	}
+
+	googleCalendarRepo, err := googlecalendar.NewRoutingRepository(
+		serviceAccountRepo, oauthGoogleCalendarRepo, delegationRepo, mysqlRepo)
+	if err != nil {
+		return nil, fmt.Errorf("fail to create routing repository: %w", err)
 	}
 
 	// Usecase
-	calendarUsecase := usecase.NewCalendarUsecase(mysqlRepo, useOauth, logger)
 	syncUsecase := usecase.NewSyncUsecase(clockService, googleCalendarRepo, mysqlRepo, logger)
 	watchUsecase := usecase.NewWatchUsecase(googleCalendarRepo, mysqlRepo, logger)
+	calendarUsecase := usecase.NewCalendarUsecase(googleCalendarRepo, mysqlRepo, syncUsecase, watchUsecase, authTypes, logger)
+	leaseUsecase := usecase.NewLeaseUsecase(mysqlRepo, newInstanceID(), logger)
+	oauthUsecase := usecase.NewOAuthUsecase(oauthRepo, mysqlRepo, calendarUsecase, clockService, logger)
+	eventUsecase := usecase.NewEventUsecase(clockService, mysqlRepo, logger)
+	feedUsecase := usecase.NewFeedUsecase(clockService, mysqlRepo, logger)
+	exportUsecase := usecase.NewExportUsecase(mysqlRepo, logger)
 
 	// Handler
-	handler := echohandler.New(calendarUsecase, syncUsecase, watchUsecase, logger)
+	handler := echohandler.New(calendarUsecase, syncUsecase, watchUsecase, leaseUsecase, oauthUsecase, eventUsecase, feedUsecase, exportUsecase, logger)
 
 	return handler, nil
 }
+
+// newCryptService returns the crypt service selected by CRYPT_BACKEND.
+//   - "keyring" (default): encrypts with the raw keys of newKeyringCrypt.
+//   - "envelope": encrypts with a data key per record wrapped by the key manager selected by KEY_MANAGER.
+//     The keyring is used to decrypt the refresh tokens stored before switching to envelope encryption if it is configured.
+func newCryptService(ctx context.Context) (service.Crypt, error) {
+
+	switch backend := os.Getenv("CRYPT_BACKEND"); backend {
+	case "", "keyring":
+		return newKeyringCrypt()
+
+	case "envelope":
+		keyManager, err := newKeyManager(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("fail to create key manager: %w", err)
+		}
+
+		var fallback service.Crypt
+		if os.Getenv("CRYPT_KEY") != "" || os.Getenv("CRYPT_KEYS") != "" {
+			fallback, err = newKeyringCrypt()
+			if err != nil {
+				return nil, fmt.Errorf("fail to create keyring crypt: %w", err)
+			}
+		}
+
+		return service.NewEnvelopeCrypt(keyManager, fallback)
+
+	default:
+		return nil, fmt.Errorf("unknown crypt backend: %q", backend)
+	}
+}
+
+// newKeyManager returns the key manager selected by KEY_MANAGER.
+//   - "cloudkms": wraps data keys with the Cloud KMS key of CLOUD_KMS_KEY_NAME.
+//   - "file": wraps data keys with the key in KEY_MANAGER_FILE, for development and tests.
+func newKeyManager(ctx context.Context) (service.KeyManager, error) {
+
+	switch keyManager := os.Getenv("KEY_MANAGER"); keyManager {
+	case "cloudkms":
+		return cloudkms.NewKeyManager(ctx, os.Getenv("CLOUD_KMS_KEY_NAME"))
+	case "file":
+		return service.NewFileKeyManager(os.Getenv("KEY_MANAGER_FILE"))
+	default:
+		return nil, fmt.Errorf("unknown key manager: %q", keyManager)
+	}
+}
+
+// newKeyringCrypt returns the keyring of CRYPT_KEYS that encrypts with the key of CRYPT_ACTIVE_KEY_ID.
+// CRYPT_KEY is added as the legacy key to decrypt the refresh tokens encrypted before the keyring was introduced,
+// and is used for encryption if CRYPT_ACTIVE_KEY_ID is not set.
+func newKeyringCrypt() (service.Crypt, error) {
+
+	keys, err := service.ParseCryptKeys(os.Getenv("CRYPT_KEYS"))
+	if err != nil {
+		return nil, fmt.Errorf("fail to parse crypt keys: %w", err)
+	}
+
+	activeKeyID := os.Getenv("CRYPT_ACTIVE_KEY_ID")
+
+	if legacyKey := os.Getenv("CRYPT_KEY"); legacyKey != "" {
+		if _, ok := keys[service.LegacyKeyID]; ok {
+			return nil, fmt.Errorf("key id %q is reserved for CRYPT_KEY", service.LegacyKeyID)
+		}
+		keys[service.LegacyKeyID] = []byte(legacyKey)
+
+		if activeKeyID == "" {
+			activeKeyID = service.LegacyKeyID
+		}
+	}
+
+	return service.NewKeyringCrypt(keys, activeKeyID)
+}
+
+// newInstanceID returns an ID that identifies this instance as a lease holder.
+func newInstanceID() string {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "unknown"
+	}
+
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+
+	return hostname + "-" + hex.EncodeToString(b)
+}
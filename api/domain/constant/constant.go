@@ -1,3 +1,30 @@
 package constant
 
-const EventStatusCancelled = "cancelled"
+// Status of an event.
+const (
+	EventStatusConfirmed = "confirmed"
+	EventStatusTentative = "tentative"
+	EventStatusCancelled = "cancelled"
+)
+
+// Transparency of an event. Transparent events do not block time on the calendar.
+const (
+	EventTransparencyOpaque      = "opaque"
+	EventTransparencyTransparent = "transparent"
+)
+
+// Stages of the sync process, recorded when the sync fails.
+const (
+	// SyncStageDatabase is reading the database before the events are listed from Google Calendar.
+	SyncStageDatabase      = "database"
+	SyncStageListEvents    = "list_events"
+	SyncStageListInstances = "list_instances"
+	SyncStageTransaction   = "transaction"
+)
+
+// Lease names of the jobs that must not run on multiple instances at the same time.
+const (
+	JobSyncAll               = "sync-all"
+	JobSyncFutureInstanceAll = "sync-future-instance-all"
+	JobWatchAll              = "watch-all"
+)
@@ -0,0 +1,207 @@
+package entity
+
+import (
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+)
+
+const (
+	// AvailableSlotStep is the granularity of the start time of available slots.
+	AvailableSlotStep = 15 * time.Minute
+
+	// maxSlotMargin caps the margin used for ranking, so that slots with enough margin are ranked by start time.
+	maxSlotMargin = time.Hour
+)
+
+// WorkingHours is the daily period when a participant can attend, in the time zone of Location.
+type WorkingHours struct {
+	// Start and End are the offsets from the start of the day. End must be after Start, and at most 24 hours.
+	Start    time.Duration
+	End      time.Duration
+	Weekdays []time.Weekday
+	Location *time.Location
+}
+
+// AvailabilityParticipant is a calendar whose attendee must be free in the slots.
+type AvailabilityParticipant struct {
+	CalendarID valueobject.CalendarID
+	// Busy is the busy intervals merged by MergeBusyIntervals.
+	Busy []TimeInterval
+	// WorkingHours is nil if the attendee can attend at any time.
+	WorkingHours *WorkingHours
+}
+
+// AvailableSlot is a period when all participants are free.
+type AvailableSlot struct {
+	TimeInterval
+	// Margin is the free time shared by all participants before and after the slot, whichever is shorter.
+	Margin time.Duration
+}
+
+// FindAvailableSlots returns the slots of the duration in [from, to) when all participants are free within their working hours,
+// keeping the buffer from their busy intervals. Slots start at multiples of AvailableSlotStep.
+// Slots are ranked by the margin (capped at 1 hour) in descending order, and then by start time, and at most limit slots are returned.
+func FindAvailableSlots(participants []AvailabilityParticipant,
+	from, to time.Time, duration, buffer time.Duration, limit int) []AvailableSlot {
+
+	common := []TimeInterval{{Start: from, End: to}}
+	for _, participant := range participants {
+		free := []TimeInterval{{Start: from, End: to}}
+		if participant.WorkingHours != nil {
+			free = participant.WorkingHours.intervals(from, to)
+		}
+
+		// 予定の前後にバッファを確保するため、予定を広げてから除外する
+		busy := make([]TimeInterval, 0, len(participant.Busy))
+		for _, interval := range participant.Busy {
+			busy = append(busy, TimeInterval{Start: interval.Start.Add(-buffer), End: interval.End.Add(buffer)})
+		}
+
+		common = intersectIntervals(common, subtractIntervals(free, mergeIntervals(busy)))
+	}
+
+	slots := []AvailableSlot{}
+	for _, window := range common {
+		start := window.Start.Truncate(AvailableSlotStep)
+		if start.Before(window.Start) {
+			start = start.Add(AvailableSlotStep)
+		}
+
+		for ; !start.Add(duration).After(window.End); start = start.Add(AvailableSlotStep) {
+			end := start.Add(duration)
+			margin := min(start.Sub(window.Start), window.End.Sub(end), maxSlotMargin)
+			slots = append(slots, AvailableSlot{
+				TimeInterval: TimeInterval{Start: start, End: end},
+				Margin:       margin,
+			})
+		}
+	}
+
+	sort.SliceStable(slots, func(i, j int) bool {
+		if slots[i].Margin != slots[j].Margin {
+			return slots[i].Margin > slots[j].Margin
+		}
+		return slots[i].Start.Before(slots[j].Start)
+	})
+
+	if len(slots) > limit {
+		slots = slots[:limit]
+	}
+
+	return slots
+}
+
+// intervals returns the working periods within [from, to) in ascending order.
+func (w WorkingHours) intervals(from, to time.Time) []TimeInterval {
+
+	intervals := []TimeInterval{}
+
+	y, m, d := from.In(w.Location).Date()
+	for day := time.Date(y, m, d, 0, 0, 0, 0, w.Location); day.Before(to); day = day.AddDate(0, 0, 1) {
+		if !slices.Contains(w.Weekdays, day.Weekday()) {
+			continue
+		}
+
+		// 夏時間の切り替え日も壁時計の時刻で扱うため、Add ではなく time.Date で組み立てる
+		y, m, d := day.Date()
+		start := time.Date(y, m, d, 0, int(w.Start/time.Minute), 0, 0, w.Location)
+		end := time.Date(y, m, d, 0, int(w.End/time.Minute), 0, 0, w.Location)
+
+		if start.Before(from) {
+			start = from
+		}
+		if end.After(to) {
+			end = to
+		}
+		if start.Before(end) {
+			intervals = append(intervals, TimeInterval{Start: start, End: end})
+		}
+	}
+
+	return intervals
+}
+
+// mergeIntervals sorts the intervals and merges overlapping or adjacent ones.
+func mergeIntervals(intervals []TimeInterval) []TimeInterval {
+
+	sorted := slices.Clone(intervals)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Start.Before(sorted[j].Start)
+	})
+
+	merged := make([]TimeInterval, 0, len(sorted))
+	for _, interval := range sorted {
+		last := len(merged) - 1
+		if last >= 0 && !interval.Start.After(merged[last].End) {
+			if interval.End.After(merged[last].End) {
+				merged[last].End = interval.End
+			}
+			continue
+		}
+		merged = append(merged, interval)
+	}
+
+	return merged
+}
+
+// subtractIntervals removes the periods of b from a. Both must be sorted and must not overlap each other.
+func subtractIntervals(a, b []TimeInterval) []TimeInterval {
+
+	result := []TimeInterval{}
+	j := 0
+	for _, interval := range a {
+		start := interval.Start
+
+		// interval より前に終わる b は以降の interval にも影響しない
+		for j < len(b) && !b[j].End.After(start) {
+			j++
+		}
+
+		for k := j; k < len(b) && b[k].Start.Before(interval.End); k++ {
+			if b[k].Start.After(start) {
+				result = append(result, TimeInterval{Start: start, End: b[k].Start})
+			}
+			if b[k].End.After(start) {
+				start = b[k].End
+			}
+		}
+
+		if start.Before(interval.End) {
+			result = append(result, TimeInterval{Start: start, End: interval.End})
+		}
+	}
+
+	return result
+}
+
+// intersectIntervals returns the periods included in both a and b. Both must be sorted and must not overlap each other.
+func intersectIntervals(a, b []TimeInterval) []TimeInterval {
+
+	result := []TimeInterval{}
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		start := a[i].Start
+		if b[j].Start.After(start) {
+			start = b[j].Start
+		}
+		end := a[i].End
+		if b[j].End.Before(end) {
+			end = b[j].End
+		}
+
+		if start.Before(end) {
+			result = append(result, TimeInterval{Start: start, End: end})
+		}
+
+		if a[i].End.Before(b[j].End) {
+			i++
+		} else {
+			j++
+		}
+	}
+
+	return result
+}
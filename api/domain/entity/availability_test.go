@@ -0,0 +1,141 @@
+package entity_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/takuoki/google-calendar-sync/api/domain/entity"
+)
+
+func TestFindAvailableSlots(t *testing.T) {
+	t.Parallel()
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+
+	// 2025-01-06 は月曜日
+	base := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	at := func(hour, minute int) time.Time {
+		return base.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+	}
+	weekdays := []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+	tests := map[string]struct {
+		participants []entity.AvailabilityParticipant
+		from, to     time.Time
+		duration     time.Duration
+		buffer       time.Duration
+		limit        int
+		expected     []entity.AvailableSlot
+	}{
+		"common free time": {
+			participants: []entity.AvailabilityParticipant{
+				{Busy: []entity.TimeInterval{{Start: at(9, 0), End: at(10, 0)}}},
+				{Busy: []entity.TimeInterval{{Start: at(11, 0), End: at(12, 0)}}},
+			},
+			from: at(9, 0), to: at(12, 0), duration: time.Hour, limit: 10,
+			expected: []entity.AvailableSlot{
+				{TimeInterval: entity.TimeInterval{Start: at(10, 0), End: at(11, 0)}, Margin: 0},
+			},
+		},
+		"ranked by margin": {
+			participants: []entity.AvailabilityParticipant{
+				{Busy: []entity.TimeInterval{{Start: at(9, 0), End: at(10, 0)}, {Start: at(11, 0), End: at(12, 0)}}},
+			},
+			from: at(10, 0), to: at(11, 0), duration: 30 * time.Minute, limit: 10,
+			expected: []entity.AvailableSlot{
+				{TimeInterval: entity.TimeInterval{Start: at(10, 15), End: at(10, 45)}, Margin: 15 * time.Minute},
+				{TimeInterval: entity.TimeInterval{Start: at(10, 0), End: at(10, 30)}, Margin: 0},
+				{TimeInterval: entity.TimeInterval{Start: at(10, 30), End: at(11, 0)}, Margin: 0},
+			},
+		},
+		"buffer": {
+			participants: []entity.AvailabilityParticipant{
+				{Busy: []entity.TimeInterval{{Start: at(9, 0), End: at(10, 0)}, {Start: at(11, 30), End: at(12, 0)}}},
+			},
+			from: at(9, 0), to: at(12, 0), duration: time.Hour, buffer: 15 * time.Minute, limit: 10,
+			expected: []entity.AvailableSlot{
+				{TimeInterval: entity.TimeInterval{Start: at(10, 15), End: at(11, 15)}, Margin: 0},
+			},
+		},
+		"working hours in time zone": {
+			participants: []entity.AvailabilityParticipant{
+				{
+					// 09:00-10:00 JST = 00:00-01:00 UTC
+					WorkingHours: &entity.WorkingHours{Start: 9 * time.Hour, End: 10 * time.Hour, Weekdays: weekdays, Location: tokyo},
+				},
+				{
+					WorkingHours: &entity.WorkingHours{Start: 0, End: 24 * time.Hour, Weekdays: weekdays, Location: time.UTC},
+				},
+			},
+			from: at(-24, 0), to: at(24, 0), duration: time.Hour, limit: 10,
+			expected: []entity.AvailableSlot{
+				{TimeInterval: entity.TimeInterval{Start: at(0, 0), End: at(1, 0)}, Margin: 0},
+			},
+		},
+		"limit": {
+			participants: []entity.AvailabilityParticipant{{}},
+			from:         at(9, 0), to: at(10, 0), duration: 30 * time.Minute, limit: 1,
+			expected: []entity.AvailableSlot{
+				{TimeInterval: entity.TimeInterval{Start: at(9, 15), End: at(9, 45)}, Margin: 15 * time.Minute},
+			},
+		},
+		"no slot": {
+			participants: []entity.AvailabilityParticipant{
+				{Busy: []entity.TimeInterval{{Start: at(9, 0), End: at(9, 45)}}},
+			},
+			from: at(9, 0), to: at(10, 0), duration: 30 * time.Minute, limit: 10,
+			expected: []entity.AvailableSlot{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result := entity.FindAvailableSlots(tt.participants, tt.from, tt.to, tt.duration, tt.buffer, tt.limit)
+			if len(result) != len(tt.expected) {
+				t.Fatalf("FindAvailableSlots() = %+v, want %+v", result, tt.expected)
+			}
+			for i := range result {
+				if !result[i].Start.Equal(tt.expected[i].Start) || !result[i].End.Equal(tt.expected[i].End) ||
+					result[i].Margin != tt.expected[i].Margin {
+					t.Errorf("slot %d = %+v, want %+v", i, result[i], tt.expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestFindAvailableSlots_DaylightSavingTime(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+
+	// 2025-03-09 (日曜日) に夏時間が始まるため、翌日の 09:00 は 13:00 UTC になる
+	participants := []entity.AvailabilityParticipant{
+		{WorkingHours: &entity.WorkingHours{Start: 9 * time.Hour, End: 10 * time.Hour,
+			Weekdays: []time.Weekday{time.Monday}, Location: newYork}},
+	}
+	from := time.Date(2025, 3, 8, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 3, 11, 0, 0, 0, 0, time.UTC)
+
+	result := entity.FindAvailableSlots(participants, from, to, time.Hour, 0, 10)
+
+	expected := []entity.TimeInterval{
+		{Start: time.Date(2025, 3, 10, 13, 0, 0, 0, time.UTC), End: time.Date(2025, 3, 10, 14, 0, 0, 0, time.UTC)},
+	}
+	intervals := make([]entity.TimeInterval, 0, len(result))
+	for _, slot := range result {
+		intervals = append(intervals, entity.TimeInterval{Start: slot.Start.UTC(), End: slot.End.UTC()})
+	}
+	if !reflect.DeepEqual(intervals, expected) {
+		t.Errorf("FindAvailableSlots() = %+v, want %+v", intervals, expected)
+	}
+}
@@ -1,9 +1,45 @@
 package entity
 
-import "github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+import (
+	"time"
+
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+)
 
 type Calendar struct {
 	ID           valueobject.CalendarID
 	Name         string
 	RefreshToken *string
+	// Subject is the email of the Google Workspace user impersonated via domain-wide delegation.
+	Subject *string
+	// AuthType decides whether the calendar is accessed via the service account, user OAuth or domain-wide delegation.
+	AuthType   valueobject.AuthType
+	Summary    string
+	TimeZone   string
+	AccessRole string
+	// IsPaused is true while syncing the calendar is paused.
+	IsPaused bool
+	// NeedsReauthAt is the time when the refresh token was found to be revoked or expired.
+	// While it is set, syncing and watching the calendar are skipped until a new refresh token is supplied.
+	NeedsReauthAt *time.Time
+	// Tags are used to select calendars for batch operations.
+	Tags []string
+}
+
+func (c Calendar) Credential() Credential {
+	return Credential{
+		RefreshToken: c.RefreshToken,
+		Subject:      c.Subject,
+	}
+}
+
+// Credential is used to access a calendar on Google Calendar.
+// RefreshToken is set for user OAuth, Subject for domain-wide delegation, and neither for the service account.
+type Credential struct {
+	RefreshToken *string
+	Subject      *string
+}
+
+func (c Credential) AuthType() valueobject.AuthType {
+	return valueobject.AuthTypeOf(c.RefreshToken, c.Subject)
 }
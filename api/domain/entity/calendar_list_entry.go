@@ -0,0 +1,12 @@
+package entity
+
+import "github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+
+// CalendarListEntry is a calendar that appears in the calendar list of a Google account.
+type CalendarListEntry struct {
+	ID         valueobject.CalendarID
+	Summary    string
+	AccessRole string
+	Primary    bool
+	TimeZone   string
+}
@@ -0,0 +1,9 @@
+package entity
+
+// CalendarMetadata is the information of a calendar managed by Google Calendar.
+type CalendarMetadata struct {
+	Summary  string
+	TimeZone string
+	// AccessRole is empty if the calendar is not in the calendar list of the account.
+	AccessRole string
+}
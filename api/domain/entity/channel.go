@@ -13,3 +13,9 @@ type Channel struct {
 	Expiration time.Time
 	IsStopped  bool
 }
+
+// WatchFailure is the calendar whose watch could not be started, with the cause.
+type WatchFailure struct {
+	CalendarID valueobject.CalendarID
+	Err        error
+}
@@ -12,9 +12,21 @@ type Event struct {
 	ID               valueobject.EventID
 	RecurringEventID *valueobject.EventID
 	Summary          string
+	Description      string
+	Location         string
 	Start            *time.Time
 	End              *time.Time
-	Status           string
+	// OriginalStart is the start time of the instance in the recurrence of the recurring event.
+	// It is nil if the event is not an instance of a recurring event.
+	OriginalStart *time.Time
+	// Transparency is opaque or transparent. Transparent events are not treated as busy.
+	Transparency string
+	// AllDay is true if the event is specified by dates. Its start and end are the start of the days in the time zone of the calendar.
+	AllDay bool
+	// ICalUID is the UID of the event in iCalendar format, which is the same for all instances of a recurring event.
+	// It is empty for the events synced before it was stored.
+	ICalUID string
+	Status  string
 }
 
 // NewEventFromRecurringEvent creates a new Event from a RecurringEvent.
@@ -28,6 +40,7 @@ func NewCancelledEventFromRecurringEvent(recurringEvent RecurringEvent) Event {
 		Summary:          recurringEvent.Summary,
 		Start:            recurringEvent.Start,
 		End:              recurringEvent.End,
+		ICalUID:          recurringEvent.ICalUID,
 		Status:           constant.EventStatusCancelled,
 	}
 }
@@ -44,7 +57,31 @@ func (e *Event) Equals(other *Event) bool {
 		e.ID == other.ID &&
 		comparePointer(e.RecurringEventID, other.RecurringEventID) &&
 		e.Summary == other.Summary &&
+		e.Description == other.Description &&
+		e.Location == other.Location &&
 		compareTime(e.Start, other.Start) &&
 		compareTime(e.End, other.End) &&
+		compareTime(e.OriginalStart, other.OriginalStart) &&
+		e.Transparency == other.Transparency &&
+		e.AllDay == other.AllDay &&
+		e.ICalUID == other.ICalUID &&
 		e.Status == other.Status
 }
+
+// IsModifiedFrom reports whether the instance was individually modified from the recurring event,
+// that is, its start time, duration or summary differs from the recurrence.
+func (e *Event) IsModifiedFrom(recurringEvent RecurringEvent) bool {
+	if e.OriginalStart == nil || e.Start == nil {
+		return false
+	}
+
+	if !e.Start.Equal(*e.OriginalStart) || e.Summary != recurringEvent.Summary {
+		return true
+	}
+
+	if e.End != nil && recurringEvent.Start != nil && recurringEvent.End != nil {
+		return e.End.Sub(*e.Start) != recurringEvent.End.Sub(*recurringEvent.Start)
+	}
+
+	return false
+}
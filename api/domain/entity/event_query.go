@@ -0,0 +1,170 @@
+package entity
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+)
+
+// EventFilter is the condition to list synced events. Nil fields are not used for filtering.
+type EventFilter struct {
+	// From and To select the events overlapping the period [From, To).
+	// Events without start and end time, such as cancelled instances, are selected by their original start time,
+	// and are always selected if it is also unknown.
+	From             *time.Time
+	To               *time.Time
+	Status           *string
+	RecurringEventID *valueobject.EventID
+	// Summary selects the events whose summary contains it.
+	Summary *string
+	// IncludeCancelled includes cancelled events. It is ignored if Status is specified.
+	IncludeCancelled bool
+}
+
+// ExportQuery is the condition to export synced events or recurring events.
+type ExportQuery struct {
+	Target ExportTarget
+	Format ExportFormat
+	// CalendarID is nil to export all calendars.
+	CalendarID *valueobject.CalendarID
+	// Filter selects the events. For recurring events, only To and IncludeCancelled are used,
+	// because a recurring event continues after its first instance.
+	Filter EventFilter
+	// Columns are all the columns of the target if empty.
+	Columns []string
+	// Location is the time zone in which times are written.
+	Location *time.Location
+}
+
+// minSearchTermLength is the shortest term that can be searched.
+// The full-text index splits text into tokens of 2 characters, so shorter terms never match.
+const minSearchTermLength = 2
+
+// EventSearchQuery is the condition to search synced events by text.
+type EventSearchQuery struct {
+	// Terms are the words to search for. Events containing all the terms in summary, description or location match.
+	Terms []string
+	// CalendarID is nil to search all calendars.
+	CalendarID *valueobject.CalendarID
+	// Filter selects the events in addition to the terms.
+	Filter EventFilter
+}
+
+// EventSearchResult is an event matching EventSearchQuery.
+type EventSearchResult struct {
+	Event Event
+	// Score is the relevance of the event. It is larger for more relevant events, and only comparable within the same search.
+	Score float64
+}
+
+// ParseSearchTerms splits the text into the terms separated by white spaces. Duplicated terms are removed.
+// Terms shorter than 2 characters are kept, so that the caller can reject them instead of ignoring them silently.
+func ParseSearchTerms(text string) []string {
+
+	terms := []string{}
+	for _, term := range strings.Fields(text) {
+		if slices.Contains(terms, term) {
+			continue
+		}
+		terms = append(terms, term)
+	}
+
+	return terms
+}
+
+// IsShortSearchTerm returns true if the term is shorter than 2 characters and never matches.
+func IsShortSearchTerm(term string) bool {
+	return utf8.RuneCountInString(term) < minSearchTermLength
+}
+
+// EventCursor is the position of the last event of a page.
+// Events are listed in ascending order of start time and ID, so the next page starts after the cursor.
+type EventCursor struct {
+	Start *time.Time          `json:"s,omitempty"`
+	ID    valueobject.EventID `json:"i"`
+}
+
+func NewEventCursor(event Event) EventCursor {
+	return EventCursor{
+		Start: event.Start,
+		ID:    event.ID,
+	}
+}
+
+// ParseEventCursor parses the string returned by EventCursor.String.
+func ParseEventCursor(s string) (*EventCursor, error) {
+
+	var cursor EventCursor
+	if err := decodeCursor(s, &cursor); err != nil {
+		return nil, err
+	}
+
+	if cursor.ID == "" {
+		return nil, fmt.Errorf("id of cursor is empty")
+	}
+
+	return &cursor, nil
+}
+
+// String returns the opaque representation of the cursor returned to the client.
+func (c EventCursor) String() string {
+	return encodeCursor(c)
+}
+
+// RecurringEventCursor is the position of the last recurring event of a page.
+// Recurring events are listed in ascending order of ID, so the next page starts after the cursor.
+type RecurringEventCursor struct {
+	ID valueobject.EventID `json:"i"`
+}
+
+func NewRecurringEventCursor(recurringEvent RecurringEvent) RecurringEventCursor {
+	return RecurringEventCursor{
+		ID: recurringEvent.ID,
+	}
+}
+
+// ParseRecurringEventCursor parses the string returned by RecurringEventCursor.String.
+func ParseRecurringEventCursor(s string) (*RecurringEventCursor, error) {
+
+	var cursor RecurringEventCursor
+	if err := decodeCursor(s, &cursor); err != nil {
+		return nil, err
+	}
+
+	if cursor.ID == "" {
+		return nil, fmt.Errorf("id of cursor is empty")
+	}
+
+	return &cursor, nil
+}
+
+// String returns the opaque representation of the cursor returned to the client.
+func (c RecurringEventCursor) String() string {
+	return encodeCursor(c)
+}
+
+func decodeCursor(s string, cursor any) error {
+
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return fmt.Errorf("fail to decode cursor: %w", err)
+	}
+
+	if err := json.Unmarshal(b, cursor); err != nil {
+		return fmt.Errorf("fail to unmarshal cursor: %w", err)
+	}
+
+	return nil
+}
+
+func encodeCursor(cursor any) string {
+	// 構造体のみで構成されるため、エラーにはならない
+	b, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
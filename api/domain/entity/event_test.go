@@ -1,6 +1,7 @@
 package entity_test
 
 import (
+	"slices"
 	"testing"
 	"time"
 
@@ -128,6 +129,48 @@ func TestEvent_Equals(t *testing.T) {
 			},
 			expected: false,
 		},
+		"different Description": {
+			event1: &entity.Event{
+				CalendarID:  valueobject.CalendarID("cal1"),
+				ID:          valueobject.EventID("1"),
+				Summary:     "Meeting",
+				Description: "Agenda",
+				Start:       &now,
+				End:         &otherTime,
+				Status:      "confirmed",
+			},
+			event2: &entity.Event{
+				CalendarID:  valueobject.CalendarID("cal1"),
+				ID:          valueobject.EventID("1"),
+				Summary:     "Meeting",
+				Description: "Minutes",
+				Start:       &now,
+				End:         &otherTime,
+				Status:      "confirmed",
+			},
+			expected: false,
+		},
+		"different Location": {
+			event1: &entity.Event{
+				CalendarID: valueobject.CalendarID("cal1"),
+				ID:         valueobject.EventID("1"),
+				Summary:    "Meeting",
+				Location:   "Room A",
+				Start:      &now,
+				End:        &otherTime,
+				Status:     "confirmed",
+			},
+			event2: &entity.Event{
+				CalendarID: valueobject.CalendarID("cal1"),
+				ID:         valueobject.EventID("1"),
+				Summary:    "Meeting",
+				Location:   "Room B",
+				Start:      &now,
+				End:        &otherTime,
+				Status:     "confirmed",
+			},
+			expected: false,
+		},
 		"different Start": {
 			event1: &entity.Event{
 				CalendarID: valueobject.CalendarID("cal1"),
@@ -185,6 +228,27 @@ func TestEvent_Equals(t *testing.T) {
 			},
 			expected: false,
 		},
+		"different OriginalStart": {
+			event1: &entity.Event{
+				CalendarID:    valueobject.CalendarID("cal1"),
+				ID:            valueobject.EventID("1"),
+				Summary:       "Meeting",
+				Start:         &now,
+				End:           &otherTime,
+				OriginalStart: &now,
+				Status:        "confirmed",
+			},
+			event2: &entity.Event{
+				CalendarID:    valueobject.CalendarID("cal1"),
+				ID:            valueobject.EventID("1"),
+				Summary:       "Meeting",
+				Start:         &now,
+				End:           &otherTime,
+				OriginalStart: &otherTime,
+				Status:        "confirmed",
+			},
+			expected: false,
+		},
 	}
 
 	for name, tt := range tests {
@@ -198,3 +262,195 @@ func TestEvent_Equals(t *testing.T) {
 		})
 	}
 }
+
+func TestEvent_IsModifiedFrom(t *testing.T) {
+	t.Parallel()
+
+	seriesStart := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	seriesEnd := seriesStart.Add(time.Hour)
+	recurringEvent := entity.RecurringEvent{
+		ID:      valueobject.EventID("r1"),
+		Summary: "Weekly meeting",
+		Start:   &seriesStart,
+		End:     &seriesEnd,
+	}
+
+	originalStart := seriesStart.AddDate(0, 0, 7)
+	originalEnd := originalStart.Add(time.Hour)
+	movedStart := originalStart.Add(2 * time.Hour)
+	movedEnd := movedStart.Add(time.Hour)
+	extendedEnd := originalStart.Add(2 * time.Hour)
+
+	tests := map[string]struct {
+		event    entity.Event
+		expected bool
+	}{
+		"not modified": {
+			event:    entity.Event{Summary: "Weekly meeting", Start: &originalStart, End: &originalEnd, OriginalStart: &originalStart},
+			expected: false,
+		},
+		"start moved": {
+			event:    entity.Event{Summary: "Weekly meeting", Start: &movedStart, End: &movedEnd, OriginalStart: &originalStart},
+			expected: true,
+		},
+		"duration changed": {
+			event:    entity.Event{Summary: "Weekly meeting", Start: &originalStart, End: &extendedEnd, OriginalStart: &originalStart},
+			expected: true,
+		},
+		"summary changed": {
+			event:    entity.Event{Summary: "Special meeting", Start: &originalStart, End: &originalEnd, OriginalStart: &originalStart},
+			expected: true,
+		},
+		"without original start": {
+			event:    entity.Event{Summary: "Special meeting", Start: &movedStart, End: &movedEnd},
+			expected: false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result := tt.event.IsModifiedFrom(recurringEvent)
+			if result != tt.expected {
+				t.Errorf("IsModifiedFrom() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEventCursor(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := map[string]entity.EventCursor{
+		"with start":    {Start: &start, ID: valueobject.EventID("event-1")},
+		"without start": {Start: nil, ID: valueobject.EventID("event-2")},
+	}
+
+	for name, cursor := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			parsed, err := entity.ParseEventCursor(cursor.String())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !entity.CompareTime(cursor.Start, parsed.Start) || cursor.ID != parsed.ID {
+				t.Errorf("expected %+v, got %+v", cursor, *parsed)
+			}
+		})
+	}
+}
+
+func TestParseEventCursor_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"not base64": "!!!",
+		"not json":   "bm90LWpzb24",
+		"empty id":   "e30",
+	}
+
+	for name, s := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := entity.ParseEventCursor(s); err == nil {
+				t.Errorf("expected error for %q, got nil", s)
+			}
+		})
+	}
+}
+
+func TestRecurringEventCursor(t *testing.T) {
+	t.Parallel()
+
+	cursor := entity.RecurringEventCursor{ID: valueobject.EventID("recurring-event-1")}
+
+	parsed, err := entity.ParseRecurringEventCursor(cursor.String())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *parsed != cursor {
+		t.Errorf("expected %+v, got %+v", cursor, *parsed)
+	}
+
+	// カーソルは ID をそのまま含まない
+	if cursor.String() == string(cursor.ID) {
+		t.Errorf("cursor is not opaque: %s", cursor.String())
+	}
+
+	for _, s := range []string{"recurring-event-1", "e30"} {
+		if _, err := entity.ParseRecurringEventCursor(s); err == nil {
+			t.Errorf("expected error for %q, got nil", s)
+		}
+	}
+}
+
+func TestParseSearchTerms(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		text     string
+		expected []string
+	}{
+		"single term": {
+			text:     "project",
+			expected: []string{"project"},
+		},
+		"multiple terms": {
+			text:     "  project\tレビュー 会議 ",
+			expected: []string{"project", "レビュー", "会議"},
+		},
+		"duplicated terms": {
+			text:     "project review project",
+			expected: []string{"project", "review"},
+		},
+		"short terms": {
+			text:     "project X 会",
+			expected: []string{"project", "X", "会"},
+		},
+		"empty": {
+			text:     " ",
+			expected: []string{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result := entity.ParseSearchTerms(tt.text)
+			if !slices.Equal(result, tt.expected) {
+				t.Errorf("ParseSearchTerms() = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIsShortSearchTerm(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		term     string
+		expected bool
+	}{
+		"ascii":          {term: "X", expected: true},
+		"multibyte":      {term: "会", expected: true},
+		"two characters": {term: "ab", expected: false},
+		"two multibyte":  {term: "会議", expected: false},
+		"empty":          {term: "", expected: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if result := entity.IsShortSearchTerm(tt.term); result != tt.expected {
+				t.Errorf("IsShortSearchTerm(%q) = %v, want %v", tt.term, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,269 @@
+package entity
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+)
+
+// ExportTarget is the table to be exported.
+type ExportTarget string
+
+const (
+	ExportTargetEvents          ExportTarget = "events"
+	ExportTargetRecurringEvents ExportTarget = "recurring_events"
+)
+
+// ExportFormat is the file format of the export.
+type ExportFormat string
+
+const (
+	ExportFormatCSV   ExportFormat = "csv"
+	ExportFormatJSONL ExportFormat = "jsonl"
+)
+
+// exportFlushInterval is the number of rows buffered before they are flushed to the underlying writer.
+const exportFlushInterval = 1000
+
+// ExportColumns returns all the columns of the target in the default order.
+func ExportColumns(target ExportTarget) []string {
+	switch target {
+	case ExportTargetEvents:
+		return []string{"calendar_id", "id", "recurring_event_id", "summary", "start", "end",
+			"original_start", "all_day", "transparency", "status"}
+	case ExportTargetRecurringEvents:
+		return []string{"calendar_id", "id", "summary", "recurrence", "start", "end", "status"}
+	default:
+		return nil
+	}
+}
+
+// ParseExportColumns parses the comma-separated columns, ignoring spaces and empty elements.
+func ParseExportColumns(s string) []string {
+	columns := []string{}
+	for _, column := range strings.Split(s, ",") {
+		if column = strings.TrimSpace(column); column != "" {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
+
+// ExportWriter writes events as rows of the selected columns.
+// CSV starts with a header row, and JSON Lines writes each row as an object with the columns as keys.
+// Times are written in RFC 3339 in the location, and missing values are empty in CSV and null in JSON Lines.
+// Times of all-day events are written as dates in the time zone of the calendar, as Google Calendar specifies them.
+// CSV cells that spreadsheet applications would evaluate as formulas are prefixed with a single quote.
+type ExportWriter struct {
+	w       io.Writer
+	csv     *csv.Writer
+	format  ExportFormat
+	columns []string
+	loc     *time.Location
+	// calendarLocs are the time zones of the calendars, used for the dates of all-day events.
+	calendarLocs map[valueobject.CalendarID]*time.Location
+	count        int
+}
+
+// NewExportWriter returns an error if the format or any of the columns is not supported by the target.
+func NewExportWriter(w io.Writer, target ExportTarget, format ExportFormat, columns []string, loc *time.Location) (
+	*ExportWriter, error) {
+
+	all := ExportColumns(target)
+	if all == nil {
+		return nil, fmt.Errorf("unknown export target: %q", target)
+	}
+	if len(columns) == 0 {
+		columns = all
+	}
+	for _, column := range columns {
+		if !slices.Contains(all, column) {
+			return nil, fmt.Errorf("unknown column of %s: %q", target, column)
+		}
+	}
+
+	ew := &ExportWriter{
+		w:            w,
+		format:       format,
+		columns:      columns,
+		loc:          loc,
+		calendarLocs: map[valueobject.CalendarID]*time.Location{},
+	}
+
+	switch format {
+	case ExportFormatCSV:
+		ew.csv = csv.NewWriter(w)
+		if err := ew.csv.Write(columns); err != nil {
+			return nil, fmt.Errorf("fail to write header: %w", err)
+		}
+	case ExportFormatJSONL:
+	default:
+		return nil, fmt.Errorf("unknown export format: %q", format)
+	}
+
+	return ew, nil
+}
+
+// SetCalendarLocation sets the time zone of the calendar, in which the dates of its all-day events are written.
+// The location of the writer is used for the calendars whose time zone is not set.
+func (w *ExportWriter) SetCalendarLocation(calendarID valueobject.CalendarID, loc *time.Location) {
+	w.calendarLocs[calendarID] = loc
+}
+
+func (w *ExportWriter) WriteEvent(event Event) error {
+	var recurringEventID *string
+	if event.RecurringEventID != nil {
+		id := string(*event.RecurringEventID)
+		recurringEventID = &id
+	}
+
+	values := map[string]any{
+		"calendar_id":        string(event.CalendarID),
+		"id":                 string(event.ID),
+		"recurring_event_id": recurringEventID,
+		"summary":            event.Summary,
+		"start":              event.Start,
+		"end":                event.End,
+		"original_start":     event.OriginalStart,
+		"all_day":            event.AllDay,
+		"transparency":       event.Transparency,
+		"status":             event.Status,
+	}
+
+	// 終日の予定は、指定されたタイムゾーンに変換すると日付がずれるため、カレンダーのタイムゾーンでの日付とする
+	if event.AllDay {
+		loc, ok := w.calendarLocs[event.CalendarID]
+		if !ok {
+			loc = w.loc
+		}
+		values["start"] = formatExportDate(event.Start, loc)
+		values["end"] = formatExportDate(event.End, loc)
+		values["original_start"] = formatExportDate(event.OriginalStart, loc)
+	}
+
+	return w.write(values)
+}
+
+func (w *ExportWriter) WriteRecurringEvent(recurringEvent RecurringEvent) error {
+	return w.write(map[string]any{
+		"calendar_id": string(recurringEvent.CalendarID),
+		"id":          string(recurringEvent.ID),
+		"summary":     recurringEvent.Summary,
+		"recurrence":  recurringEvent.Recurrence,
+		"start":       recurringEvent.Start,
+		"end":         recurringEvent.End,
+		"status":      recurringEvent.Status,
+	})
+}
+
+// Flush writes the buffered rows to the underlying writer. It must be called after the last row.
+func (w *ExportWriter) Flush() error {
+	if w.csv != nil {
+		w.csv.Flush()
+		return w.csv.Error()
+	}
+	return nil
+}
+
+func (w *ExportWriter) write(values map[string]any) error {
+
+	switch w.format {
+	case ExportFormatCSV:
+		record := make([]string, 0, len(w.columns))
+		for _, column := range w.columns {
+			record = append(record, w.csvValue(values[column]))
+		}
+		if err := w.csv.Write(record); err != nil {
+			return err
+		}
+
+		// 大量の行をメモリに溜めないよう、一定件数ごとに書き出す
+		w.count++
+		if w.count%exportFlushInterval == 0 {
+			return w.Flush()
+		}
+
+	case ExportFormatJSONL:
+		// 列の指定順を保つため、キーの順序を固定して組み立てる
+		b := []byte{'{'}
+		for i, column := range w.columns {
+			if i > 0 {
+				b = append(b, ',')
+			}
+			key, err := json.Marshal(column)
+			if err != nil {
+				return err
+			}
+			value, err := json.Marshal(w.jsonValue(values[column]))
+			if err != nil {
+				return err
+			}
+			b = append(b, key...)
+			b = append(b, ':')
+			b = append(b, value...)
+		}
+		b = append(b, '}', '\n')
+
+		if _, err := w.w.Write(b); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *ExportWriter) csvValue(v any) string {
+	switch v := w.jsonValue(v).(type) {
+	case nil:
+		return ""
+	case string:
+		return escapeCSVFormula(v)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// escapeCSVFormula prefixes the value with a single quote if it starts with a character
+// that makes spreadsheet applications evaluate it as a formula.
+func escapeCSVFormula(v string) string {
+	if v != "" && strings.ContainsRune("=+-@\t\r", rune(v[0])) {
+		return "'" + v
+	}
+	return v
+}
+
+// formatExportDate returns the date of the time in the location, or nil if the time is nil.
+func formatExportDate(t *time.Time, loc *time.Location) *string {
+	if t == nil {
+		return nil
+	}
+	date := t.In(loc).Format(time.DateOnly)
+	return &date
+}
+
+// jsonValue converts times into strings in the location, and nil pointers into nil.
+func (w *ExportWriter) jsonValue(v any) any {
+	switch v := v.(type) {
+	case *time.Time:
+		if v == nil {
+			return nil
+		}
+		return v.In(w.loc).Format(time.RFC3339)
+	case *string:
+		if v == nil {
+			return nil
+		}
+		return *v
+	default:
+		return v
+	}
+}
@@ -0,0 +1,200 @@
+package entity_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/takuoki/google-calendar-sync/api/domain/entity"
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+)
+
+func TestExportWriter(t *testing.T) {
+	t.Parallel()
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+
+	start := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	recurringEventID := valueobject.EventID("recurring")
+	events := []entity.Event{
+		{CalendarID: "calendar", ID: "event1", Summary: `Meeting, "weekly"`, Start: &start, End: &end,
+			Transparency: "opaque", Status: "confirmed"},
+		{CalendarID: "calendar", ID: "event2", RecurringEventID: &recurringEventID, Summary: "Instance",
+			OriginalStart: &start, AllDay: true, Status: "cancelled"},
+	}
+
+	tests := map[string]struct {
+		format   entity.ExportFormat
+		columns  []string
+		loc      *time.Location
+		expected string
+	}{
+		"csv with all columns": {
+			format: entity.ExportFormatCSV,
+			loc:    time.UTC,
+			expected: "calendar_id,id,recurring_event_id,summary,start,end,original_start,all_day,transparency,status\n" +
+				`calendar,event1,,"Meeting, ""weekly""",2025-01-06T00:00:00Z,2025-01-06T01:00:00Z,,false,opaque,confirmed` + "\n" +
+				"calendar,event2,recurring,Instance,,,2025-01-06,true,,cancelled\n",
+		},
+		"csv with selected columns in time zone": {
+			format:  entity.ExportFormatCSV,
+			columns: []string{"id", "start"},
+			loc:     tokyo,
+			expected: "id,start\n" +
+				"event1,2025-01-06T09:00:00+09:00\n" +
+				"event2,\n",
+		},
+		"jsonl with selected columns": {
+			format:  entity.ExportFormatJSONL,
+			columns: []string{"status", "id", "recurring_event_id", "all_day", "start"},
+			loc:     tokyo,
+			expected: `{"status":"confirmed","id":"event1","recurring_event_id":null,"all_day":false,"start":"2025-01-06T09:00:00+09:00"}` + "\n" +
+				`{"status":"cancelled","id":"event2","recurring_event_id":"recurring","all_day":true,"start":null}` + "\n",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			w, err := entity.NewExportWriter(&buf, entity.ExportTargetEvents, tt.format, tt.columns, tt.loc)
+			if err != nil {
+				t.Fatalf("NewExportWriter() error = %v", err)
+			}
+			for _, event := range events {
+				if err := w.WriteEvent(event); err != nil {
+					t.Fatalf("WriteEvent() error = %v", err)
+				}
+			}
+			if err := w.Flush(); err != nil {
+				t.Fatalf("Flush() error = %v", err)
+			}
+
+			if buf.String() != tt.expected {
+				t.Errorf("result = \n%s\nwant\n%s", buf.String(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestExportWriter_AllDay(t *testing.T) {
+	t.Parallel()
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+
+	// 終日の予定は、カレンダーのタイムゾーンでの日の始まりとして保存されている
+	start := time.Date(2025, 1, 6, 0, 0, 0, 0, tokyo)
+	end := start.AddDate(0, 0, 1)
+	timedStart := time.Date(2025, 1, 6, 9, 0, 0, 0, tokyo)
+	events := []entity.Event{
+		{CalendarID: "tokyo", ID: "all-day", Start: &start, End: &end, OriginalStart: &start, AllDay: true},
+		{CalendarID: "tokyo", ID: "timed", Start: &timedStart},
+		{CalendarID: "unknown", ID: "all-day-unknown", Start: &start, End: &end, AllDay: true},
+	}
+
+	var buf bytes.Buffer
+	w, err := entity.NewExportWriter(&buf, entity.ExportTargetEvents, entity.ExportFormatCSV,
+		[]string{"id", "start", "end", "original_start"}, newYork)
+	if err != nil {
+		t.Fatalf("NewExportWriter() error = %v", err)
+	}
+	w.SetCalendarLocation("tokyo", tokyo)
+	for _, event := range events {
+		if err := w.WriteEvent(event); err != nil {
+			t.Fatalf("WriteEvent() error = %v", err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	// Then: カレンダーのタイムゾーンが不明な場合は、指定されたタイムゾーンでの日付となる
+	expected := "id,start,end,original_start\n" +
+		"all-day,2025-01-06,2025-01-07,2025-01-06\n" +
+		"timed,2025-01-05T19:00:00-05:00,,\n" +
+		"all-day-unknown,2025-01-05,2025-01-06,\n"
+	if buf.String() != expected {
+		t.Errorf("result = \n%s\nwant\n%s", buf.String(), expected)
+	}
+}
+
+func TestExportWriter_CSVFormula(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		summary  string
+		expected string
+	}{
+		"equal":            {summary: "=1+2", expected: "'=1+2"},
+		"plus":             {summary: "+1", expected: "'+1"},
+		"minus":            {summary: "-1", expected: "'-1"},
+		"at":               {summary: "@SUM(A1)", expected: "'@SUM(A1)"},
+		"tab":              {summary: "\tx", expected: "'\tx"},
+		"carriage return":  {summary: "\rx", expected: "\"'\rx\""},
+		"not at the start": {summary: "a=b", expected: "a=b"},
+		"empty":            {summary: "", expected: ""},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			w, err := entity.NewExportWriter(&buf, entity.ExportTargetEvents, entity.ExportFormatCSV,
+				[]string{"id", "summary"}, time.UTC)
+			if err != nil {
+				t.Fatalf("NewExportWriter() error = %v", err)
+			}
+			if err := w.WriteEvent(entity.Event{CalendarID: "calendar", ID: "event", Summary: tt.summary}); err != nil {
+				t.Fatalf("WriteEvent() error = %v", err)
+			}
+			if err := w.Flush(); err != nil {
+				t.Fatalf("Flush() error = %v", err)
+			}
+
+			expected := "id,summary\nevent," + tt.expected + "\n"
+			if buf.String() != expected {
+				t.Errorf("result = %q, want %q", buf.String(), expected)
+			}
+		})
+	}
+}
+
+func TestNewExportWriter_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		target  entity.ExportTarget
+		format  entity.ExportFormat
+		columns []string
+	}{
+		"unknown target": {target: "calendars", format: entity.ExportFormatCSV},
+		"unknown format": {target: entity.ExportTargetEvents, format: "xml"},
+		"column of other target": {
+			target: entity.ExportTargetRecurringEvents, format: entity.ExportFormatCSV, columns: []string{"id", "original_start"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			if _, err := entity.NewExportWriter(&buf, tt.target, tt.format, tt.columns, time.UTC); err == nil {
+				t.Error("NewExportWriter() error = nil, want error")
+			}
+		})
+	}
+}
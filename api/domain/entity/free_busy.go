@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+)
+
+// TimeInterval is the period [Start, End).
+type TimeInterval struct {
+	Start time.Time
+	End   time.Time
+}
+
+// FreeBusy is the busy intervals of a calendar in ascending order of start time.
+type FreeBusy struct {
+	CalendarID valueobject.CalendarID
+	Busy       []TimeInterval
+}
+
+// MergeBusyIntervals returns the periods occupied by the events within [from, to).
+// Overlapping or adjacent periods are merged into one. Events without start or end time are ignored.
+// All-day events occupy the whole days in the time zone of the calendar, since they are stored as the start of the days in it.
+func MergeBusyIntervals(events []Event, from, to time.Time) []TimeInterval {
+
+	intervals := make([]TimeInterval, 0, len(events))
+	for _, event := range events {
+		if event.Start == nil || event.End == nil {
+			continue
+		}
+
+		// 期間外にはみ出した部分は切り詰める
+		start, end := *event.Start, *event.End
+		if start.Before(from) {
+			start = from
+		}
+		if end.After(to) {
+			end = to
+		}
+		if !start.Before(end) {
+			continue
+		}
+
+		intervals = append(intervals, TimeInterval{Start: start, End: end})
+	}
+
+	return mergeIntervals(intervals)
+}
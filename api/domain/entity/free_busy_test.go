@@ -0,0 +1,101 @@
+package entity_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/takuoki/google-calendar-sync/api/domain/entity"
+)
+
+func TestMergeBusyIntervals(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	at := func(hour int) *time.Time {
+		v := base.Add(time.Duration(hour) * time.Hour)
+		return &v
+	}
+	from, to := *at(8), *at(18)
+
+	tests := map[string]struct {
+		events   []entity.Event
+		expected []entity.TimeInterval
+	}{
+		"no events": {
+			events:   nil,
+			expected: []entity.TimeInterval{},
+		},
+		"separate": {
+			events: []entity.Event{
+				{Start: at(13), End: at(14)},
+				{Start: at(9), End: at(10)},
+			},
+			expected: []entity.TimeInterval{
+				{Start: *at(9), End: *at(10)},
+				{Start: *at(13), End: *at(14)},
+			},
+		},
+		"overlapping and adjacent": {
+			events: []entity.Event{
+				{Start: at(9), End: at(11)},
+				{Start: at(10), End: at(12)},
+				{Start: at(12), End: at(13)},
+				{Start: at(10), End: at(11)},
+			},
+			expected: []entity.TimeInterval{
+				{Start: *at(9), End: *at(13)},
+			},
+		},
+		"clipped to the period": {
+			events: []entity.Event{
+				{Start: at(6), End: at(9)},
+				{Start: at(17), End: at(20)},
+				{Start: at(18), End: at(19)},
+			},
+			expected: []entity.TimeInterval{
+				{Start: *at(8), End: *at(9)},
+				{Start: *at(17), End: *at(18)},
+			},
+		},
+		"all-day event": {
+			// 終日の予定は、カレンダーのタイムゾーン (UTC+9) での日の始まりとして保存されている
+			events: []entity.Event{
+				{Start: at(-9), End: at(15), AllDay: true},
+				{Start: at(16), End: at(17)},
+			},
+			expected: []entity.TimeInterval{
+				{Start: *at(8), End: *at(15)},
+				{Start: *at(16), End: *at(17)},
+			},
+		},
+		"all-day event for the next day": {
+			events: []entity.Event{
+				{Start: at(15), End: at(39), AllDay: true},
+				{Start: at(9), End: at(10)},
+			},
+			expected: []entity.TimeInterval{
+				{Start: *at(9), End: *at(10)},
+				{Start: *at(15), End: *at(18)},
+			},
+		},
+		"without time": {
+			events: []entity.Event{
+				{Start: nil, End: at(10)},
+				{Start: at(9), End: nil},
+			},
+			expected: []entity.TimeInterval{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result := entity.MergeBusyIntervals(tt.events, from, to)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("MergeBusyIntervals() = %+v, want %+v", result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,389 @@
+package entity
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/takuoki/google-calendar-sync/api/domain/constant"
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+)
+
+const (
+	iCalendarProductID = "-//takuoki//google-calendar-sync//EN"
+
+	// iCalendarLineLength is the maximum length of a content line in octets, excluding the line break.
+	iCalendarLineLength = 75
+
+	// vtimezoneFutureYears is how long VTIMEZONE lists the transitions after the last event,
+	// so that later instances of recurring events are shown at the correct local time.
+	vtimezoneFutureYears = 5
+
+	iCalendarUTCLayout   = "20060102T150405Z"
+	iCalendarLocalLayout = "20060102T150405"
+	iCalendarDateLayout  = "20060102"
+)
+
+// ICalendarFeed is the contents of a calendar published as an RFC 5545 iCalendar object.
+type ICalendarFeed struct {
+	Calendar Calendar
+	// Series are the recurring events with their instances.
+	// Instances that are modified or cancelled are written as overrides with RECURRENCE-ID.
+	Series []RecurringEventSeries
+	// Events are the events that are not instances of recurring events.
+	Events []Event
+}
+
+// iCalendarComponent is a VEVENT to be written.
+type iCalendarComponent struct {
+	uid          string
+	recurrenceID *time.Time
+	summary      string
+	start        *time.Time
+	end          *time.Time
+	allDay       bool
+	transparency string
+	status       string
+	recurrence   []string
+}
+
+// Encode returns the feed as an iCalendar object with CRLF line breaks.
+// Times are written in the time zone of the calendar with VTIMEZONE, or in UTC if it is unknown.
+func (f ICalendarFeed) Encode(now time.Time) []byte {
+
+	loc := time.UTC
+	if f.Calendar.TimeZone != "" {
+		if l, err := time.LoadLocation(f.Calendar.TimeZone); err == nil {
+			loc = l
+		}
+	}
+
+	components := f.components()
+
+	w := &iCalendarWriter{}
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.line("PRODID:" + iCalendarProductID)
+	w.line("CALSCALE:GREGORIAN")
+	w.line("METHOD:PUBLISH")
+	w.line("X-WR-CALNAME:" + escapeICalendarText(f.Calendar.Name))
+	if loc != time.UTC {
+		w.line("X-WR-TIMEZONE:" + loc.String())
+	}
+
+	from, to := componentPeriod(components, now)
+	for _, tzLoc := range referencedLocations(loc, components) {
+		w.vtimezone(tzLoc, from, to.AddDate(vtimezoneFutureYears, 0, 0))
+	}
+
+	dtstamp := now.UTC().Format(iCalendarUTCLayout)
+	for _, c := range components {
+		w.line("BEGIN:VEVENT")
+		w.line("UID:" + escapeICalendarText(c.uid))
+		w.line("DTSTAMP:" + dtstamp)
+		if c.recurrenceID != nil {
+			w.line(formatICalendarTime("RECURRENCE-ID", *c.recurrenceID, c.allDay, loc))
+		}
+		w.line(formatICalendarTime("DTSTART", *c.start, c.allDay, loc))
+		if c.end != nil {
+			w.line(formatICalendarTime("DTEND", *c.end, c.allDay, loc))
+		}
+		for _, line := range c.recurrence {
+			w.line(line)
+		}
+		w.line("SUMMARY:" + escapeICalendarText(c.summary))
+		if c.transparency == constant.EventTransparencyTransparent {
+			w.line("TRANSP:TRANSPARENT")
+		}
+		if c.status != "" {
+			w.line("STATUS:" + strings.ToUpper(c.status))
+		}
+		w.line("END:VEVENT")
+	}
+
+	w.line("END:VCALENDAR")
+
+	return []byte(w.String())
+}
+
+// components converts the series and events into VEVENTs.
+// When the same ID is used by both a recurring event and an event, which happens when an event is changed
+// between recurring and non-recurring, only the one that is not cancelled is written.
+func (f ICalendarFeed) components() []iCalendarComponent {
+
+	activeIDs := map[valueobject.EventID]bool{}
+	for _, s := range f.Series {
+		if s.RecurringEvent.Status != constant.EventStatusCancelled {
+			activeIDs[s.RecurringEvent.ID] = true
+		}
+	}
+	for _, e := range f.Events {
+		if e.Status != constant.EventStatusCancelled {
+			activeIDs[e.ID] = true
+		}
+	}
+
+	components := []iCalendarComponent{}
+
+	for _, s := range f.Series {
+		recurringEvent := s.RecurringEvent
+		cancelled := recurringEvent.Status == constant.EventStatusCancelled
+		if recurringEvent.Start == nil || (cancelled && activeIDs[recurringEvent.ID]) {
+			continue
+		}
+
+		// 繰り返しイベント自体は終日かどうかを保持していないため、インスタンスから判定する
+		allDay := slices.ContainsFunc(s.Instances, func(e Event) bool { return e.AllDay })
+
+		uid := iCalendarUID(recurringEvent.ICalUID, recurringEvent.ID)
+		components = append(components, iCalendarComponent{
+			uid:        uid,
+			summary:    recurringEvent.Summary,
+			start:      recurringEvent.Start,
+			end:        recurringEvent.End,
+			allDay:     allDay,
+			status:     recurringEvent.Status,
+			recurrence: s.Recurrence.Lines,
+		})
+
+		// 繰り返しイベント自体がキャンセルされた場合は、個別の変更を書き出す必要がない
+		if cancelled {
+			continue
+		}
+
+		overrides := []iCalendarComponent{}
+		for _, instance := range s.Instances {
+			if instance.OriginalStart == nil {
+				continue
+			}
+			if instance.Status != constant.EventStatusCancelled && !instance.IsModifiedFrom(recurringEvent) {
+				continue
+			}
+
+			// キャンセルされたインスタンスは開始時刻を持たないことがあるため、元の開始時刻で補う
+			start, end := instance.Start, instance.End
+			if start == nil {
+				start, end = instance.OriginalStart, nil
+			}
+			summary := instance.Summary
+			if summary == "" {
+				summary = recurringEvent.Summary
+			}
+
+			overrides = append(overrides, iCalendarComponent{
+				uid:          uid,
+				recurrenceID: instance.OriginalStart,
+				summary:      summary,
+				start:        start,
+				end:          end,
+				allDay:       allDay,
+				transparency: instance.Transparency,
+				status:       instance.Status,
+			})
+		}
+
+		sort.SliceStable(overrides, func(i, j int) bool {
+			return overrides[i].recurrenceID.Before(*overrides[j].recurrenceID)
+		})
+		components = append(components, overrides...)
+	}
+
+	for _, e := range f.Events {
+		if e.Start == nil || (e.Status == constant.EventStatusCancelled && activeIDs[e.ID]) {
+			continue
+		}
+
+		components = append(components, iCalendarComponent{
+			uid:          iCalendarUID(e.ICalUID, e.ID),
+			summary:      e.Summary,
+			start:        e.Start,
+			end:          e.End,
+			allDay:       e.AllDay,
+			transparency: e.Transparency,
+			status:       e.Status,
+		})
+	}
+
+	return components
+}
+
+// iCalendarUID returns the UID given by Google Calendar, so that clients subscribing to both Google Calendar and the feed
+// recognize the same events. The event ID is used instead for the events synced before the UID was stored.
+func iCalendarUID(iCalUID string, id valueobject.EventID) string {
+	if iCalUID != "" {
+		return iCalUID
+	}
+	return string(id)
+}
+
+// referencedLocations returns the location of the calendar and the locations referenced by TZID in the recurrences.
+// UTC is excluded because it does not need VTIMEZONE.
+func referencedLocations(loc *time.Location, components []iCalendarComponent) []*time.Location {
+
+	names := []string{}
+	if loc != time.UTC {
+		names = append(names, loc.String())
+	}
+
+	for _, c := range components {
+		for _, line := range c.recurrence {
+			nameAndParams, _, _ := strings.Cut(line, ":")
+			for _, param := range strings.Split(nameAndParams, ";")[1:] {
+				key, value, ok := strings.Cut(param, "=")
+				if ok && strings.EqualFold(key, "TZID") && !slices.Contains(names, value) {
+					names = append(names, value)
+				}
+			}
+		}
+	}
+
+	locations := make([]*time.Location, 0, len(names))
+	for _, name := range names {
+		// 解釈できないタイムゾーンはクライアントに任せる
+		if l, err := time.LoadLocation(name); err == nil && l != time.UTC {
+			locations = append(locations, l)
+		}
+	}
+
+	return locations
+}
+
+// componentPeriod returns the earliest and latest times of the components, including now.
+func componentPeriod(components []iCalendarComponent, now time.Time) (time.Time, time.Time) {
+
+	from, to := now, now
+	for _, c := range components {
+		for _, t := range []*time.Time{c.start, c.end, c.recurrenceID} {
+			if t == nil {
+				continue
+			}
+			if t.Before(from) {
+				from = *t
+			}
+			if t.After(to) {
+				to = *t
+			}
+		}
+	}
+
+	return from, to
+}
+
+// formatICalendarTime returns the content line of the date-time property.
+// All-day events are written as dates in the time zone of the calendar.
+func formatICalendarTime(name string, t time.Time, allDay bool, loc *time.Location) string {
+	switch {
+	case allDay:
+		return name + ";VALUE=DATE:" + t.In(loc).Format(iCalendarDateLayout)
+	case loc == time.UTC:
+		return name + ":" + t.UTC().Format(iCalendarUTCLayout)
+	default:
+		return name + ";TZID=" + loc.String() + ":" + t.In(loc).Format(iCalendarLocalLayout)
+	}
+}
+
+// escapeICalendarText escapes the TEXT value defined in RFC 5545 3.3.11.
+func escapeICalendarText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(s)
+}
+
+// iCalendarWriter writes content lines folded at 75 octets.
+type iCalendarWriter struct {
+	strings.Builder
+}
+
+func (w *iCalendarWriter) line(s string) {
+
+	// マルチバイト文字の途中で折り返さないよう、文字の境界で区切る
+	limit := iCalendarLineLength
+	for len(s) > limit {
+		i := limit
+		for i > 0 && !utf8.RuneStart(s[i]) {
+			i--
+		}
+		w.WriteString(s[:i])
+		w.WriteString("\r\n ")
+		s = s[i:]
+		// 継続行は先頭の空白を含めて 75 オクテットに収める
+		limit = iCalendarLineLength - 1
+	}
+
+	w.WriteString(s)
+	w.WriteString("\r\n")
+}
+
+// vtimezone writes VTIMEZONE of the location with the transitions from the one in effect at from until to.
+func (w *iCalendarWriter) vtimezone(loc *time.Location, from, to time.Time) {
+
+	w.line("BEGIN:VTIMEZONE")
+	w.line("TZID:" + loc.String())
+
+	t := from.In(loc)
+	start, end := t.ZoneBounds()
+
+	// 期間の開始時点で有効なオフセットを最初の定義とする
+	_, prevOffset := t.Zone()
+	onset := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	if !start.IsZero() {
+		_, prevOffset = start.Add(-time.Second).Zone()
+		onset = start
+	}
+
+	for {
+		name, offset := t.Zone()
+		w.timezoneRule(t.IsDST(), name, onset.In(time.FixedZone("", prevOffset)), prevOffset, offset)
+
+		if end.IsZero() || !end.Before(to) {
+			break
+		}
+
+		prevOffset = offset
+		t, onset = end, end
+		_, end = t.ZoneBounds()
+	}
+
+	w.line("END:VTIMEZONE")
+}
+
+func (w *iCalendarWriter) timezoneRule(dst bool, name string, onset time.Time, offsetFrom, offsetTo int) {
+	component := "STANDARD"
+	if dst {
+		component = "DAYLIGHT"
+	}
+
+	w.line("BEGIN:" + component)
+	// DTSTART は切り替え前のオフセットでのローカル時刻で表す
+	w.line("DTSTART:" + onset.Format(iCalendarLocalLayout))
+	w.line("TZOFFSETFROM:" + formatUTCOffset(offsetFrom))
+	w.line("TZOFFSETTO:" + formatUTCOffset(offsetTo))
+	if name != "" {
+		w.line("TZNAME:" + escapeICalendarText(name))
+	}
+	w.line("END:" + component)
+}
+
+// formatUTCOffset formats the offset in seconds as "+hhmm", or "+hhmmss" if it has seconds.
+func formatUTCOffset(offset int) string {
+	sign := "+"
+	if offset < 0 {
+		sign = "-"
+		offset = -offset
+	}
+
+	s := fmt.Sprintf("%s%02d%02d", sign, offset/3600, offset%3600/60)
+	if offset%60 != 0 {
+		s += fmt.Sprintf("%02d", offset%60)
+	}
+
+	return s
+}
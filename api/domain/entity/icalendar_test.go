@@ -0,0 +1,181 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/takuoki/google-calendar-sync/api/domain/constant"
+	"github.com/takuoki/google-calendar-sync/api/domain/entity"
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+)
+
+func TestICalendarFeed_Encode(t *testing.T) {
+	t.Parallel()
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+	at := func(day, hour int) *time.Time {
+		v := time.Date(2025, 1, day, hour, 0, 0, 0, tokyo)
+		return &v
+	}
+	recurringEventID := valueobject.EventID("weekly")
+
+	feed := entity.ICalendarFeed{
+		Calendar: entity.Calendar{ID: "calendar", Name: "Team, Tokyo", TimeZone: "Asia/Tokyo"},
+		Series: []entity.RecurringEventSeries{
+			{
+				RecurringEvent: entity.RecurringEvent{
+					ID: recurringEventID, Summary: "Weekly", Start: at(6, 10), End: at(6, 11),
+					ICalUID: "weekly@google.com", Status: "confirmed",
+				},
+				Recurrence: entity.Recurrence{Lines: []string{"RRULE:FREQ=WEEKLY;BYDAY=MO"}},
+				Instances: []entity.Event{
+					{ID: "weekly_20250120", RecurringEventID: &recurringEventID, Summary: "Weekly",
+						Start: at(20, 10), End: at(20, 11), OriginalStart: at(20, 10), Status: "confirmed"},
+					{ID: "weekly_20250113", RecurringEventID: &recurringEventID, Summary: "Weekly",
+						Start: at(13, 14), End: at(13, 15), OriginalStart: at(13, 10), Status: "confirmed"},
+					{ID: "weekly_20250106", RecurringEventID: &recurringEventID,
+						OriginalStart: at(6, 10), Status: constant.EventStatusCancelled},
+				},
+			},
+		},
+		Events: []entity.Event{
+			{ID: "holiday", Summary: "Holiday", Start: at(8, 0), End: at(9, 0), AllDay: true,
+				Transparency: constant.EventTransparencyTransparent, ICalUID: "holiday@google.com", Status: "confirmed"},
+			// UID を保存する前に同期されたイベントは、イベント ID を UID とする
+			{ID: "cancelled", Summary: "Cancelled", Start: at(7, 9), End: at(7, 10), Status: constant.EventStatusCancelled},
+			// 繰り返しイベントから通常のイベントに変更された際に残るキャンセル済みのデータ
+			{ID: recurringEventID, Summary: "Weekly", Start: at(6, 10), End: at(6, 11), Status: constant.EventStatusCancelled},
+		},
+	}
+
+	result := string(feed.Encode(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)))
+
+	expected := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//takuoki//google-calendar-sync//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		`X-WR-CALNAME:Team\, Tokyo`,
+		"X-WR-TIMEZONE:Asia/Tokyo",
+		"BEGIN:VEVENT",
+		"UID:weekly@google.com",
+		"DTSTAMP:20250101T000000Z",
+		"DTSTART;TZID=Asia/Tokyo:20250106T100000",
+		"DTEND;TZID=Asia/Tokyo:20250106T110000",
+		"RRULE:FREQ=WEEKLY;BYDAY=MO",
+		"SUMMARY:Weekly",
+		"STATUS:CONFIRMED",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:weekly@google.com",
+		"DTSTAMP:20250101T000000Z",
+		"RECURRENCE-ID;TZID=Asia/Tokyo:20250106T100000",
+		"DTSTART;TZID=Asia/Tokyo:20250106T100000",
+		"SUMMARY:Weekly",
+		"STATUS:CANCELLED",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:weekly@google.com",
+		"DTSTAMP:20250101T000000Z",
+		"RECURRENCE-ID;TZID=Asia/Tokyo:20250113T100000",
+		"DTSTART;TZID=Asia/Tokyo:20250113T140000",
+		"DTEND;TZID=Asia/Tokyo:20250113T150000",
+		"SUMMARY:Weekly",
+		"STATUS:CONFIRMED",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:holiday@google.com",
+		"DTSTAMP:20250101T000000Z",
+		"DTSTART;VALUE=DATE:20250108",
+		"DTEND;VALUE=DATE:20250109",
+		"SUMMARY:Holiday",
+		"TRANSP:TRANSPARENT",
+		"STATUS:CONFIRMED",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:cancelled",
+		"DTSTAMP:20250101T000000Z",
+		"DTSTART;TZID=Asia/Tokyo:20250107T090000",
+		"DTEND;TZID=Asia/Tokyo:20250107T100000",
+		"SUMMARY:Cancelled",
+		"STATUS:CANCELLED",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n")
+
+	// VTIMEZONE の内容は tzdata に依存するため、存在のみ確認する
+	vtimezone := "BEGIN:VTIMEZONE\r\nTZID:Asia/Tokyo\r\n"
+	if !strings.Contains(result, vtimezone) {
+		t.Fatalf("Encode() does not contain %q:\n%s", vtimezone, result)
+	}
+	start := strings.Index(result, "BEGIN:VTIMEZONE")
+	end := strings.Index(result, "END:VTIMEZONE\r\n") + len("END:VTIMEZONE\r\n")
+	result = result[:start] + result[end:]
+
+	if result != expected {
+		t.Errorf("Encode() = \n%s\nwant\n%s", result, expected)
+	}
+}
+
+func TestICalendarFeed_Encode_DaylightSavingTime(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+	start := time.Date(2025, 1, 6, 9, 0, 0, 0, newYork)
+	end := start.Add(time.Hour)
+
+	feed := entity.ICalendarFeed{
+		Calendar: entity.Calendar{Name: "New York", TimeZone: "America/New_York"},
+		Events:   []entity.Event{{ID: "event", Summary: "Event", Start: &start, End: &end, Status: "confirmed"}},
+	}
+
+	result := string(feed.Encode(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)))
+
+	// 2025 年 3 月 9 日 02:00 EST に夏時間が始まり、11 月 2 日 02:00 EDT に終わる
+	for _, want := range []string{
+		"BEGIN:STANDARD\r\nDTSTART:20241103T020000\r\nTZOFFSETFROM:-0400\r\nTZOFFSETTO:-0500\r\nTZNAME:EST\r\nEND:STANDARD",
+		"BEGIN:DAYLIGHT\r\nDTSTART:20250309T020000\r\nTZOFFSETFROM:-0500\r\nTZOFFSETTO:-0400\r\nTZNAME:EDT\r\nEND:DAYLIGHT",
+		"BEGIN:STANDARD\r\nDTSTART:20251102T020000\r\nTZOFFSETFROM:-0400\r\nTZOFFSETTO:-0500\r\nTZNAME:EST\r\nEND:STANDARD",
+		// 最後のイベントから 5 年後までの切り替えを含む
+		"BEGIN:STANDARD\r\nDTSTART:20291104T020000\r\n",
+		"DTSTART;TZID=America/New_York:20250106T090000",
+	} {
+		if !strings.Contains(result, want) {
+			t.Errorf("Encode() does not contain %q:\n%s", want, result)
+		}
+	}
+}
+
+func TestICalendarFeed_Encode_Folding(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)
+	summary := strings.Repeat("会議; 定例", 20)
+
+	feed := entity.ICalendarFeed{
+		Events: []entity.Event{{ID: "event", Summary: summary, Start: &start, Status: "confirmed"}},
+	}
+
+	result := string(feed.Encode(start))
+
+	for _, line := range strings.Split(strings.TrimSuffix(result, "\r\n"), "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("line is longer than 75 octets: %q", line)
+		}
+	}
+	if !strings.Contains(strings.ReplaceAll(result, "\r\n ", ""), "SUMMARY:"+strings.ReplaceAll(summary, ";", `\;`)+"\r\n") {
+		t.Errorf("unfolded summary is not found:\n%s", result)
+	}
+	if !strings.Contains(result, "DTSTART:20250106T090000Z") {
+		t.Errorf("start time is not written in UTC:\n%s", result)
+	}
+}
@@ -0,0 +1,9 @@
+package entity
+
+import "time"
+
+type Lease struct {
+	Name       string
+	Holder     string
+	Expiration time.Time
+}
@@ -0,0 +1,17 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+)
+
+// OAuthState is the state of an OAuth 2.0 authorization request, which is kept until the callback.
+type OAuthState struct {
+	State        string
+	CodeVerifier string
+	CalendarID   valueobject.CalendarID
+	// Name is nil when the calendar is already registered.
+	Name       *string
+	Expiration time.Time
+}
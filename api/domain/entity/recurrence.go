@@ -0,0 +1,135 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Recurrence is the parsed recurrence of a recurring event.
+// It is stored as a JSON array of RRULE, EXRULE, RDATE and EXDATE lines of RFC 5545.
+// Other lines are kept only in Lines.
+type Recurrence struct {
+	// Lines are the original lines of the recurrence.
+	Lines   []string
+	Rules   []RecurrenceRule
+	RDates  []string
+	ExDates []string
+}
+
+// RecurrenceRule is a parsed RRULE or EXRULE. Parts not supported by the fields are kept only in Lines of Recurrence.
+type RecurrenceRule struct {
+	Exclusion  bool
+	Freq       string
+	Interval   int
+	Count      *int
+	Until      *string
+	ByDay      []string
+	ByMonthDay []int
+	ByMonth    []int
+}
+
+// ParseRecurrence parses the recurrence stored in the database.
+func ParseRecurrence(s string) (*Recurrence, error) {
+
+	recurrence := &Recurrence{
+		Lines:   []string{},
+		Rules:   []RecurrenceRule{},
+		RDates:  []string{},
+		ExDates: []string{},
+	}
+
+	// 繰り返しイベントから通常のイベントに変更された場合は空文字になる
+	if s == "" {
+		return recurrence, nil
+	}
+
+	if err := json.Unmarshal([]byte(s), &recurrence.Lines); err != nil {
+		return nil, fmt.Errorf("fail to unmarshal recurrence: %w", err)
+	}
+
+	for _, line := range recurrence.Lines {
+		// 名前とパラメータ (例: EXDATE;TZID=Asia/Tokyo) と値は最初のコロンで区切られる
+		nameAndParams, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid recurrence line: %q", line)
+		}
+		name, _, _ := strings.Cut(nameAndParams, ";")
+
+		switch strings.ToUpper(name) {
+		case "RRULE", "EXRULE":
+			rule, err := parseRecurrenceRule(value)
+			if err != nil {
+				return nil, fmt.Errorf("fail to parse rule %q: %w", line, err)
+			}
+			rule.Exclusion = strings.EqualFold(name, "EXRULE")
+			recurrence.Rules = append(recurrence.Rules, *rule)
+		case "RDATE":
+			recurrence.RDates = append(recurrence.RDates, strings.Split(value, ",")...)
+		case "EXDATE":
+			recurrence.ExDates = append(recurrence.ExDates, strings.Split(value, ",")...)
+		}
+	}
+
+	return recurrence, nil
+}
+
+func parseRecurrenceRule(value string) (*RecurrenceRule, error) {
+
+	rule := &RecurrenceRule{
+		Interval:   1,
+		ByDay:      []string{},
+		ByMonthDay: []int{},
+		ByMonth:    []int{},
+	}
+
+	for _, part := range strings.Split(value, ";") {
+		key, val, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid rule part: %q", part)
+		}
+
+		var err error
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			rule.Freq = val
+		case "INTERVAL":
+			rule.Interval, err = strconv.Atoi(val)
+		case "COUNT":
+			var count int
+			count, err = strconv.Atoi(val)
+			rule.Count = &count
+		case "UNTIL":
+			rule.Until = &val
+		case "BYDAY":
+			rule.ByDay = strings.Split(val, ",")
+		case "BYMONTHDAY":
+			rule.ByMonthDay, err = parseInts(val)
+		case "BYMONTH":
+			rule.ByMonth, err = parseInts(val)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", key, err)
+		}
+	}
+
+	if rule.Freq == "" {
+		return nil, fmt.Errorf("FREQ is required")
+	}
+
+	return rule, nil
+}
+
+func parseInts(s string) ([]int, error) {
+	values := strings.Split(s, ",")
+	ints := make([]int, 0, len(values))
+	for _, v := range values {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, err
+		}
+		ints = append(ints, i)
+	}
+	return ints, nil
+}
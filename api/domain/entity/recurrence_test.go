@@ -0,0 +1,114 @@
+package entity_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/takuoki/google-calendar-sync/api/domain/entity"
+)
+
+func TestParseRecurrence(t *testing.T) {
+	t.Parallel()
+
+	count := 10
+	until := "20251231T000000Z"
+
+	tests := map[string]struct {
+		recurrence string
+		expected   *entity.Recurrence
+	}{
+		"empty": {
+			recurrence: "",
+			expected: &entity.Recurrence{
+				Lines:   []string{},
+				Rules:   []entity.RecurrenceRule{},
+				RDates:  []string{},
+				ExDates: []string{},
+			},
+		},
+		"weekly with exdate": {
+			recurrence: `["RRULE:FREQ=WEEKLY;BYDAY=MO,WE;UNTIL=20251231T000000Z","EXDATE;TZID=Asia/Tokyo:20250106T090000,20250113T090000"]`,
+			expected: &entity.Recurrence{
+				Lines: []string{
+					"RRULE:FREQ=WEEKLY;BYDAY=MO,WE;UNTIL=20251231T000000Z",
+					"EXDATE;TZID=Asia/Tokyo:20250106T090000,20250113T090000",
+				},
+				Rules: []entity.RecurrenceRule{
+					{Freq: "WEEKLY", Interval: 1, Until: &until, ByDay: []string{"MO", "WE"}, ByMonthDay: []int{}, ByMonth: []int{}},
+				},
+				RDates:  []string{},
+				ExDates: []string{"20250106T090000", "20250113T090000"},
+			},
+		},
+		"monthly with count and rdate": {
+			recurrence: `["RRULE:FREQ=MONTHLY;INTERVAL=2;COUNT=10;BYMONTHDAY=1,15","RDATE;VALUE=DATE:20250120"]`,
+			expected: &entity.Recurrence{
+				Lines: []string{
+					"RRULE:FREQ=MONTHLY;INTERVAL=2;COUNT=10;BYMONTHDAY=1,15",
+					"RDATE;VALUE=DATE:20250120",
+				},
+				Rules: []entity.RecurrenceRule{
+					{Freq: "MONTHLY", Interval: 2, Count: &count, ByDay: []string{}, ByMonthDay: []int{1, 15}, ByMonth: []int{}},
+				},
+				RDates:  []string{"20250120"},
+				ExDates: []string{},
+			},
+		},
+		"unknown line": {
+			recurrence: `["DTSTART:20250101T000000Z"]`,
+			expected: &entity.Recurrence{
+				Lines:   []string{"DTSTART:20250101T000000Z"},
+				Rules:   []entity.RecurrenceRule{},
+				RDates:  []string{},
+				ExDates: []string{},
+			},
+		},
+		"exrule": {
+			recurrence: `["RRULE:FREQ=DAILY","EXRULE:FREQ=YEARLY;BYMONTH=1"]`,
+			expected: &entity.Recurrence{
+				Lines: []string{"RRULE:FREQ=DAILY", "EXRULE:FREQ=YEARLY;BYMONTH=1"},
+				Rules: []entity.RecurrenceRule{
+					{Freq: "DAILY", Interval: 1, ByDay: []string{}, ByMonthDay: []int{}, ByMonth: []int{}},
+					{Exclusion: true, Freq: "YEARLY", Interval: 1, ByDay: []string{}, ByMonthDay: []int{}, ByMonth: []int{1}},
+				},
+				RDates:  []string{},
+				ExDates: []string{},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := entity.ParseRecurrence(tt.recurrence)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("ParseRecurrence() = %+v, want %+v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseRecurrence_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"not json":         "RRULE:FREQ=DAILY",
+		"without colon":    `["RRULE"]`,
+		"without freq":     `["RRULE:COUNT=3"]`,
+		"invalid interval": `["RRULE:FREQ=DAILY;INTERVAL=x"]`,
+	}
+
+	for name, recurrence := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := entity.ParseRecurrence(recurrence); err == nil {
+				t.Errorf("expected error for %q, got nil", recurrence)
+			}
+		})
+	}
+}
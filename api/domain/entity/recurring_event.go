@@ -14,7 +14,16 @@ type RecurringEvent struct {
 	Recurrence string
 	Start      *time.Time
 	End        *time.Time
-	Status     string
+	// ICalUID is the UID of the event in iCalendar format. It is empty for the events synced before it was stored.
+	ICalUID string
+	Status  string
+}
+
+// RecurringEventSeries is a recurring event with its instances stored in the database.
+type RecurringEventSeries struct {
+	RecurringEvent RecurringEvent
+	Recurrence     Recurrence
+	Instances      []Event
 }
 
 // NewCancelledRecurringEventFromEvent creates a new RecurringEvent from a Event.
@@ -28,6 +37,7 @@ func NewCancelledRecurringEventFromEvent(event Event) RecurringEvent {
 		Recurrence: "", // Recurrence is not set for cancelled events
 		Start:      event.Start,
 		End:        event.End,
+		ICalUID:    event.ICalUID,
 		Status:     constant.EventStatusCancelled,
 	}
 }
@@ -46,5 +56,6 @@ func (e *RecurringEvent) Equals(other *RecurringEvent) bool {
 		e.Recurrence == other.Recurrence &&
 		compareTime(e.Start, other.Start) &&
 		compareTime(e.End, other.End) &&
+		e.ICalUID == other.ICalUID &&
 		e.Status == other.Status
 }
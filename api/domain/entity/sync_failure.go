@@ -0,0 +1,14 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+)
+
+type SyncFailure struct {
+	CalendarID   valueobject.CalendarID
+	FailureTime  time.Time
+	Stage        string
+	ErrorMessage string
+}
@@ -0,0 +1,20 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+)
+
+type SyncHistory struct {
+	CalendarID        valueobject.CalendarID
+	SyncTime          time.Time
+	NextSyncToken     string
+	UpdatedEventCount int
+}
+
+type SyncFutureInstanceHistory struct {
+	CalendarID        valueobject.CalendarID
+	SyncTime          time.Time
+	UpdatedEventCount int
+}
@@ -0,0 +1,24 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+)
+
+// SyncStatus represents the sync health of a calendar.
+// Each field is nil if the corresponding operation has never been performed.
+type SyncStatus struct {
+	CalendarID               valueobject.CalendarID
+	LatestSync               *SyncHistory
+	SyncTokenAge             *time.Duration
+	LatestSyncFutureInstance *SyncFutureInstanceHistory
+	ActiveChannel            *Channel
+	LatestFailure            *SyncFailure
+
+	// ConsecutiveFailureCount is the number of sync failures since the last successful sync.
+	ConsecutiveFailureCount int
+
+	// NeedsReauthAt is copied from the calendar. Syncs are skipped while it is set.
+	NeedsReauthAt *time.Time
+}
@@ -5,13 +5,17 @@ import (
 	"net/http"
 )
 
+// ClientError is an error caused by the client.
+// Code is a stable machine-readable error code, and Status is the HTTP status code.
 type ClientError struct {
-	Code    int
+	Status  int
+	Code    string
 	Message string
 }
 
-func newClientError(code int, message string) *ClientError {
+func newClientError(status int, code, message string) *ClientError {
 	return &ClientError{
+		Status:  status,
 		Code:    code,
 		Message: message,
 	}
@@ -21,18 +25,65 @@ func (e *ClientError) Error() string {
 	return e.Message
 }
 
+// Error codes returned to the client.
+const (
+	ErrorCodeRequired                 = "required"
+	ErrorCodeNotAllowed               = "not_allowed"
+	ErrorCodeInvalidParameter         = "invalid_parameter"
+	ErrorCodeInvalidJSON              = "invalid_json"
+	ErrorCodeAllParameterFalse        = "all_parameter_false"
+	ErrorCodeCalendarNotFound         = "calendar_not_found"
+	ErrorCodeRecurringEventNotFound   = "recurring_event_not_found"
+	ErrorCodeFeedNotFound             = "feed_not_found"
+	ErrorCodeCalendarAlreadyExists    = "calendar_already_exists"
+	ErrorCodeCalendarNeedsReauth      = "calendar_needs_reauth"
+	ErrorCodeJobAlreadyRunning        = "job_already_running"
+	ErrorCodeOAuthDisabled            = "oauth_disabled"
+	ErrorCodeOAuthAccessDenied        = "oauth_access_denied"
+	ErrorCodeOAuthFailed              = "oauth_failed"
+	ErrorCodeGoogleCalendarNotFound   = "google_calendar_not_found"
+	ErrorCodeGooglePermissionDenied   = "google_permission_denied"
+	ErrorCodeGoogleInvalidCredentials = "google_invalid_credentials"
+	ErrorCodeGoogleQuotaExceeded      = "google_quota_exceeded"
+)
+
 var (
 	RequiredError = func(paramName string) *ClientError {
-		return newClientError(http.StatusBadRequest, fmt.Sprintf("%s is required", paramName))
+		return newClientError(http.StatusBadRequest, ErrorCodeRequired, fmt.Sprintf("%s is required", paramName))
 	}
 	NotAllowedError = func(paramName string) *ClientError {
-		return newClientError(http.StatusBadRequest, fmt.Sprintf("%s is not allowed", paramName))
+		return newClientError(http.StatusBadRequest, ErrorCodeNotAllowed, fmt.Sprintf("%s is not allowed", paramName))
+	}
+	OnlyOneAllowedError = func(paramNames string) *ClientError {
+		return newClientError(http.StatusBadRequest, ErrorCodeNotAllowed, fmt.Sprintf("only one of %s is allowed", paramNames))
 	}
+	InvalidParameterError = func(paramName string) *ClientError {
+		return newClientError(http.StatusBadRequest, ErrorCodeInvalidParameter, fmt.Sprintf("%s is invalid", paramName))
+	}
+	OAuthFailedError = func(reason string) *ClientError {
+		return newClientError(http.StatusBadRequest, ErrorCodeOAuthFailed, fmt.Sprintf("authorization failed: %s", reason))
+	}
+
+	InvalidJSONError            = newClientError(http.StatusBadRequest, ErrorCodeInvalidJSON, "invalid json")
+	AllParameterFalseError      = newClientError(http.StatusBadRequest, ErrorCodeAllParameterFalse, "all must be true")
+	CalendarNotFoundError       = newClientError(http.StatusNotFound, ErrorCodeCalendarNotFound, "calender not found")
+	RecurringEventNotFoundError = newClientError(http.StatusNotFound, ErrorCodeRecurringEventNotFound, "recurring event not found")
+	FeedNotFoundError           = newClientError(http.StatusNotFound, ErrorCodeFeedNotFound, "feed not found")
+	CalendarAlreadyExistError   = newClientError(http.StatusConflict, ErrorCodeCalendarAlreadyExists, "calender already exists")
+	CalendarNeedsReauthError    = newClientError(http.StatusConflict, ErrorCodeCalendarNeedsReauth, "calendar needs re-authorization")
+	JobAlreadyRunningError      = newClientError(http.StatusConflict, ErrorCodeJobAlreadyRunning, "job is already running")
+	OAuthDisabledError          = newClientError(http.StatusBadRequest, ErrorCodeOAuthDisabled, "oauth is not enabled")
+	OAuthAccessDeniedError      = newClientError(http.StatusBadRequest, ErrorCodeOAuthAccessDenied, "access is denied by the user")
 
-	InvalidJSONError          = newClientError(http.StatusBadRequest, "invalid json")
-	CalendarNotFoundError     = newClientError(http.StatusNotFound, "calender not found")
-	CalendarAlreadyExistError = newClientError(http.StatusNotFound, "calender already exists")
-	AllParameterFalseError    = newClientError(http.StatusBadRequest, "all must be true")
+	// Errors returned by the Google Calendar API.
+	GoogleCalendarNotFoundError = newClientError(http.StatusNotFound,
+		ErrorCodeGoogleCalendarNotFound, "calendar is not found or not shared on google calendar")
+	GooglePermissionDeniedError = newClientError(http.StatusForbidden,
+		ErrorCodeGooglePermissionDenied, "permission to the calendar is denied on google calendar")
+	GoogleInvalidCredentialsError = newClientError(http.StatusBadRequest,
+		ErrorCodeGoogleInvalidCredentials, "credentials for google calendar are invalid")
+	GoogleQuotaExceededError = newClientError(http.StatusTooManyRequests,
+		ErrorCodeGoogleQuotaExceeded, "quota of google calendar api is exceeded")
 )
 
 // InternalHandlingError is an error used for internal handling.
@@ -1,41 +1,244 @@
 package service
 
 import (
+	"container/list"
+	"errors"
 	"sync"
+	"time"
 )
 
 type Cache[K comparable, V any] interface {
 	Get(key K) (V, bool)
 	Set(key K, value V)
 	Delete(key K)
+	// GetOrLoad returns the cached value, or loads and caches it if it does not exist.
+	// Concurrent calls for the same key share one load. The value is not cached if load returns an error.
+	GetOrLoad(key K, load func() (V, error)) (V, error)
+	Stats() CacheStats
 }
 
+// CacheStats is the statistics of a cache since it was created.
+type CacheStats struct {
+	Hits   uint64
+	Misses uint64
+	// Evictions is the number of entries removed to keep the cache within its max size.
+	Evictions uint64
+	Size      int
+}
+
+type cacheConfig struct {
+	ttl     time.Duration
+	maxSize int
+	clock   Clock
+}
+
+type CacheOption func(*cacheConfig)
+
+// WithTTL expires each entry after ttl since it was set.
+func WithTTL(ttl time.Duration) CacheOption {
+	return func(c *cacheConfig) {
+		c.ttl = ttl
+	}
+}
+
+// WithMaxSize evicts the least recently used entry when the number of entries exceeds maxSize.
+func WithMaxSize(maxSize int) CacheOption {
+	return func(c *cacheConfig) {
+		c.maxSize = maxSize
+	}
+}
+
+// WithCacheClock sets the clock used to expire entries.
+func WithCacheClock(clock Clock) CacheOption {
+	return func(c *cacheConfig) {
+		c.clock = clock
+	}
+}
+
+type cacheEntry[K comparable, V any] struct {
+	key        K
+	value      V
+	expiration time.Time
+}
+
+// errCacheLoadPanicked is returned to the calls waiting for a load that panicked.
+var errCacheLoadPanicked = errors.New("cache load panicked")
+
+type cacheLoadCall[V any] struct {
+	wg    sync.WaitGroup
+	value V
+	err   error
+}
+
+// InMemoryCache is a cache without expiry or size limit by default.
+// Entries expire with WithTTL, and the least recently used ones are evicted with WithMaxSize.
 type InMemoryCache[K comparable, V any] struct {
-	mu     sync.RWMutex
-	values map[K]V
+	config cacheConfig
+
+	mu      sync.Mutex
+	entries map[K]*list.Element
+	// lru holds *cacheEntry in order of use, with the most recently used at the front.
+	lru   *list.List
+	calls map[K]*cacheLoadCall[V]
+	stats CacheStats
+
+	// waitHook is called when a call starts waiting for the load of another call, to synchronize tests.
+	waitHook func()
 }
 
-func NewInMemoryCache[K comparable, V any]() *InMemoryCache[K, V] {
+func NewInMemoryCache[K comparable, V any](opts ...CacheOption) *InMemoryCache[K, V] {
+
+	config := cacheConfig{
+		clock: &SystemClock{location: time.UTC},
+	}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
 	return &InMemoryCache[K, V]{
-		values: make(map[K]V),
+		config:  config,
+		entries: make(map[K]*list.Element),
+		lru:     list.New(),
+		calls:   make(map[K]*cacheLoadCall[V]),
 	}
 }
 
 func (c *InMemoryCache[K, V]) Get(key K) (V, bool) {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-	v, ok := c.values[key]
-	return v, ok
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.get(key)
 }
 
 func (c *InMemoryCache[K, V]) Set(key K, value V) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
-	c.values[key] = value
+
+	// 読み込み中の値で上書きされないよう、読み込み結果を破棄させる
+	delete(c.calls, key)
+	c.set(key, value)
 }
 
 func (c *InMemoryCache[K, V]) Delete(key K) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
-	delete(c.values, key)
+
+	delete(c.calls, key)
+	if elem, ok := c.entries[key]; ok {
+		c.remove(elem)
+	}
+}
+
+func (c *InMemoryCache[K, V]) GetOrLoad(key K, load func() (V, error)) (V, error) {
+
+	c.mu.Lock()
+
+	if value, ok := c.get(key); ok {
+		c.mu.Unlock()
+		return value, nil
+	}
+
+	// 同じキーの読み込み中であれば、その結果を待つ
+	if call, ok := c.calls[key]; ok {
+		c.mu.Unlock()
+		if c.waitHook != nil {
+			c.waitHook()
+		}
+		call.wg.Wait()
+		return call.value, call.err
+	}
+
+	call := &cacheLoadCall[V]{}
+	call.wg.Add(1)
+	c.calls[key] = call
+	c.mu.Unlock()
+
+	loaded := false
+	defer func() {
+		// load がパニックした場合も、待機中の呼び出しがエラーで戻れるようにする
+		if !loaded {
+			call.err = errCacheLoadPanicked
+		}
+
+		c.mu.Lock()
+		// 読み込み中に Set または Delete された場合は、読み込んだ値を保存しない
+		if c.calls[key] == call {
+			delete(c.calls, key)
+			if call.err == nil {
+				c.set(key, call.value)
+			}
+		}
+		c.mu.Unlock()
+
+		call.wg.Done()
+	}()
+
+	call.value, call.err = load()
+	loaded = true
+
+	return call.value, call.err
+}
+
+func (c *InMemoryCache[K, V]) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := c.stats
+	stats.Size = c.lru.Len()
+	return stats
+}
+
+func (c *InMemoryCache[K, V]) get(key K) (V, bool) {
+
+	elem, ok := c.entries[key]
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+
+	entry := elem.Value.(*cacheEntry[K, V])
+	if !entry.expiration.IsZero() && !c.config.clock.Now().Before(entry.expiration) {
+		c.remove(elem)
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+
+	c.lru.MoveToFront(elem)
+	c.stats.Hits++
+	return entry.value, true
+}
+
+func (c *InMemoryCache[K, V]) set(key K, value V) {
+
+	var expiration time.Time
+	if c.config.ttl > 0 {
+		expiration = c.config.clock.Now().Add(c.config.ttl)
+	}
+
+	if elem, ok := c.entries[key]; ok {
+		entry := elem.Value.(*cacheEntry[K, V])
+		entry.value = value
+		entry.expiration = expiration
+		c.lru.MoveToFront(elem)
+		return
+	}
+
+	c.entries[key] = c.lru.PushFront(&cacheEntry[K, V]{
+		key:        key,
+		value:      value,
+		expiration: expiration,
+	})
+
+	if c.config.maxSize > 0 {
+		for c.lru.Len() > c.config.maxSize {
+			c.remove(c.lru.Back())
+			c.stats.Evictions++
+		}
+	}
+}
+
+func (c *InMemoryCache[K, V]) remove(elem *list.Element) {
+	entry := c.lru.Remove(elem).(*cacheEntry[K, V])
+	delete(c.entries, entry.key)
 }
@@ -1,7 +1,11 @@
 package service_test
 
 import (
+	"errors"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/takuoki/google-calendar-sync/api/domain/service"
 )
@@ -26,3 +30,192 @@ func TestInMemoryCache(t *testing.T) {
 		t.Errorf("expected key1 to be deleted")
 	}
 }
+
+func TestInMemoryCache_TTL(t *testing.T) {
+	clock := service.NewMockClock()
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock.SetFixedTime(now)
+
+	cache := service.NewInMemoryCache[string, int](service.WithTTL(time.Minute), service.WithCacheClock(clock))
+	cache.Set("key1", 100)
+
+	clock.SetFixedTime(now.Add(time.Minute - time.Millisecond))
+	if value, ok := cache.Get("key1"); !ok || value != 100 {
+		t.Errorf("expected value 100 before expiration, got %v (exists: %v)", value, ok)
+	}
+
+	clock.SetFixedTime(now.Add(time.Minute))
+	if _, ok := cache.Get("key1"); ok {
+		t.Errorf("expected key1 to be expired")
+	}
+	if size := cache.Stats().Size; size != 0 {
+		t.Errorf("expected expired entry to be removed, got size %d", size)
+	}
+
+	// Set で有効期限が延長される
+	cache.Set("key1", 200)
+	clock.SetFixedTime(now.Add(90 * time.Second))
+	if value, ok := cache.Get("key1"); !ok || value != 200 {
+		t.Errorf("expected value 200 after reset, got %v (exists: %v)", value, ok)
+	}
+}
+
+func TestInMemoryCache_MaxSize(t *testing.T) {
+	cache := service.NewInMemoryCache[string, int](service.WithMaxSize(2))
+
+	cache.Set("key1", 1)
+	cache.Set("key2", 2)
+
+	// key1 を参照し、key2 を最も使われていないエントリにする
+	if _, ok := cache.Get("key1"); !ok {
+		t.Fatalf("expected key1 to exist")
+	}
+
+	cache.Set("key3", 3)
+
+	if _, ok := cache.Get("key2"); ok {
+		t.Errorf("expected key2 to be evicted")
+	}
+	if value, ok := cache.Get("key1"); !ok || value != 1 {
+		t.Errorf("expected value 1, got %v (exists: %v)", value, ok)
+	}
+	if value, ok := cache.Get("key3"); !ok || value != 3 {
+		t.Errorf("expected value 3, got %v (exists: %v)", value, ok)
+	}
+
+	stats := cache.Stats()
+	if stats.Evictions != 1 {
+		t.Errorf("expected 1 eviction, got %d", stats.Evictions)
+	}
+	if stats.Size != 2 {
+		t.Errorf("expected size 2, got %d", stats.Size)
+	}
+}
+
+func TestInMemoryCache_GetOrLoad(t *testing.T) {
+	cache := service.NewInMemoryCache[string, int]()
+
+	// 同じキーの同時読み込みは 1 回にまとめられる
+	const n = 10
+	waiting := make(chan struct{}, n)
+	service.SetWaitHook(cache, func() { waiting <- struct{}{} })
+
+	var loads atomic.Int32
+	load := func() (int, error) {
+		loads.Add(1)
+		// 他の呼び出しが全て待機してから読み込みを終える
+		for range n - 1 {
+			<-waiting
+		}
+		return 100, nil
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(n)
+	results := make([]int, n)
+	for i := range n {
+		go func() {
+			defer wg.Done()
+			value, err := cache.GetOrLoad("key1", load)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			results[i] = value
+		}()
+	}
+	wg.Wait()
+
+	if got := loads.Load(); got != 1 {
+		t.Errorf("expected load to be called once, got %d", got)
+	}
+	for i, value := range results {
+		if value != 100 {
+			t.Errorf("expected value 100 for caller %d, got %d", i, value)
+		}
+	}
+	if value, ok := cache.Get("key1"); !ok || value != 100 {
+		t.Errorf("expected loaded value to be cached, got %v (exists: %v)", value, ok)
+	}
+}
+
+func TestInMemoryCache_GetOrLoad_Panic(t *testing.T) {
+	cache := service.NewInMemoryCache[string, int]()
+
+	waiting := make(chan struct{})
+	service.SetWaitHook(cache, func() { close(waiting) })
+
+	loading := make(chan struct{})
+	loaderDone := make(chan any)
+	go func() {
+		defer func() { loaderDone <- recover() }()
+		_, _ = cache.GetOrLoad("key1", func() (int, error) {
+			close(loading)
+			<-waiting
+			panic("load panic")
+		})
+	}()
+
+	// 読み込み中の呼び出しを待機させる
+	<-loading
+	_, err := cache.GetOrLoad("key1", func() (int, error) { return 100, nil })
+
+	// パニックは読み込んだ呼び出しに伝わり、待機中の呼び出しにはエラーが返る
+	if r := <-loaderDone; r != "load panic" {
+		t.Errorf("expected panic to propagate to the loader, got %v", r)
+	}
+	if err == nil {
+		t.Errorf("expected error for the waiting call")
+	}
+
+	// 読み込み中の状態が残らず、再度読み込める
+	value, err := cache.GetOrLoad("key1", func() (int, error) { return 100, nil })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value != 100 {
+		t.Errorf("expected value 100, got %d", value)
+	}
+}
+
+func TestInMemoryCache_GetOrLoad_Error(t *testing.T) {
+	cache := service.NewInMemoryCache[string, int]()
+
+	loadErr := errors.New("load error")
+	if _, err := cache.GetOrLoad("key1", func() (int, error) { return 0, loadErr }); !errors.Is(err, loadErr) {
+		t.Fatalf("expected load error, got %v", err)
+	}
+	if _, ok := cache.Get("key1"); ok {
+		t.Errorf("expected failed load not to be cached")
+	}
+
+	value, err := cache.GetOrLoad("key1", func() (int, error) { return 100, nil })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value != 100 {
+		t.Errorf("expected value 100, got %d", value)
+	}
+}
+
+func TestInMemoryCache_Stats(t *testing.T) {
+	cache := service.NewInMemoryCache[string, int]()
+
+	cache.Set("key1", 100)
+	cache.Get("key1")
+	cache.Get("key1")
+	cache.Get("key2")
+	if _, err := cache.GetOrLoad("key3", func() (int, error) { return 300, nil }); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	stats := cache.Stats()
+	if stats.Hits != 2 {
+		t.Errorf("expected 2 hits, got %d", stats.Hits)
+	}
+	if stats.Misses != 2 {
+		t.Errorf("expected 2 misses, got %d", stats.Misses)
+	}
+	if stats.Size != 2 {
+		t.Errorf("expected size 2, got %d", stats.Size)
+	}
+}
@@ -7,7 +7,10 @@ import (
 
 type Clock interface {
 	Now() time.Time
+	// Today returns the start of today in the default location of the clock.
 	Today() time.Time
+	// TodayIn returns the start of today in loc.
+	TodayIn(loc *time.Location) time.Time
 }
 
 type SystemClock struct {
@@ -30,7 +33,11 @@ func (c *SystemClock) Now() time.Time {
 }
 
 func (c *SystemClock) Today() time.Time {
-	return c.Now().Truncate(24 * time.Hour)
+	return c.TodayIn(c.location)
+}
+
+func (c *SystemClock) TodayIn(loc *time.Location) time.Time {
+	return startOfDay(c.Now(), loc)
 }
 
 type MockClock struct {
@@ -52,5 +59,16 @@ func (c *MockClock) Now() time.Time {
 }
 
 func (c *MockClock) Today() time.Time {
-	return c.fixedTime.Truncate(24 * time.Hour)
+	return c.TodayIn(c.fixedTime.Location())
+}
+
+func (c *MockClock) TodayIn(loc *time.Location) time.Time {
+	return startOfDay(c.fixedTime, loc)
+}
+
+// startOfDay returns 00:00:00 of the day containing t in loc.
+// Truncate(24 * time.Hour) cannot be used because it aligns to UTC.
+func startOfDay(t time.Time, loc *time.Location) time.Time {
+	y, m, d := t.In(loc).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, loc)
 }
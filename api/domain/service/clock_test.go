@@ -3,6 +3,7 @@ package service_test
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/takuoki/google-calendar-sync/api/domain/service"
 )
@@ -41,3 +42,71 @@ func TestSystemClock_Today(t *testing.T) {
 		t.Error("expected non-zero time, got zero value")
 	}
 }
+
+func TestSystemClock_TodayIn(t *testing.T) {
+	clock, err := service.NewSystemClock("UTC")
+	if err != nil {
+		t.Fatalf("failed to create SystemClock: %v", err)
+	}
+
+	loc, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+
+	today := clock.TodayIn(loc)
+	now := clock.Now()
+
+	if today.Location() != loc {
+		t.Errorf("expected location %v, got %v", loc, today.Location())
+	}
+	if today.Hour() != 0 || today.Minute() != 0 || today.Second() != 0 || today.Nanosecond() != 0 {
+		t.Errorf("expected start of day, got %v", today)
+	}
+	if now.Before(today) || !now.Before(today.Add(24*time.Hour)) {
+		t.Errorf("expected %v to be within the day starting at %v", now, today)
+	}
+}
+
+func TestMockClock_TodayIn(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+
+	fixedTime := time.Date(2025, 1, 1, 20, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		loc      *time.Location
+		expected time.Time
+	}{
+		"UTC": {
+			loc:      time.UTC,
+			expected: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"Asia/Tokyo (next day)": {
+			loc:      tokyo,
+			expected: time.Date(2025, 1, 2, 0, 0, 0, 0, tokyo),
+		},
+		"America/New_York (same day)": {
+			loc:      newYork,
+			expected: time.Date(2025, 1, 1, 0, 0, 0, 0, newYork),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			clock := service.NewMockClock()
+			clock.SetFixedTime(fixedTime)
+
+			today := clock.TodayIn(tt.loc)
+			if !today.Equal(tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, today)
+			}
+		})
+	}
+}
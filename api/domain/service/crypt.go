@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
@@ -8,6 +9,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
+	"time"
 )
 
 type Crypt interface {
@@ -41,18 +44,26 @@ func NewAESCrypt(key []byte) (*AESCrypt, error) {
 
 // Encrypt encrypts plaintext using AES-GCM
 func (c *AESCrypt) Encrypt(plaintext string) (string, error) {
+	return c.encrypt(plaintext, nil)
+}
+
+func (c *AESCrypt) encrypt(plaintext string, additionalData []byte) (string, error) {
 
 	nonce := make([]byte, c.aesGCM.NonceSize())
 	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
 		return "", fmt.Errorf("fail to generate nonce: %w", err)
 	}
 
-	ciphertext := c.aesGCM.Seal(nonce, nonce, []byte(plaintext), nil)
+	ciphertext := c.aesGCM.Seal(nonce, nonce, []byte(plaintext), additionalData)
 	return base64.StdEncoding.EncodeToString(ciphertext), nil
 }
 
 // Decrypt decrypts ciphertext using AES-GCM
 func (c *AESCrypt) Decrypt(ciphertext string) (string, error) {
+	return c.decrypt(ciphertext, nil)
+}
+
+func (c *AESCrypt) decrypt(ciphertext string, additionalData []byte) (string, error) {
 	decodedCiphertext, err := base64.StdEncoding.DecodeString(ciphertext)
 	if err != nil {
 		return "", fmt.Errorf("fail to decode base64 ciphertext: %w", err)
@@ -64,10 +75,236 @@ func (c *AESCrypt) Decrypt(ciphertext string) (string, error) {
 	}
 
 	nonce, ciphertextData := decodedCiphertext[:nonceSize], decodedCiphertext[nonceSize:]
-	plaintext, err := c.aesGCM.Open(nil, nonce, ciphertextData, nil)
+	plaintext, err := c.aesGCM.Open(nil, nonce, ciphertextData, additionalData)
 	if err != nil {
 		return "", fmt.Errorf("fail to decrypt ciphertext: %w", err)
 	}
 
 	return string(plaintext), nil
 }
+
+// LegacyKeyID is the key ID used to decrypt ciphertexts without a version and a key ID,
+// which were encrypted by AESCrypt before the keyring was introduced.
+const LegacyKeyID = "legacy"
+
+// ciphertextVersion is the prefix of ciphertexts encrypted by KeyringCrypt.
+const ciphertextVersion = "v1"
+
+// KeyringCrypt encrypts with the active key, and decrypts with the key identified in the ciphertext,
+// so that the key can be rotated without breaking the stored ciphertexts.
+// The ciphertext is in the format of "v1:<keyID>:<base64>".
+type KeyringCrypt struct {
+	activeKeyID string
+	crypts      map[string]*AESCrypt
+}
+
+func NewKeyringCrypt(keys map[string][]byte, activeKeyID string) (*KeyringCrypt, error) {
+
+	crypts := make(map[string]*AESCrypt, len(keys))
+	for keyID, key := range keys {
+		if keyID == "" || strings.ContainsAny(keyID, ":,") {
+			return nil, fmt.Errorf("invalid key id: %q", keyID)
+		}
+
+		crypt, err := NewAESCrypt(key)
+		if err != nil {
+			return nil, fmt.Errorf("fail to create AES crypt (keyID: %q): %w", keyID, err)
+		}
+		crypts[keyID] = crypt
+	}
+
+	if _, ok := crypts[activeKeyID]; !ok {
+		return nil, fmt.Errorf("active key is not found in the keyring: %q", activeKeyID)
+	}
+
+	return &KeyringCrypt{
+		activeKeyID: activeKeyID,
+		crypts:      crypts,
+	}, nil
+}
+
+// Encrypt encrypts plaintext with the active key.
+func (c *KeyringCrypt) Encrypt(plaintext string) (string, error) {
+
+	prefix := ciphertextVersion + ":" + c.activeKeyID + ":"
+
+	// 鍵 ID の書き換えを検知できるよう、プレフィックスを追加データとして認証する
+	ciphertext, err := c.crypts[c.activeKeyID].encrypt(plaintext, []byte(prefix))
+	if err != nil {
+		return "", err
+	}
+
+	return prefix + ciphertext, nil
+}
+
+// Decrypt decrypts ciphertext with the key identified in it.
+// Ciphertexts without a version are decrypted with the legacy key.
+func (c *KeyringCrypt) Decrypt(ciphertext string) (string, error) {
+
+	if !strings.HasPrefix(ciphertext, ciphertextVersion+":") {
+		legacy, ok := c.crypts[LegacyKeyID]
+		if !ok {
+			return "", fmt.Errorf("legacy key is required to decrypt ciphertext without version")
+		}
+		return legacy.Decrypt(ciphertext)
+	}
+
+	parts := strings.SplitN(ciphertext, ":", 3)
+	if len(parts) != 3 {
+		return "", fmt.Errorf("invalid ciphertext format")
+	}
+
+	keyID := parts[1]
+	crypt, ok := c.crypts[keyID]
+	if !ok {
+		return "", fmt.Errorf("key is not found in the keyring: %q", keyID)
+	}
+
+	return crypt.decrypt(parts[2], []byte(parts[0]+":"+keyID+":"))
+}
+
+// ParseCryptKeys parses keys in the format of "<keyID>:<key>,<keyID>:<key>,...".
+// Each key is used as is, in the same way as CRYPT_KEY, so it must not contain a comma.
+func ParseCryptKeys(s string) (map[string][]byte, error) {
+
+	keys := map[string][]byte{}
+	for _, entry := range strings.Split(s, ",") {
+		if entry == "" {
+			continue
+		}
+
+		keyID, key, ok := strings.Cut(entry, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid key format: key id and key must be separated by colon")
+		}
+
+		if _, ok := keys[keyID]; ok {
+			return nil, fmt.Errorf("duplicate key id: %q", keyID)
+		}
+
+		keys[keyID] = []byte(key)
+	}
+
+	return keys, nil
+}
+
+// envelopeCiphertextVersion is the prefix of ciphertexts encrypted by EnvelopeCrypt.
+const envelopeCiphertextVersion = "env1"
+
+// Unwrapped data keys are kept in memory only for a limited time and number.
+const (
+	dataKeyCacheTTL     = 1 * time.Hour
+	dataKeyCacheMaxSize = 1000
+)
+
+// keyManagerTimeout limits the time to wrap or unwrap a data key, since Crypt does not take a context.
+const keyManagerTimeout = 10 * time.Second
+
+// EnvelopeCrypt encrypts each plaintext with its own data key, and stores the data key wrapped by the key manager.
+// The ciphertext is in the format of "env1:<base64 wrapped key>:<base64>".
+type EnvelopeCrypt struct {
+	keyManager KeyManager
+	// fallback decrypts ciphertexts encrypted before switching to envelope encryption.
+	fallback Crypt
+	// dataKeyCache avoids calling the key manager every time the same record is decrypted.
+	dataKeyCache Cache[string, *AESCrypt]
+}
+
+// NewEnvelopeCrypt returns an EnvelopeCrypt.
+// Ciphertexts not encrypted by EnvelopeCrypt are decrypted with fallback if it is not nil.
+func NewEnvelopeCrypt(keyManager KeyManager, fallback Crypt) (*EnvelopeCrypt, error) {
+
+	if keyManager == nil {
+		return nil, errors.New("key manager is required")
+	}
+
+	return &EnvelopeCrypt{
+		keyManager:   keyManager,
+		fallback:     fallback,
+		dataKeyCache: NewInMemoryCache[string, *AESCrypt](WithTTL(dataKeyCacheTTL), WithMaxSize(dataKeyCacheMaxSize)),
+	}, nil
+}
+
+// Encrypt encrypts plaintext with a new data key.
+func (c *EnvelopeCrypt) Encrypt(plaintext string) (string, error) {
+
+	dataKey := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return "", fmt.Errorf("fail to generate data key: %w", err)
+	}
+
+	crypt, err := NewAESCrypt(dataKey)
+	if err != nil {
+		return "", fmt.Errorf("fail to create AES crypt: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), keyManagerTimeout)
+	defer cancel()
+
+	wrappedKey, err := c.keyManager.WrapKey(ctx, dataKey)
+	if err != nil {
+		return "", fmt.Errorf("fail to wrap data key: %w", err)
+	}
+
+	prefix := envelopeCiphertextVersion + ":" + base64.StdEncoding.EncodeToString(wrappedKey) + ":"
+
+	// ラップされたデータキーの差し替えを検知できるよう、プレフィックスを追加データとして認証する
+	ciphertext, err := crypt.encrypt(plaintext, []byte(prefix))
+	if err != nil {
+		return "", err
+	}
+
+	return prefix + ciphertext, nil
+}
+
+// Decrypt decrypts ciphertext with the data key unwrapped by the key manager.
+func (c *EnvelopeCrypt) Decrypt(ciphertext string) (string, error) {
+
+	if !strings.HasPrefix(ciphertext, envelopeCiphertextVersion+":") {
+		if c.fallback == nil {
+			return "", fmt.Errorf("ciphertext is not encrypted with envelope encryption")
+		}
+		return c.fallback.Decrypt(ciphertext)
+	}
+
+	parts := strings.SplitN(ciphertext, ":", 3)
+	if len(parts) != 3 {
+		return "", fmt.Errorf("invalid ciphertext format")
+	}
+
+	crypt, err := c.dataKeyCrypt(parts[1])
+	if err != nil {
+		return "", err
+	}
+
+	return crypt.decrypt(parts[2], []byte(parts[0]+":"+parts[1]+":"))
+}
+
+func (c *EnvelopeCrypt) dataKeyCrypt(encodedWrappedKey string) (*AESCrypt, error) {
+
+	if crypt, ok := c.dataKeyCache.Get(encodedWrappedKey); ok {
+		return crypt, nil
+	}
+
+	wrappedKey, err := base64.StdEncoding.DecodeString(encodedWrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("fail to decode base64 wrapped key: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), keyManagerTimeout)
+	defer cancel()
+
+	dataKey, err := c.keyManager.UnwrapKey(ctx, wrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("fail to unwrap data key: %w", err)
+	}
+
+	crypt, err := NewAESCrypt(dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("fail to create AES crypt: %w", err)
+	}
+
+	c.dataKeyCache.Set(encodedWrappedKey, crypt)
+
+	return crypt, nil
+}
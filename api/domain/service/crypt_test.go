@@ -80,3 +80,242 @@ func TestAESCrypt_EmptyCiphertext(t *testing.T) {
 		t.Errorf("error message does not match the expected format, got: %s", err.Error())
 	}
 }
+
+func TestKeyringCrypt_Rotation(t *testing.T) {
+	oldKey := []byte("12345678901234567890123456789012")
+	newKey := []byte("abcdefghijklmnopqrstuvwxyz012345")
+	plaintext := "Hello, World!"
+
+	// Encrypt with the old key
+	oldCrypt, err := service.NewKeyringCrypt(map[string][]byte{"old": oldKey}, "old")
+	if err != nil {
+		t.Fatalf("failed to create KeyringCrypt: %v", err)
+	}
+
+	oldCiphertext, err := oldCrypt.Encrypt(plaintext)
+	if err != nil {
+		t.Fatalf("failed to encrypt: %v", err)
+	}
+	if !strings.HasPrefix(oldCiphertext, "v1:old:") {
+		t.Errorf("ciphertext does not have the key id, got: %s", oldCiphertext)
+	}
+
+	// Rotate to the new key
+	newCrypt, err := service.NewKeyringCrypt(map[string][]byte{"old": oldKey, "new": newKey}, "new")
+	if err != nil {
+		t.Fatalf("failed to create KeyringCrypt: %v", err)
+	}
+
+	decryptedText, err := newCrypt.Decrypt(oldCiphertext)
+	if err != nil {
+		t.Fatalf("failed to decrypt: %v", err)
+	}
+	if decryptedText != plaintext {
+		t.Errorf("decrypted text does not match plaintext: got %s, want %s", decryptedText, plaintext)
+	}
+
+	newCiphertext, err := newCrypt.Encrypt(plaintext)
+	if err != nil {
+		t.Fatalf("failed to encrypt: %v", err)
+	}
+	if !strings.HasPrefix(newCiphertext, "v1:new:") {
+		t.Errorf("ciphertext does not have the active key id, got: %s", newCiphertext)
+	}
+}
+
+func TestKeyringCrypt_Legacy(t *testing.T) {
+	key := []byte("12345678901234567890123456789012") // 32 bytes key
+	plaintext := "Hello, World!"
+
+	// Ciphertext encrypted before the keyring was introduced
+	aesCrypt, err := service.NewAESCrypt(key)
+	if err != nil {
+		t.Fatalf("failed to create AESCrypt: %v", err)
+	}
+
+	legacyCiphertext, err := aesCrypt.Encrypt(plaintext)
+	if err != nil {
+		t.Fatalf("failed to encrypt: %v", err)
+	}
+
+	crypt, err := service.NewKeyringCrypt(map[string][]byte{service.LegacyKeyID: key}, service.LegacyKeyID)
+	if err != nil {
+		t.Fatalf("failed to create KeyringCrypt: %v", err)
+	}
+
+	decryptedText, err := crypt.Decrypt(legacyCiphertext)
+	if err != nil {
+		t.Fatalf("failed to decrypt: %v", err)
+	}
+	if decryptedText != plaintext {
+		t.Errorf("decrypted text does not match plaintext: got %s, want %s", decryptedText, plaintext)
+	}
+}
+
+func TestKeyringCrypt_DecryptFailure(t *testing.T) {
+	keys := map[string][]byte{
+		"key1": []byte("12345678901234567890123456789012"),
+		"key2": []byte("abcdefghijklmnopqrstuvwxyz012345"),
+	}
+
+	crypt, err := service.NewKeyringCrypt(keys, "key1")
+	if err != nil {
+		t.Fatalf("failed to create KeyringCrypt: %v", err)
+	}
+
+	ciphertext, err := crypt.Encrypt("Hello, World!")
+	if err != nil {
+		t.Fatalf("failed to encrypt: %v", err)
+	}
+
+	tests := map[string]struct {
+		ciphertext string
+		errPrefix  string
+	}{
+		"unknown key id": {
+			ciphertext: strings.Replace(ciphertext, "v1:key1:", "v1:key3:", 1),
+			errPrefix:  "key is not found in the keyring",
+		},
+		"tampered key id": {
+			ciphertext: strings.Replace(ciphertext, "v1:key1:", "v1:key2:", 1),
+			errPrefix:  "fail to decrypt ciphertext",
+		},
+		"without legacy key": {
+			ciphertext: "bGVnYWN5",
+			errPrefix:  "legacy key is required",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := crypt.Decrypt(tt.ciphertext)
+			if err == nil {
+				t.Error("expected error, got nil")
+			} else if !strings.HasPrefix(err.Error(), tt.errPrefix) {
+				t.Errorf("error message does not match the expected format, got: %s", err.Error())
+			}
+		})
+	}
+}
+
+func TestNewKeyringCrypt_ActiveKeyNotFound(t *testing.T) {
+	_, err := service.NewKeyringCrypt(map[string][]byte{"key1": []byte("12345678901234567890123456789012")}, "key2")
+	if err == nil {
+		t.Error("expected error for active key not found, got nil")
+	} else if !strings.HasPrefix(err.Error(), "active key is not found") {
+		t.Errorf("error message does not match the expected format, got: %s", err.Error())
+	}
+}
+
+func TestParseCryptKeys(t *testing.T) {
+	keys, err := service.ParseCryptKeys("key1:12345678901234567890123456789012,key2:abcdefghijklmnopqrstuvwxyz012345")
+	if err != nil {
+		t.Fatalf("failed to parse crypt keys: %v", err)
+	}
+
+	if string(keys["key1"]) != "12345678901234567890123456789012" {
+		t.Errorf("key1 does not match, got: %s", keys["key1"])
+	}
+	if string(keys["key2"]) != "abcdefghijklmnopqrstuvwxyz012345" {
+		t.Errorf("key2 does not match, got: %s", keys["key2"])
+	}
+}
+
+func TestEnvelopeCrypt_Success(t *testing.T) {
+	plaintext := "Hello, World!"
+
+	keyManager, err := service.NewFileKeyManager(writeKeyFile(t))
+	if err != nil {
+		t.Fatalf("failed to create FileKeyManager: %v", err)
+	}
+
+	crypt, err := service.NewEnvelopeCrypt(keyManager, nil)
+	if err != nil {
+		t.Fatalf("failed to create EnvelopeCrypt: %v", err)
+	}
+
+	ciphertext, err := crypt.Encrypt(plaintext)
+	if err != nil {
+		t.Fatalf("failed to encrypt: %v", err)
+	}
+	if !strings.HasPrefix(ciphertext, "env1:") {
+		t.Errorf("ciphertext does not have the version, got: %s", ciphertext)
+	}
+
+	// Each plaintext is encrypted with its own data key
+	otherCiphertext, err := crypt.Encrypt(plaintext)
+	if err != nil {
+		t.Fatalf("failed to encrypt: %v", err)
+	}
+	if strings.Split(ciphertext, ":")[1] == strings.Split(otherCiphertext, ":")[1] {
+		t.Error("data key is reused")
+	}
+
+	decryptedText, err := crypt.Decrypt(ciphertext)
+	if err != nil {
+		t.Fatalf("failed to decrypt: %v", err)
+	}
+	if decryptedText != plaintext {
+		t.Errorf("decrypted text does not match plaintext: got %s, want %s", decryptedText, plaintext)
+	}
+}
+
+func TestEnvelopeCrypt_Fallback(t *testing.T) {
+	plaintext := "Hello, World!"
+
+	keyManager, err := service.NewFileKeyManager(writeKeyFile(t))
+	if err != nil {
+		t.Fatalf("failed to create FileKeyManager: %v", err)
+	}
+
+	keyringCrypt, err := service.NewKeyringCrypt(
+		map[string][]byte{"key1": []byte("abcdefghijklmnopqrstuvwxyz012345")}, "key1")
+	if err != nil {
+		t.Fatalf("failed to create KeyringCrypt: %v", err)
+	}
+
+	// Ciphertext encrypted before switching to envelope encryption
+	keyringCiphertext, err := keyringCrypt.Encrypt(plaintext)
+	if err != nil {
+		t.Fatalf("failed to encrypt: %v", err)
+	}
+
+	tests := map[string]struct {
+		fallback  service.Crypt
+		errPrefix string
+	}{
+		"with fallback": {
+			fallback: keyringCrypt,
+		},
+		"without fallback": {
+			fallback:  nil,
+			errPrefix: "ciphertext is not encrypted with envelope encryption",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			crypt, err := service.NewEnvelopeCrypt(keyManager, tt.fallback)
+			if err != nil {
+				t.Fatalf("failed to create EnvelopeCrypt: %v", err)
+			}
+
+			decryptedText, err := crypt.Decrypt(keyringCiphertext)
+			if tt.errPrefix != "" {
+				if err == nil {
+					t.Error("expected error, got nil")
+				} else if !strings.HasPrefix(err.Error(), tt.errPrefix) {
+					t.Errorf("error message does not match the expected format, got: %s", err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("failed to decrypt: %v", err)
+			}
+			if decryptedText != plaintext {
+				t.Errorf("decrypted text does not match plaintext: got %s, want %s", decryptedText, plaintext)
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+package service
+
+func SetWaitHook[K comparable, V any](c *InMemoryCache[K, V], hook func()) {
+	c.waitHook = hook
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// KeyManager wraps and unwraps data keys with a key-encryption key held by the key manager.
+type KeyManager interface {
+	WrapKey(ctx context.Context, dataKey []byte) ([]byte, error)
+	UnwrapKey(ctx context.Context, wrappedKey []byte) ([]byte, error)
+}
+
+// FileKeyManager is a KeyManager whose key-encryption key is read from a local file.
+// It is a stand-in for a KMS in development and tests.
+type FileKeyManager struct {
+	crypt *AESCrypt
+}
+
+// NewFileKeyManager reads the base64-encoded 32-byte key-encryption key from the file.
+func NewFileKeyManager(path string) (*FileKeyManager, error) {
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("fail to read key file: %w", err)
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(content)))
+	if err != nil {
+		return nil, fmt.Errorf("fail to decode base64 key: %w", err)
+	}
+
+	crypt, err := NewAESCrypt(key)
+	if err != nil {
+		return nil, fmt.Errorf("fail to create AES crypt: %w", err)
+	}
+
+	return &FileKeyManager{
+		crypt: crypt,
+	}, nil
+}
+
+func (m *FileKeyManager) WrapKey(ctx context.Context, dataKey []byte) ([]byte, error) {
+
+	wrappedKey, err := m.crypt.Encrypt(string(dataKey))
+	if err != nil {
+		return nil, fmt.Errorf("fail to wrap data key: %w", err)
+	}
+
+	return []byte(wrappedKey), nil
+}
+
+func (m *FileKeyManager) UnwrapKey(ctx context.Context, wrappedKey []byte) ([]byte, error) {
+
+	dataKey, err := m.crypt.Decrypt(string(wrappedKey))
+	if err != nil {
+		return nil, fmt.Errorf("fail to unwrap data key: %w", err)
+	}
+
+	return []byte(dataKey), nil
+}
@@ -0,0 +1,64 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/takuoki/google-calendar-sync/api/domain/service"
+)
+
+// writeKeyFile writes the base64-encoded key "12345678901234567890123456789012" to a temporary file.
+func writeKeyFile(t *testing.T) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "key")
+	if err := os.WriteFile(path, []byte("MTIzNDU2Nzg5MDEyMzQ1Njc4OTAxMjM0NTY3ODkwMTI=\n"), 0o600); err != nil {
+		t.Fatalf("failed to write key file: %v", err)
+	}
+
+	return path
+}
+
+func TestFileKeyManager_Success(t *testing.T) {
+	ctx := context.Background()
+	dataKey := []byte("abcdefghijklmnopqrstuvwxyz012345")
+
+	keyManager, err := service.NewFileKeyManager(writeKeyFile(t))
+	if err != nil {
+		t.Fatalf("failed to create FileKeyManager: %v", err)
+	}
+
+	wrappedKey, err := keyManager.WrapKey(ctx, dataKey)
+	if err != nil {
+		t.Fatalf("failed to wrap key: %v", err)
+	}
+	if bytes.Contains(wrappedKey, dataKey) {
+		t.Error("wrapped key contains the data key")
+	}
+
+	unwrappedKey, err := keyManager.UnwrapKey(ctx, wrappedKey)
+	if err != nil {
+		t.Fatalf("failed to unwrap key: %v", err)
+	}
+	if !bytes.Equal(unwrappedKey, dataKey) {
+		t.Errorf("unwrapped key does not match data key: got %s, want %s", unwrappedKey, dataKey)
+	}
+}
+
+func TestNewFileKeyManager_InvalidKey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "key")
+	if err := os.WriteFile(path, []byte("c2hvcnRrZXk="), 0o600); err != nil {
+		t.Fatalf("failed to write key file: %v", err)
+	}
+
+	_, err := service.NewFileKeyManager(path)
+	if err == nil {
+		t.Error("expected error for invalid key length, got nil")
+	} else if !strings.HasPrefix(err.Error(), "fail to create AES crypt") {
+		t.Errorf("error message does not match the expected format, got: %s", err.Error())
+	}
+}
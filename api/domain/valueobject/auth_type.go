@@ -0,0 +1,24 @@
+package valueobject
+
+// AuthType is the way to authenticate to Google Calendar for a calendar.
+type AuthType string
+
+const (
+	AuthTypeServiceAccount AuthType = "service_account"
+	AuthTypeOAuth          AuthType = "oauth"
+	// AuthTypeDelegation impersonates a Google Workspace user with the service account via domain-wide delegation.
+	AuthTypeDelegation AuthType = "domain_wide_delegation"
+)
+
+// AuthTypeOf returns the auth type of a calendar registered with the refresh token or the subject.
+// A calendar with a subject is accessed via domain-wide delegation, one with a refresh token via user OAuth,
+// and otherwise via the service account.
+func AuthTypeOf(refreshToken, subject *string) AuthType {
+	if subject != nil {
+		return AuthTypeDelegation
+	}
+	if refreshToken != nil {
+		return AuthTypeOAuth
+	}
+	return AuthTypeServiceAccount
+}
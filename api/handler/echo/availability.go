@@ -0,0 +1,169 @@
+package echo
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	echo "github.com/labstack/echo/v4"
+	"github.com/takuoki/google-calendar-sync/api/domain"
+	"github.com/takuoki/google-calendar-sync/api/domain/entity"
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+	"github.com/takuoki/google-calendar-sync/api/openapi"
+)
+
+const (
+	defaultAvailableSlotLimit = 10
+	maxAvailableSlotLimit     = 100
+
+	maxAvailabilityDuration = 24 * time.Hour
+	maxAvailabilityBuffer   = 4 * time.Hour
+)
+
+var weekdays = map[openapi.WorkingHoursDays]time.Weekday{
+	openapi.SU: time.Sunday,
+	openapi.MO: time.Monday,
+	openapi.TU: time.Tuesday,
+	openapi.WE: time.Wednesday,
+	openapi.TH: time.Thursday,
+	openapi.FR: time.Friday,
+	openapi.SA: time.Saturday,
+}
+
+func (h *handler) PostAvailability(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var req openapi.PostAvailabilityJSONBody
+	if err := c.Bind(&req); err != nil {
+		return domain.InvalidJSONError
+	}
+
+	if len(req.Calendars) == 0 {
+		return domain.RequiredError("calendars")
+	}
+	if len(req.Calendars) > maxFreeBusyCalendars {
+		return domain.InvalidParameterError("calendars")
+	}
+	if req.From.IsZero() {
+		return domain.RequiredError("from")
+	}
+	if req.To.IsZero() {
+		return domain.RequiredError("to")
+	}
+	if req.DurationMinutes == 0 {
+		return domain.RequiredError("durationMinutes")
+	}
+
+	duration := time.Duration(req.DurationMinutes) * time.Minute
+	if duration < 0 || duration > maxAvailabilityDuration {
+		return domain.InvalidParameterError("durationMinutes")
+	}
+
+	var buffer time.Duration
+	if req.BufferMinutes != nil {
+		buffer = time.Duration(*req.BufferMinutes) * time.Minute
+		if buffer < 0 || buffer > maxAvailabilityBuffer {
+			return domain.InvalidParameterError("bufferMinutes")
+		}
+	}
+
+	limit := defaultAvailableSlotLimit
+	if req.Limit != nil {
+		if *req.Limit < 1 || *req.Limit > maxAvailableSlotLimit {
+			return domain.InvalidParameterError("limit")
+		}
+		limit = *req.Limit
+	}
+
+	participants := make([]entity.AvailabilityParticipant, 0, len(req.Calendars))
+	for _, calendar := range req.Calendars {
+		if calendar.CalendarId == "" {
+			return domain.RequiredError("calendarId")
+		}
+
+		participant := entity.AvailabilityParticipant{CalendarID: valueobject.CalendarID(calendar.CalendarId)}
+		if calendar.WorkingHours != nil {
+			workingHours, err := convertWorkingHours(*calendar.WorkingHours)
+			if err != nil {
+				return err
+			}
+			participant.WorkingHours = workingHours
+		}
+		participants = append(participants, participant)
+	}
+
+	slots, err := h.eventUsecase.FindAvailability(ctx, participants, req.From, req.To, duration, buffer, limit)
+	if err != nil {
+		return fmt.Errorf("fail to find availability: %w", err)
+	}
+
+	res := openapi.AvailabilityResponse{
+		Status: statusSuccess,
+		Slots:  make([]openapi.AvailableSlot, 0, len(slots)),
+	}
+	for _, slot := range slots {
+		res.Slots = append(res.Slots, openapi.AvailableSlot{
+			Start:         slot.Start,
+			End:           slot.End,
+			MarginMinutes: int(slot.Margin / time.Minute),
+		})
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func convertWorkingHours(workingHours openapi.WorkingHours) (*entity.WorkingHours, error) {
+	start, ok := parseTimeOfDay(workingHours.Start)
+	if !ok {
+		return nil, domain.InvalidParameterError("workingHours.start")
+	}
+	end, ok := parseTimeOfDay(workingHours.End)
+	if !ok || end <= start {
+		return nil, domain.InvalidParameterError("workingHours.end")
+	}
+
+	if len(workingHours.Days) == 0 {
+		return nil, domain.RequiredError("workingHours.days")
+	}
+	days := make([]time.Weekday, 0, len(workingHours.Days))
+	for _, day := range workingHours.Days {
+		weekday, ok := weekdays[day]
+		if !ok {
+			return nil, domain.InvalidParameterError("workingHours.days")
+		}
+		days = append(days, weekday)
+	}
+
+	// タイムゾーン未指定の場合は usecase でカレンダーのタイムゾーンを使う
+	var loc *time.Location
+	if workingHours.TimeZone != nil {
+		var err error
+		loc, err = time.LoadLocation(*workingHours.TimeZone)
+		if err != nil {
+			return nil, domain.InvalidParameterError("workingHours.timeZone")
+		}
+	}
+
+	return &entity.WorkingHours{
+		Start:    start,
+		End:      end,
+		Weekdays: days,
+		Location: loc,
+	}, nil
+}
+
+// parseTimeOfDay parses the time of day in "HH:MM" format, from "00:00" to "24:00".
+func parseTimeOfDay(s string) (time.Duration, bool) {
+	var hour, minute int
+	if len(s) != 5 {
+		return 0, false
+	}
+	if _, err := fmt.Sscanf(s, "%02d:%02d", &hour, &minute); err != nil {
+		return 0, false
+	}
+	if hour < 0 || minute < 0 || minute > 59 || hour > 24 || (hour == 24 && minute != 0) {
+		return 0, false
+	}
+
+	return time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute, true
+}
@@ -1,14 +1,50 @@
 package echo
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
 
 	echo "github.com/labstack/echo/v4"
 	"github.com/takuoki/google-calendar-sync/api/domain"
+	"github.com/takuoki/google-calendar-sync/api/domain/entity"
 	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
 	"github.com/takuoki/google-calendar-sync/api/openapi"
 )
 
+func (h *handler) GetCalendars(c echo.Context, params openapi.GetCalendarsParams) error {
+	ctx := c.Request().Context()
+
+	calendars, err := h.calendarUsecase.List(ctx, params.Tag)
+	if err != nil {
+		return fmt.Errorf("fail to list calendars: %w", err)
+	}
+
+	res := openapi.CalendarListResponse{
+		Status:    statusSuccess,
+		Calendars: make([]openapi.Calendar, 0, len(calendars)),
+	}
+	for _, calendar := range calendars {
+		res.Calendars = append(res.Calendars, convertCalendar(calendar))
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (h *handler) GetCalendarsCalendarId(c echo.Context, calendarID string) error {
+	ctx := c.Request().Context()
+
+	calendar, err := h.calendarUsecase.Get(ctx, valueobject.CalendarID(calendarID))
+	if err != nil {
+		return fmt.Errorf("fail to get calendar: %w", err)
+	}
+
+	return c.JSON(http.StatusOK, openapi.CalendarResponse{
+		Status:   statusSuccess,
+		Calendar: convertCalendar(*calendar),
+	})
+}
+
 func (h *handler) PostCalendarsCalendarId(c echo.Context, calendarID string) error {
 	ctx := c.Request().Context()
 
@@ -21,10 +57,166 @@ func (h *handler) PostCalendarsCalendarId(c echo.Context, calendarID string) err
 		return domain.RequiredError("name")
 	}
 
-	err := h.calendarUsecase.Create(ctx, valueobject.CalendarID(calendarID), *req.Name, req.RefreshToken)
+	var tags []string
+	if req.Tags != nil {
+		tags = *req.Tags
+	}
+
+	err := h.calendarUsecase.Create(ctx, valueobject.CalendarID(calendarID), *req.Name, req.RefreshToken, req.Subject, tags)
 	if err != nil {
 		return fmt.Errorf("fail to create calendar: %w", err)
 	}
 
 	return success(c)
 }
+
+func (h *handler) PostCalendarsBulk(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var req openapi.PostCalendarsBulkJSONBody
+	if err := c.Bind(&req); err != nil {
+		return domain.InvalidJSONError
+	}
+
+	calendars := make([]entity.Calendar, 0, len(req.Calendars))
+	for _, calendar := range req.Calendars {
+		// カレンダーごとの認証情報がない場合は、共通の認証情報を使用する
+		refreshToken, subject := calendar.RefreshToken, calendar.Subject
+		if refreshToken == nil && subject == nil {
+			refreshToken, subject = req.RefreshToken, req.Subject
+		}
+		var tags []string
+		if calendar.Tags != nil {
+			tags = *calendar.Tags
+		}
+		calendars = append(calendars, entity.Calendar{
+			ID:           valueobject.CalendarID(calendar.Id),
+			Name:         calendar.Name,
+			RefreshToken: refreshToken,
+			Subject:      subject,
+			Tags:         tags,
+		})
+	}
+
+	watch := req.Watch != nil && *req.Watch
+
+	watchFailures, err := h.calendarUsecase.BulkCreate(ctx, calendars, watch)
+	if err != nil {
+		return fmt.Errorf("fail to bulk create calendars: %w", err)
+	}
+
+	res := openapi.CalendarBulkResponse{
+		Status:        statusSuccess,
+		WatchFailures: make([]openapi.WatchFailure, 0, len(watchFailures)),
+	}
+	for _, failure := range watchFailures {
+		// 内部エラーの詳細はレスポンスに含めない（ログには usecase で出力済み）
+		code, detail := errorCodeInternal, "internal server error"
+		var e *domain.ClientError
+		if errors.As(failure.Err, &e) {
+			code, detail = e.Code, e.Message
+		}
+		res.WatchFailures = append(res.WatchFailures, openapi.WatchFailure{
+			CalendarId: string(failure.CalendarID),
+			Code:       code,
+			Detail:     detail,
+		})
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (h *handler) PatchCalendarsCalendarId(c echo.Context, calendarID string) error {
+	ctx := c.Request().Context()
+
+	var req openapi.PatchCalendarsCalendarIdJSONBody
+	if err := c.Bind(&req); err != nil {
+		return domain.InvalidJSONError
+	}
+
+	err := h.calendarUsecase.Update(ctx, valueobject.CalendarID(calendarID), req.Name, req.RefreshToken, req.Subject, req.Tags)
+	if err != nil {
+		return fmt.Errorf("fail to update calendar: %w", err)
+	}
+
+	return success(c)
+}
+
+func (h *handler) DeleteCalendarsCalendarId(c echo.Context, calendarID string) error {
+	ctx := c.Request().Context()
+
+	if err := h.calendarUsecase.Delete(ctx, valueobject.CalendarID(calendarID)); err != nil {
+		return fmt.Errorf("fail to delete calendar: %w", err)
+	}
+
+	return success(c)
+}
+
+func (h *handler) PostCalendarsCalendarIdPause(c echo.Context, calendarID string) error {
+	ctx := c.Request().Context()
+
+	if err := h.calendarUsecase.Pause(ctx, valueobject.CalendarID(calendarID)); err != nil {
+		return fmt.Errorf("fail to pause calendar: %w", err)
+	}
+
+	return success(c)
+}
+
+func (h *handler) PostCalendarsCalendarIdResume(c echo.Context, calendarID string) error {
+	ctx := c.Request().Context()
+
+	if err := h.calendarUsecase.Resume(ctx, valueobject.CalendarID(calendarID)); err != nil {
+		return fmt.Errorf("fail to resume calendar: %w", err)
+	}
+
+	return success(c)
+}
+
+func (h *handler) GetDiscover(c echo.Context, params openapi.GetDiscoverParams) error {
+	ctx := c.Request().Context()
+
+	entries, err := h.calendarUsecase.Discover(ctx, entity.Credential{
+		RefreshToken: params.XRefreshToken,
+		Subject:      params.Subject,
+	})
+	if err != nil {
+		return fmt.Errorf("fail to discover calendars: %w", err)
+	}
+
+	res := openapi.DiscoverResponse{
+		Status:    statusSuccess,
+		Calendars: make([]openapi.DiscoveredCalendar, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		res.Calendars = append(res.Calendars, openapi.DiscoveredCalendar{
+			Id:         string(entry.ID),
+			Summary:    entry.Summary,
+			AccessRole: entry.AccessRole,
+			Primary:    entry.Primary,
+			TimeZone:   entry.TimeZone,
+		})
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func convertCalendar(calendar entity.Calendar) openapi.Calendar {
+	tags := calendar.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	return openapi.Calendar{
+		Id:              string(calendar.ID),
+		Name:            calendar.Name,
+		HasRefreshToken: calendar.RefreshToken != nil,
+		AuthType:        openapi.CalendarAuthType(calendar.AuthType),
+		Subject:         calendar.Subject,
+		IsPaused:        calendar.IsPaused,
+		NeedsReauthAt:   calendar.NeedsReauthAt,
+		Tags:            tags,
+		Summary:         calendar.Summary,
+		TimeZone:        calendar.TimeZone,
+		AccessRole:      calendar.AccessRole,
+	}
+}
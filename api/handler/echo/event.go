@@ -0,0 +1,237 @@
+package echo
+
+import (
+	"fmt"
+	"net/http"
+
+	echo "github.com/labstack/echo/v4"
+	"github.com/takuoki/google-calendar-sync/api/domain"
+	"github.com/takuoki/google-calendar-sync/api/domain/entity"
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+	"github.com/takuoki/google-calendar-sync/api/openapi"
+)
+
+const (
+	defaultEventLimit = 100
+	maxEventLimit     = 1000
+
+	defaultRecurringEventLimit = 20
+	maxRecurringEventLimit     = 100
+
+	defaultSearchEventLimit = 20
+	maxSearchEventLimit     = 100
+)
+
+func (h *handler) GetCalendarsCalendarIdEvents(c echo.Context, calendarID string, params openapi.GetCalendarsCalendarIdEventsParams) error {
+	ctx := c.Request().Context()
+
+	limit := defaultEventLimit
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > maxEventLimit {
+			return domain.InvalidParameterError("limit")
+		}
+		limit = *params.Limit
+	}
+
+	filter := entity.EventFilter{
+		From:             params.From,
+		To:               params.To,
+		Status:           params.Status,
+		Summary:          params.Summary,
+		IncludeCancelled: params.IncludeCancelled != nil && *params.IncludeCancelled,
+	}
+	if params.RecurringEventId != nil {
+		filter.RecurringEventID = valueobject.NewEventID(*params.RecurringEventId)
+	}
+
+	events, nextCursor, err := h.eventUsecase.List(ctx, valueobject.CalendarID(calendarID), filter, params.Cursor, limit)
+	if err != nil {
+		return fmt.Errorf("fail to list events: %w", err)
+	}
+
+	res := openapi.EventListResponse{
+		Status:     statusSuccess,
+		Events:     make([]openapi.Event, 0, len(events)),
+		NextCursor: nextCursor,
+	}
+	for _, event := range events {
+		res.Events = append(res.Events, convertEvent(event))
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (h *handler) GetCalendarsCalendarIdRecurringEvents(c echo.Context, calendarID string,
+	params openapi.GetCalendarsCalendarIdRecurringEventsParams) error {
+	ctx := c.Request().Context()
+
+	limit := defaultRecurringEventLimit
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > maxRecurringEventLimit {
+			return domain.InvalidParameterError("limit")
+		}
+		limit = *params.Limit
+	}
+
+	filter := entity.EventFilter{
+		From:             &params.From,
+		To:               &params.To,
+		IncludeCancelled: params.IncludeCancelled != nil && *params.IncludeCancelled,
+	}
+
+	series, nextCursor, err := h.eventUsecase.ListRecurringEvents(ctx, valueobject.CalendarID(calendarID), filter, params.Cursor, limit)
+	if err != nil {
+		return fmt.Errorf("fail to list recurring events: %w", err)
+	}
+
+	res := openapi.RecurringEventListResponse{
+		Status:          statusSuccess,
+		RecurringEvents: make([]openapi.RecurringEvent, 0, len(series)),
+		NextCursor:      nextCursor,
+	}
+	for _, s := range series {
+		res.RecurringEvents = append(res.RecurringEvents, convertRecurringEventSeries(s))
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func (h *handler) GetCalendarsCalendarIdRecurringEventsEventId(c echo.Context, calendarID string, eventID string,
+	params openapi.GetCalendarsCalendarIdRecurringEventsEventIdParams) error {
+	ctx := c.Request().Context()
+
+	filter := entity.EventFilter{
+		From:             &params.From,
+		To:               &params.To,
+		IncludeCancelled: params.IncludeCancelled != nil && *params.IncludeCancelled,
+	}
+
+	series, err := h.eventUsecase.GetRecurringEvent(ctx, valueobject.CalendarID(calendarID), valueobject.EventID(eventID), filter)
+	if err != nil {
+		return fmt.Errorf("fail to get recurring event: %w", err)
+	}
+
+	return c.JSON(http.StatusOK, openapi.RecurringEventResponse{
+		Status:         statusSuccess,
+		RecurringEvent: convertRecurringEventSeries(*series),
+	})
+}
+
+func (h *handler) GetEventsSearch(c echo.Context, params openapi.GetEventsSearchParams) error {
+	ctx := c.Request().Context()
+
+	limit := defaultSearchEventLimit
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > maxSearchEventLimit {
+			return domain.InvalidParameterError("limit")
+		}
+		limit = *params.Limit
+	}
+
+	offset := 0
+	if params.Offset != nil {
+		if *params.Offset < 0 {
+			return domain.InvalidParameterError("offset")
+		}
+		offset = *params.Offset
+	}
+
+	query := entity.EventSearchQuery{
+		Terms: entity.ParseSearchTerms(params.Q),
+		Filter: entity.EventFilter{
+			From:             params.From,
+			To:               params.To,
+			IncludeCancelled: params.IncludeCancelled != nil && *params.IncludeCancelled,
+		},
+	}
+	if params.CalendarId != nil {
+		calendarID := valueobject.CalendarID(*params.CalendarId)
+		query.CalendarID = &calendarID
+	}
+
+	results, nextOffset, err := h.eventUsecase.Search(ctx, query, offset, limit)
+	if err != nil {
+		return fmt.Errorf("fail to search events: %w", err)
+	}
+
+	res := openapi.EventSearchResponse{
+		Status:     statusSuccess,
+		Results:    make([]openapi.EventSearchResult, 0, len(results)),
+		NextOffset: nextOffset,
+	}
+	for _, result := range results {
+		res.Results = append(res.Results, openapi.EventSearchResult{
+			CalendarId: string(result.Event.CalendarID),
+			Score:      result.Score,
+			Event:      convertEvent(result.Event),
+		})
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+func convertEvent(event entity.Event) openapi.Event {
+	var recurringEventID *string
+	if event.RecurringEventID != nil {
+		id := string(*event.RecurringEventID)
+		recurringEventID = &id
+	}
+
+	return openapi.Event{
+		Id:               string(event.ID),
+		RecurringEventId: recurringEventID,
+		Summary:          event.Summary,
+		Description:      event.Description,
+		Location:         event.Location,
+		Start:            event.Start,
+		End:              event.End,
+		OriginalStart:    event.OriginalStart,
+		Transparency:     event.Transparency,
+		AllDay:           event.AllDay,
+		Status:           event.Status,
+	}
+}
+
+func convertRecurringEventSeries(series entity.RecurringEventSeries) openapi.RecurringEvent {
+	rules := make([]openapi.RecurrenceRule, 0, len(series.Recurrence.Rules))
+	for _, rule := range series.Recurrence.Rules {
+		rules = append(rules, openapi.RecurrenceRule{
+			Exclusion:  rule.Exclusion,
+			Freq:       rule.Freq,
+			Interval:   rule.Interval,
+			Count:      rule.Count,
+			Until:      rule.Until,
+			ByDay:      rule.ByDay,
+			ByMonthDay: rule.ByMonthDay,
+			ByMonth:    rule.ByMonth,
+		})
+	}
+
+	instances := make([]openapi.RecurringEventInstance, 0, len(series.Instances))
+	for _, instance := range series.Instances {
+		instances = append(instances, openapi.RecurringEventInstance{
+			Id:            string(instance.ID),
+			Summary:       instance.Summary,
+			Start:         instance.Start,
+			End:           instance.End,
+			OriginalStart: instance.OriginalStart,
+			Status:        instance.Status,
+			IsModified:    instance.IsModifiedFrom(series.RecurringEvent),
+		})
+	}
+
+	return openapi.RecurringEvent{
+		Id:      string(series.RecurringEvent.ID),
+		Summary: series.RecurringEvent.Summary,
+		Recurrence: openapi.Recurrence{
+			Lines:   series.Recurrence.Lines,
+			Rules:   rules,
+			RDates:  series.Recurrence.RDates,
+			ExDates: series.Recurrence.ExDates,
+		},
+		Start:     series.RecurringEvent.Start,
+		End:       series.RecurringEvent.End,
+		Status:    series.RecurringEvent.Status,
+		Instances: instances,
+	}
+}
@@ -0,0 +1,73 @@
+package echo
+
+import (
+	"fmt"
+	"time"
+
+	echo "github.com/labstack/echo/v4"
+	"github.com/takuoki/google-calendar-sync/api/domain"
+	"github.com/takuoki/google-calendar-sync/api/domain/entity"
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+	"github.com/takuoki/google-calendar-sync/api/openapi"
+)
+
+var exportContentTypes = map[entity.ExportFormat]string{
+	entity.ExportFormatCSV:   "text/csv; charset=utf-8",
+	entity.ExportFormatJSONL: "application/x-ndjson",
+}
+
+func (h *handler) GetExport(c echo.Context, params openapi.GetExportParams) error {
+	ctx := c.Request().Context()
+
+	query := entity.ExportQuery{
+		Target: entity.ExportTargetEvents,
+		Format: entity.ExportFormatCSV,
+		Filter: entity.EventFilter{
+			From:             params.From,
+			To:               params.To,
+			IncludeCancelled: params.IncludeCancelled != nil && *params.IncludeCancelled,
+		},
+		Location: time.UTC,
+	}
+	if params.Target != nil {
+		query.Target = entity.ExportTarget(*params.Target)
+	}
+	if params.Format != nil {
+		query.Format = entity.ExportFormat(*params.Format)
+	}
+	if params.CalendarId != nil {
+		calendarID := valueobject.CalendarID(*params.CalendarId)
+		query.CalendarID = &calendarID
+	}
+	if params.Columns != nil {
+		query.Columns = entity.ParseExportColumns(*params.Columns)
+	}
+	if params.TimeZone != nil {
+		loc, err := time.LoadLocation(*params.TimeZone)
+		if err != nil {
+			return domain.InvalidParameterError("timeZone")
+		}
+		query.Location = loc
+	}
+
+	contentType, ok := exportContentTypes[query.Format]
+	if !ok {
+		return domain.InvalidParameterError("format")
+	}
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, contentType)
+	res.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", string(query.Target)+"."+string(query.Format)))
+
+	if err := h.exportUsecase.Export(ctx, res, query); err != nil {
+		// 書き出しを始めた後はエラーレスポンスに切り替えられないため、途中で打ち切る
+		if res.Committed {
+			h.logger.Errorf(ctx, "fail to export after the response started: %v", err)
+			return nil
+		}
+		res.Header().Del(echo.HeaderContentDisposition)
+		return fmt.Errorf("fail to export: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package echo
+
+import (
+	"fmt"
+	"net/http"
+
+	echo "github.com/labstack/echo/v4"
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+	"github.com/takuoki/google-calendar-sync/api/openapi"
+)
+
+const contentTypeCalendar = "text/calendar; charset=utf-8"
+
+func (h *handler) PostCalendarsCalendarIdFeedToken(c echo.Context, calendarID string) error {
+	ctx := c.Request().Context()
+
+	token, err := h.feedUsecase.IssueToken(ctx, valueobject.CalendarID(calendarID))
+	if err != nil {
+		return fmt.Errorf("fail to issue feed token: %w", err)
+	}
+
+	return c.JSON(http.StatusOK, openapi.FeedTokenResponse{
+		Status: statusSuccess,
+		Token:  token,
+	})
+}
+
+func (h *handler) DeleteCalendarsCalendarIdFeedToken(c echo.Context, calendarID string) error {
+	ctx := c.Request().Context()
+
+	if err := h.feedUsecase.RevokeToken(ctx, valueobject.CalendarID(calendarID)); err != nil {
+		return fmt.Errorf("fail to revoke feed token: %w", err)
+	}
+
+	return success(c)
+}
+
+func (h *handler) GetCalendarsCalendarIdFeedIcs(c echo.Context, calendarID string, params openapi.GetCalendarsCalendarIdFeedIcsParams) error {
+	ctx := c.Request().Context()
+
+	feed, err := h.feedUsecase.Get(ctx, valueobject.CalendarID(calendarID), params.Token)
+	if err != nil {
+		return fmt.Errorf("fail to get feed: %w", err)
+	}
+
+	// トークンを含む URL の内容を共有キャッシュに残さない
+	c.Response().Header().Set(echo.HeaderCacheControl, "private, no-store")
+
+	return c.Blob(http.StatusOK, contentTypeCalendar, feed)
+}
@@ -0,0 +1,65 @@
+package echo
+
+import (
+	"fmt"
+	"net/http"
+
+	echo "github.com/labstack/echo/v4"
+	"github.com/takuoki/google-calendar-sync/api/domain"
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+	"github.com/takuoki/google-calendar-sync/api/openapi"
+)
+
+const maxFreeBusyCalendars = 50
+
+func (h *handler) PostFreebusy(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var req openapi.PostFreebusyJSONBody
+	if err := c.Bind(&req); err != nil {
+		return domain.InvalidJSONError
+	}
+
+	if len(req.CalendarIds) == 0 {
+		return domain.RequiredError("calendarIds")
+	}
+	if len(req.CalendarIds) > maxFreeBusyCalendars {
+		return domain.InvalidParameterError("calendarIds")
+	}
+	if req.From.IsZero() {
+		return domain.RequiredError("from")
+	}
+	if req.To.IsZero() {
+		return domain.RequiredError("to")
+	}
+
+	calendarIDs := make([]valueobject.CalendarID, 0, len(req.CalendarIds))
+	for _, id := range req.CalendarIds {
+		calendarIDs = append(calendarIDs, valueobject.CalendarID(id))
+	}
+
+	freeBusy, err := h.eventUsecase.FreeBusy(ctx, calendarIDs, req.From, req.To)
+	if err != nil {
+		return fmt.Errorf("fail to get free/busy: %w", err)
+	}
+
+	res := openapi.FreeBusyResponse{
+		Status:    statusSuccess,
+		Calendars: make([]openapi.FreeBusyCalendar, 0, len(freeBusy)),
+	}
+	for _, fb := range freeBusy {
+		busy := make([]openapi.TimeInterval, 0, len(fb.Busy))
+		for _, interval := range fb.Busy {
+			busy = append(busy, openapi.TimeInterval{
+				Start: interval.Start,
+				End:   interval.End,
+			})
+		}
+		res.Calendars = append(res.Calendars, openapi.FreeBusyCalendar{
+			CalendarId: string(fb.CalendarID),
+			Busy:       busy,
+		})
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
@@ -11,6 +11,11 @@ type handler struct {
 	calendarUsecase usecase.CalendarUsecase
 	syncUsecase     usecase.SyncUsecase
 	watchUsecase    usecase.WatchUsecase
+	leaseUsecase    usecase.LeaseUsecase
+	oauthUsecase    usecase.OAuthUsecase
+	eventUsecase    usecase.EventUsecase
+	feedUsecase     usecase.FeedUsecase
+	exportUsecase   usecase.ExportUsecase
 	logger          applog.Logger
 }
 
@@ -18,12 +23,22 @@ func New(
 	calendarUsecase usecase.CalendarUsecase,
 	syncUsecase usecase.SyncUsecase,
 	watchUsecase usecase.WatchUsecase,
+	leaseUsecase usecase.LeaseUsecase,
+	oauthUsecase usecase.OAuthUsecase,
+	eventUsecase usecase.EventUsecase,
+	feedUsecase usecase.FeedUsecase,
+	exportUsecase usecase.ExportUsecase,
 	logger applog.Logger,
 ) openapi.ServerInterface {
 	return &handler{
 		calendarUsecase: calendarUsecase,
 		syncUsecase:     syncUsecase,
 		watchUsecase:    watchUsecase,
+		leaseUsecase:    leaseUsecase,
+		oauthUsecase:    oauthUsecase,
+		eventUsecase:    eventUsecase,
+		feedUsecase:     feedUsecase,
+		exportUsecase:   exportUsecase,
 		logger:          logger,
 	}
 }
@@ -2,24 +2,38 @@ package echo
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
+	"path"
 
 	echo "github.com/labstack/echo/v4"
 	"github.com/takuoki/golib/applog"
 	"github.com/takuoki/google-calendar-sync/api/domain"
 )
 
+// Error codes that are not defined in the domain because they are detected by the HTTP layer.
+const (
+	errorCodeInvalidRequest   = "invalid_request"
+	errorCodeRouteNotFound    = "route_not_found"
+	errorCodeMethodNotAllowed = "method_not_allowed"
+	errorCodeInternal         = "internal_error"
+)
+
 func ErrorMiddleware(logger applog.Logger) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			ctx := c.Request().Context()
 			if err := next(c); err != nil {
 				var e *domain.ClientError
+				var he *echo.HTTPError
 				if errors.As(err, &e) {
-					return failure(c, e.Code, e.Message)
+					return failure(c, e.Status, e.Code, e.Message)
+				} else if errors.As(err, &he) && he.Code < http.StatusInternalServerError {
+					// ルーティングやパラメータのバインドなど、echo が検出したエラー
+					return failure(c, he.Code, convertHTTPErrorCode(he.Code), fmt.Sprint(he.Message))
 				} else {
 					logger.Errorf(ctx, "unexpected error: %v", err)
-					return failure(c, http.StatusInternalServerError, "internal server error")
+					return failure(c, http.StatusInternalServerError, errorCodeInternal, "internal server error")
 				}
 			}
 
@@ -27,3 +41,59 @@ func ErrorMiddleware(logger applog.Logger) echo.MiddlewareFunc {
 		}
 	}
 }
+
+func convertHTTPErrorCode(status int) string {
+	switch status {
+	case http.StatusNotFound:
+		return errorCodeRouteNotFound
+	case http.StatusMethodNotAllowed:
+		return errorCodeMethodNotAllowed
+	default:
+		return errorCodeInvalidRequest
+	}
+}
+
+// feedPathPattern is the path of the iCalendar feed, including the base path of the API.
+const feedPathPattern = "/api/calendars/*/feed.ics"
+
+// SkipTrailingSlash is the skipper of the trailing slash middleware.
+// The path of the iCalendar feed is kept, so that clients recognize the file type by its extension.
+func SkipTrailingSlash(c echo.Context) bool {
+	matched, _ := path.Match(feedPathPattern, c.Request().URL.Path)
+	return matched
+}
+
+// redactedValue replaces the values of the secret query parameters in the request URI.
+const redactedValue = "REDACTED"
+
+// RedactQueryMiddleware replaces the values of the query parameters with the names in the request URI,
+// so that secrets such as the feed token are not written to the request log.
+// It must be used before the request log middleware. The parsed URL used by the handlers is not changed.
+func RedactQueryMiddleware(names ...string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+
+			query := req.URL.Query()
+			redacted := false
+			for _, name := range names {
+				if !query.Has(name) {
+					continue
+				}
+				values := query[name]
+				for i := range values {
+					values[i] = redactedValue
+				}
+				redacted = true
+			}
+
+			if redacted {
+				uri := *req.URL
+				uri.RawQuery = query.Encode()
+				req.RequestURI = uri.RequestURI()
+			}
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package echo_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	echo "github.com/labstack/echo/v4"
+	"github.com/takuoki/golib/applog"
+	echo_requestlog "github.com/takuoki/golib/middleware/http/echo/requestlog"
+
+	echohandler "github.com/takuoki/google-calendar-sync/api/handler/echo"
+)
+
+func TestRedactQueryMiddleware(t *testing.T) {
+	t.Parallel()
+
+	const secret = "secret-feed-token"
+
+	tests := map[string]struct {
+		target        string
+		expectedToken string
+		expectedOther string
+	}{
+		"token only": {
+			target:        "/api/calendars/sample@sample.com/feed.ics?token=" + secret,
+			expectedToken: secret,
+		},
+		"with other parameters": {
+			target:        "/api/calendars/sample@sample.com/feed.ics?other=value&token=" + secret + "&token=" + secret,
+			expectedToken: secret,
+			expectedOther: "value",
+		},
+		"without token": {
+			target:        "/api/calendars/sample@sample.com/feed.ics?other=value",
+			expectedOther: "value",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			e := echo.New()
+			e.Use(echohandler.RedactQueryMiddleware("token"))
+			e.Use(echo_requestlog.Middleware(applog.NewBasicLogger(&buf)))
+
+			var token, other string
+			e.GET("/api/calendars/:calendarId/feed.ics", func(c echo.Context) error {
+				token = c.QueryParam("token")
+				other = c.QueryParam("other")
+				return c.NoContent(http.StatusOK)
+			})
+
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.target, nil))
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+			}
+			if token != tt.expectedToken {
+				t.Errorf("token = %q, want %q", token, tt.expectedToken)
+			}
+			if other != tt.expectedOther {
+				t.Errorf("other = %q, want %q", other, tt.expectedOther)
+			}
+			if !strings.Contains(buf.String(), "feed.ics") {
+				t.Errorf("request log is not written: %s", buf.String())
+			}
+			if strings.Contains(buf.String(), secret) {
+				t.Errorf("request log contains the token: %s", buf.String())
+			}
+		})
+	}
+}
+
+func TestSkipTrailingSlash(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		target   string
+		expected bool
+	}{
+		"feed": {
+			target:   "/api/calendars/sample@sample.com/feed.ics?token=token",
+			expected: true,
+		},
+		"feed token": {
+			target:   "/api/calendars/sample@sample.com/feed-token",
+			expected: false,
+		},
+		"other path with extension": {
+			target:   "/api/calendars/sample.ics",
+			expected: false,
+		},
+		"nested path with feed": {
+			target:   "/api/calendars/sample@sample.com/events/feed.ics",
+			expected: false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			e := echo.New()
+			c := e.NewContext(httptest.NewRequest(http.MethodGet, tt.target, nil), httptest.NewRecorder())
+
+			if result := echohandler.SkipTrailingSlash(c); result != tt.expected {
+				t.Errorf("SkipTrailingSlash() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
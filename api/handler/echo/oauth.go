@@ -0,0 +1,56 @@
+package echo
+
+import (
+	"fmt"
+	"net/http"
+
+	echo "github.com/labstack/echo/v4"
+	"github.com/takuoki/google-calendar-sync/api/domain"
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+	"github.com/takuoki/google-calendar-sync/api/openapi"
+)
+
+// oauthErrorAccessDenied is the error set by Google when the user denied access.
+// see: https://developers.google.com/identity/protocols/oauth2/web-server#handlingresponse
+const oauthErrorAccessDenied = "access_denied"
+
+func (h *handler) GetOauthAuthorize(c echo.Context, params openapi.GetOauthAuthorizeParams) error {
+	ctx := c.Request().Context()
+
+	authURL, err := h.oauthUsecase.Authorize(ctx, valueobject.CalendarID(params.CalendarId), params.Name)
+	if err != nil {
+		return fmt.Errorf("fail to authorize: %w", err)
+	}
+
+	return c.Redirect(http.StatusFound, authURL)
+}
+
+func (h *handler) GetOauthCallback(c echo.Context, params openapi.GetOauthCallbackParams) error {
+	ctx := c.Request().Context()
+
+	if params.Error != nil {
+		if *params.Error == oauthErrorAccessDenied {
+			return domain.OAuthAccessDeniedError
+		}
+		// access_denied 以外も、Google の認可画面で発生したエラーのため、クライアントエラーとして返す
+		return domain.OAuthFailedError(*params.Error)
+	}
+
+	var state, code string
+	if params.State != nil {
+		state = *params.State
+	}
+	if params.Code != nil {
+		code = *params.Code
+	}
+
+	calendarID, err := h.oauthUsecase.Callback(ctx, state, code)
+	if err != nil {
+		return fmt.Errorf("fail to handle oauth callback: %w", err)
+	}
+
+	return c.JSON(http.StatusOK, openapi.OAuthCallbackResponse{
+		Status:     statusSuccess,
+		CalendarId: string(calendarID),
+	})
+}
@@ -1,27 +1,49 @@
 package echo
 
 import (
+	"encoding/json"
 	"net/http"
 
 	echo "github.com/labstack/echo/v4"
+	"github.com/takuoki/google-calendar-sync/api/openapi"
+)
+
+const (
+	statusSuccess = "success"
+
+	// problemType is the type of all error responses.
+	// Error responses are identified by the code instead of the type.
+	problemType = "about:blank"
+
+	contentTypeProblemJSON = "application/problem+json"
 )
 
 type Response struct {
-	Status  string `json:"status"`
-	Message string `json:"message,omitempty"`
+	Status string `json:"status"`
 }
 
 func success(c echo.Context) error {
 	response := Response{
-		Status: "success",
+		Status: statusSuccess,
 	}
 	return c.JSON(http.StatusOK, response)
 }
 
-func failure(c echo.Context, status int, message string) error {
-	response := Response{
-		Status:  "error",
-		Message: message,
+// failure returns an error response in the format of RFC 7807 (application/problem+json).
+func failure(c echo.Context, status int, code, detail string) error {
+	response := openapi.Problem{
+		Type:     problemType,
+		Title:    http.StatusText(status),
+		Status:   status,
+		Detail:   detail,
+		Instance: c.Request().URL.Path,
+		Code:     openapi.ProblemCode(code),
+	}
+
+	b, err := json.Marshal(response)
+	if err != nil {
+		return err
 	}
-	return c.JSON(status, response)
+
+	return c.Blob(status, contentTypeProblemJSON, b)
 }
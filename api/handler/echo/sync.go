@@ -1,14 +1,37 @@
 package echo
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 
 	echo "github.com/labstack/echo/v4"
 	"github.com/takuoki/google-calendar-sync/api/domain"
+	"github.com/takuoki/google-calendar-sync/api/domain/constant"
+	"github.com/takuoki/google-calendar-sync/api/domain/entity"
 	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
 	"github.com/takuoki/google-calendar-sync/api/openapi"
 )
 
+const (
+	defaultHistoryLimit = 20
+	maxHistoryLimit     = 100
+)
+
+func (h *handler) GetSyncCalendarId(c echo.Context, calendarID string) error {
+	ctx := c.Request().Context()
+
+	status, err := h.syncUsecase.GetStatus(ctx, valueobject.CalendarID(calendarID))
+	if err != nil {
+		return fmt.Errorf("fail to get sync status: %w", err)
+	}
+
+	return c.JSON(http.StatusOK, openapi.SyncStatusResponse{
+		Status:     statusSuccess,
+		SyncStatus: convertSyncStatus(*status),
+	})
+}
+
 func (h *handler) PostSyncCalendarId(c echo.Context, calendarID string) error {
 	ctx := c.Request().Context()
 
@@ -19,6 +42,63 @@ func (h *handler) PostSyncCalendarId(c echo.Context, calendarID string) error {
 	return success(c)
 }
 
+func (h *handler) GetSyncCalendarIdHistories(c echo.Context, calendarID string, params openapi.GetSyncCalendarIdHistoriesParams) error {
+	ctx := c.Request().Context()
+
+	limit := defaultHistoryLimit
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > maxHistoryLimit {
+			return domain.InvalidParameterError("limit")
+		}
+		limit = *params.Limit
+	}
+
+	historyType := openapi.Sync
+	if params.Type != nil {
+		historyType = *params.Type
+	}
+
+	res := openapi.SyncHistoryListResponse{
+		Status:    statusSuccess,
+		Histories: []openapi.SyncHistory{},
+	}
+
+	switch historyType {
+	case openapi.Sync:
+		histories, nextBefore, err := h.syncUsecase.ListSyncHistories(
+			ctx, valueobject.CalendarID(calendarID), params.Before, limit)
+		if err != nil {
+			return fmt.Errorf("fail to list sync histories: %w", err)
+		}
+		for _, history := range histories {
+			res.Histories = append(res.Histories, openapi.SyncHistory{
+				SyncTime:          history.SyncTime,
+				UpdatedEventCount: history.UpdatedEventCount,
+			})
+		}
+		res.NextBefore = nextBefore
+
+	case openapi.FutureInstance:
+		histories, nextBefore, err := h.syncUsecase.ListSyncFutureInstanceHistories(
+			ctx, valueobject.CalendarID(calendarID), params.Before, limit)
+		if err != nil {
+			return fmt.Errorf("fail to list sync future instance histories: %w", err)
+		}
+		for _, history := range histories {
+			res.Histories = append(res.Histories, openapi.SyncHistory{
+				SyncTime:          history.SyncTime,
+				UpdatedEventCount: history.UpdatedEventCount,
+			})
+		}
+		res.NextBefore = nextBefore
+
+	default:
+		return domain.InvalidParameterError("type")
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
 func (h *handler) PostSyncFutureInstance(c echo.Context, params openapi.PostSyncFutureInstanceParams) error {
 	ctx := c.Request().Context()
 
@@ -26,9 +106,74 @@ func (h *handler) PostSyncFutureInstance(c echo.Context, params openapi.PostSync
 		return domain.AllParameterFalseError
 	}
 
-	if err := h.syncUsecase.SyncFutureInstanceAll(ctx); err != nil {
+	ran, err := h.leaseUsecase.RunExclusive(ctx, constant.JobSyncFutureInstanceAll, func(ctx context.Context) error {
+		return h.syncUsecase.SyncFutureInstanceAll(ctx, params.Tag)
+	})
+	if err != nil {
 		return fmt.Errorf("fail to sync future instance: %w", err)
 	}
+	if !ran {
+		return domain.JobAlreadyRunningError
+	}
 
 	return success(c)
 }
+
+func (h *handler) PostSync(c echo.Context, params openapi.PostSyncParams) error {
+	ctx := c.Request().Context()
+
+	if params.All != nil && !*params.All {
+		return domain.AllParameterFalseError
+	}
+
+	ran, err := h.leaseUsecase.RunExclusive(ctx, constant.JobSyncAll, func(ctx context.Context) error {
+		if params.Full != nil && *params.Full {
+			return h.syncUsecase.ResyncAll(ctx, params.Tag)
+		}
+		return h.syncUsecase.SyncAll(ctx, params.Tag)
+	})
+	if err != nil {
+		return fmt.Errorf("fail to sync all calendars: %w", err)
+	}
+	if !ran {
+		return domain.JobAlreadyRunningError
+	}
+
+	return success(c)
+}
+
+func convertSyncStatus(status entity.SyncStatus) openapi.SyncStatus {
+	res := openapi.SyncStatus{
+		CalendarId:    string(status.CalendarID),
+		NeedsReauthAt: status.NeedsReauthAt,
+	}
+
+	if status.LatestSync != nil {
+		res.LatestSyncTime = &status.LatestSync.SyncTime
+		res.LatestUpdatedEventCount = &status.LatestSync.UpdatedEventCount
+	}
+
+	if status.SyncTokenAge != nil {
+		seconds := int64(status.SyncTokenAge.Seconds())
+		res.SyncTokenAgeSeconds = &seconds
+	}
+
+	if status.LatestSyncFutureInstance != nil {
+		res.LatestFutureInstanceSyncTime = &status.LatestSyncFutureInstance.SyncTime
+		res.LatestFutureInstanceUpdatedEventCount = &status.LatestSyncFutureInstance.UpdatedEventCount
+	}
+
+	if status.ActiveChannel != nil {
+		res.ChannelExpiration = &status.ActiveChannel.Expiration
+	}
+
+	res.ConsecutiveFailureCount = status.ConsecutiveFailureCount
+	if status.LatestFailure != nil {
+		stage := openapi.SyncStatusLatestFailureStage(status.LatestFailure.Stage)
+		res.LatestFailureTime = &status.LatestFailure.FailureTime
+		res.LatestFailureStage = &stage
+		res.LatestFailureMessage = &status.LatestFailure.ErrorMessage
+	}
+
+	return res
+}
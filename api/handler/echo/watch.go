@@ -1,10 +1,12 @@
 package echo
 
 import (
+	"context"
 	"fmt"
 
 	echo "github.com/labstack/echo/v4"
 	"github.com/takuoki/google-calendar-sync/api/domain"
+	"github.com/takuoki/google-calendar-sync/api/domain/constant"
 	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
 	"github.com/takuoki/google-calendar-sync/api/openapi"
 )
@@ -16,9 +18,15 @@ func (h *handler) PostWatch(c echo.Context, params openapi.PostWatchParams) erro
 		return domain.AllParameterFalseError
 	}
 
-	if err := h.watchUsecase.StartAll(ctx); err != nil {
+	ran, err := h.leaseUsecase.RunExclusive(ctx, constant.JobWatchAll, func(ctx context.Context) error {
+		return h.watchUsecase.StartAll(ctx, params.Tag)
+	})
+	if err != nil {
 		return fmt.Errorf("fail to watch all calendars: %w", err)
 	}
+	if !ran {
+		return domain.JobAlreadyRunningError
+	}
 
 	return success(c)
 }
@@ -15,35 +15,673 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime"
 )
 
+// Defines values for CalendarAuthType.
+const (
+	DomainWideDelegation CalendarAuthType = "domain_wide_delegation"
+	Oauth                CalendarAuthType = "oauth"
+	ServiceAccount       CalendarAuthType = "service_account"
+)
+
+// Defines values for ProblemCode.
+const (
+	AllParameterFalse        ProblemCode = "all_parameter_false"
+	CalendarAlreadyExists    ProblemCode = "calendar_already_exists"
+	CalendarNeedsReauth      ProblemCode = "calendar_needs_reauth"
+	CalendarNotFound         ProblemCode = "calendar_not_found"
+	FeedNotFound             ProblemCode = "feed_not_found"
+	GoogleCalendarNotFound   ProblemCode = "google_calendar_not_found"
+	GoogleInvalidCredentials ProblemCode = "google_invalid_credentials"
+	GooglePermissionDenied   ProblemCode = "google_permission_denied"
+	GoogleQuotaExceeded      ProblemCode = "google_quota_exceeded"
+	InternalError            ProblemCode = "internal_error"
+	InvalidJson              ProblemCode = "invalid_json"
+	InvalidParameter         ProblemCode = "invalid_parameter"
+	InvalidRequest           ProblemCode = "invalid_request"
+	JobAlreadyRunning        ProblemCode = "job_already_running"
+	MethodNotAllowed         ProblemCode = "method_not_allowed"
+	NotAllowed               ProblemCode = "not_allowed"
+	OauthAccessDenied        ProblemCode = "oauth_access_denied"
+	OauthDisabled            ProblemCode = "oauth_disabled"
+	OauthFailed              ProblemCode = "oauth_failed"
+	RecurringEventNotFound   ProblemCode = "recurring_event_not_found"
+	Required                 ProblemCode = "required"
+	RouteNotFound            ProblemCode = "route_not_found"
+)
+
+// Defines values for SyncStatusLatestFailureStage.
+const (
+	Database      SyncStatusLatestFailureStage = "database"
+	ListEvents    SyncStatusLatestFailureStage = "list_events"
+	ListInstances SyncStatusLatestFailureStage = "list_instances"
+	Transaction   SyncStatusLatestFailureStage = "transaction"
+)
+
+// Defines values for WorkingHoursDays.
+const (
+	FR WorkingHoursDays = "FR"
+	MO WorkingHoursDays = "MO"
+	SA WorkingHoursDays = "SA"
+	SU WorkingHoursDays = "SU"
+	TH WorkingHoursDays = "TH"
+	TU WorkingHoursDays = "TU"
+	WE WorkingHoursDays = "WE"
+)
+
+// Defines values for GetExportParamsTarget.
+const (
+	Events          GetExportParamsTarget = "events"
+	RecurringEvents GetExportParamsTarget = "recurring_events"
+)
+
+// Defines values for GetExportParamsFormat.
+const (
+	Csv   GetExportParamsFormat = "csv"
+	Jsonl GetExportParamsFormat = "jsonl"
+)
+
+// Defines values for GetSyncCalendarIdHistoriesParamsType.
+const (
+	FutureInstance GetSyncCalendarIdHistoriesParamsType = "future-instance"
+	Sync           GetSyncCalendarIdHistoriesParamsType = "sync"
+)
+
+// AvailabilityCalendar defines model for AvailabilityCalendar.
+type AvailabilityCalendar struct {
+	CalendarId string `json:"calendarId"`
+
+	// WorkingHours If omitted, the calendar is available at any time.
+	WorkingHours *WorkingHours `json:"workingHours,omitempty"`
+}
+
+// AvailabilityResponse defines model for AvailabilityResponse.
+type AvailabilityResponse struct {
+	Slots  []AvailableSlot `json:"slots"`
+	Status string          `json:"status"`
+}
+
+// AvailableSlot defines model for AvailableSlot.
+type AvailableSlot struct {
+	End time.Time `json:"end"`
+
+	// MarginMinutes Free time shared by all calendars before and after the slot, whichever is shorter, capped at 60 minutes.
+	MarginMinutes int       `json:"marginMinutes"`
+	Start         time.Time `json:"start"`
+}
+
+// Calendar defines model for Calendar.
+type Calendar struct {
+	// AccessRole Empty if the calendar is not in the calendar list of the account.
+	AccessRole string `json:"accessRole"`
+
+	// AuthType How the calendar is accessed on Google Calendar. It is `oauth` if the calendar is registered with a refresh token, `domain_wide_delegation` if with a subject, otherwise `service_account`.
+	AuthType CalendarAuthType `json:"authType"`
+
+	// HasRefreshToken The refresh token itself is never returned.
+	HasRefreshToken bool   `json:"hasRefreshToken"`
+	Id              string `json:"id"`
+	IsPaused        bool   `json:"isPaused"`
+	Name            string `json:"name"`
+
+	// NeedsReauthAt Time when the refresh token was found to be revoked or expired. While it is set, syncing and watching the calendar are skipped. It is cleared when a new refresh token is supplied.
+	NeedsReauthAt *time.Time `json:"needsReauthAt"`
+
+	// Subject Email of the Google Workspace user impersonated via domain-wide delegation.
+	Subject *string `json:"subject"`
+
+	// Summary Title of the calendar on Google Calendar.
+	Summary  string   `json:"summary"`
+	Tags     []string `json:"tags"`
+	TimeZone string   `json:"timeZone"`
+}
+
+// CalendarAuthType How the calendar is accessed on Google Calendar. It is `oauth` if the calendar is registered with a refresh token, `domain_wide_delegation` if with a subject, otherwise `service_account`.
+type CalendarAuthType string
+
+// CalendarBulkResponse defines model for CalendarBulkResponse.
+type CalendarBulkResponse struct {
+	Status string `json:"status"`
+
+	// WatchFailures Calendars whose watch could not be started. Empty if `watch` is false or all watches are started.
+	WatchFailures []WatchFailure `json:"watchFailures"`
+}
+
+// CalendarListResponse defines model for CalendarListResponse.
+type CalendarListResponse struct {
+	Calendars []Calendar `json:"calendars"`
+	Status    string     `json:"status"`
+}
+
+// CalendarRegistration defines model for CalendarRegistration.
+type CalendarRegistration struct {
+	Id           string    `json:"id"`
+	Name         string    `json:"name"`
+	RefreshToken *string   `json:"refreshToken"`
+	Subject      *string   `json:"subject"`
+	Tags         *[]string `json:"tags,omitempty"`
+}
+
+// CalendarResponse defines model for CalendarResponse.
+type CalendarResponse struct {
+	Calendar Calendar `json:"calendar"`
+	Status   string   `json:"status"`
+}
+
+// DiscoverResponse defines model for DiscoverResponse.
+type DiscoverResponse struct {
+	Calendars []DiscoveredCalendar `json:"calendars"`
+	Status    string               `json:"status"`
+}
+
+// DiscoveredCalendar defines model for DiscoveredCalendar.
+type DiscoveredCalendar struct {
+	// AccessRole One of freeBusyReader, reader, writer and owner.
+	AccessRole string `json:"accessRole"`
+	Id         string `json:"id"`
+	Primary    bool   `json:"primary"`
+	Summary    string `json:"summary"`
+	TimeZone   string `json:"timeZone"`
+}
+
+// Event defines model for Event.
+type Event struct {
+	// AllDay If true, `start` and `end` are the start of the days in the time zone of the calendar.
+	AllDay      bool       `json:"allDay"`
+	Description string     `json:"description"`
+	End         *time.Time `json:"end"`
+	Id          string     `json:"id"`
+	Location    string     `json:"location"`
+
+	// OriginalStart Start time of the instance in the recurrence. Null if the event is not an instance of a recurring event.
+	OriginalStart *time.Time `json:"originalStart"`
+
+	// RecurringEventId ID of the recurring event if the event is an instance of it.
+	RecurringEventId *string    `json:"recurringEventId"`
+	Start            *time.Time `json:"start"`
+	Status           string     `json:"status"`
+	Summary          string     `json:"summary"`
+
+	// Transparency `transparent` if the event is shown as available.
+	Transparency string `json:"transparency"`
+}
+
+// EventListResponse defines model for EventListResponse.
+type EventListResponse struct {
+	Events []Event `json:"events"`
+
+	// NextCursor Null if there are no more events.
+	NextCursor *string `json:"nextCursor"`
+	Status     string  `json:"status"`
+}
+
+// EventSearchResponse defines model for EventSearchResponse.
+type EventSearchResponse struct {
+	// NextOffset Null if there are no more events.
+	NextOffset *int                `json:"nextOffset"`
+	Results    []EventSearchResult `json:"results"`
+	Status     string              `json:"status"`
+}
+
+// EventSearchResult defines model for EventSearchResult.
+type EventSearchResult struct {
+	CalendarId string `json:"calendarId"`
+	Event      Event  `json:"event"`
+
+	// Score Relevance of the event. Larger is more relevant. Only comparable within the same search.
+	Score float64 `json:"score"`
+}
+
+// FeedTokenResponse defines model for FeedTokenResponse.
+type FeedTokenResponse struct {
+	Status string `json:"status"`
+
+	// Token Pass as the `token` query parameter of `GET /calendars/{calendarId}/feed.ics`.
+	Token string `json:"token"`
+}
+
+// FreeBusyCalendar defines model for FreeBusyCalendar.
+type FreeBusyCalendar struct {
+	Busy       []TimeInterval `json:"busy"`
+	CalendarId string         `json:"calendarId"`
+}
+
+// FreeBusyResponse defines model for FreeBusyResponse.
+type FreeBusyResponse struct {
+	Calendars []FreeBusyCalendar `json:"calendars"`
+	Status    string             `json:"status"`
+}
+
+// OAuthCallbackResponse defines model for OAuthCallbackResponse.
+type OAuthCallbackResponse struct {
+	CalendarId string `json:"calendarId"`
+	Status     string `json:"status"`
+}
+
+// Problem Error response in the format of RFC 7807 (application/problem+json).
+type Problem struct {
+	// Code Stable machine-readable error code.
+	Code     ProblemCode `json:"code"`
+	Detail   string      `json:"detail"`
+	Instance string      `json:"instance"`
+	Status   int         `json:"status"`
+	Title    string      `json:"title"`
+
+	// Type Always `about:blank`. Use `code` to identify the error.
+	Type string `json:"type"`
+}
+
+// ProblemCode Stable machine-readable error code.
+type ProblemCode string
+
+// Recurrence defines model for Recurrence.
+type Recurrence struct {
+	ExDates []string `json:"exDates"`
+
+	// Lines Original RRULE, EXRULE, RDATE and EXDATE lines of RFC 5545.
+	Lines  []string         `json:"lines"`
+	RDates []string         `json:"rDates"`
+	Rules  []RecurrenceRule `json:"rules"`
+}
+
+// RecurrenceRule defines model for RecurrenceRule.
+type RecurrenceRule struct {
+	ByDay      []string `json:"byDay"`
+	ByMonth    []int    `json:"byMonth"`
+	ByMonthDay []int    `json:"byMonthDay"`
+	Count      *int     `json:"count"`
+
+	// Exclusion True if the rule is an EXRULE.
+	Exclusion bool    `json:"exclusion"`
+	Freq      string  `json:"freq"`
+	Interval  int     `json:"interval"`
+	Until     *string `json:"until"`
+}
+
+// RecurringEvent defines model for RecurringEvent.
+type RecurringEvent struct {
+	// End End time of the first instance.
+	End        *time.Time               `json:"end"`
+	Id         string                   `json:"id"`
+	Instances  []RecurringEventInstance `json:"instances"`
+	Recurrence Recurrence               `json:"recurrence"`
+
+	// Start Start time of the first instance.
+	Start   *time.Time `json:"start"`
+	Status  string     `json:"status"`
+	Summary string     `json:"summary"`
+}
+
+// RecurringEventInstance defines model for RecurringEventInstance.
+type RecurringEventInstance struct {
+	End *time.Time `json:"end"`
+	Id  string     `json:"id"`
+
+	// IsModified True if the start time, duration or summary of the instance was individually changed from the recurring event.
+	IsModified bool `json:"isModified"`
+
+	// OriginalStart Start time of the instance in the recurrence. Null if it was synced before the original start time was stored.
+	OriginalStart *time.Time `json:"originalStart"`
+	Start         *time.Time `json:"start"`
+	Status        string     `json:"status"`
+	Summary       string     `json:"summary"`
+}
+
+// RecurringEventListResponse defines model for RecurringEventListResponse.
+type RecurringEventListResponse struct {
+	// NextCursor Null if there are no more recurring events.
+	NextCursor      *string          `json:"nextCursor"`
+	RecurringEvents []RecurringEvent `json:"recurringEvents"`
+	Status          string           `json:"status"`
+}
+
+// RecurringEventResponse defines model for RecurringEventResponse.
+type RecurringEventResponse struct {
+	RecurringEvent RecurringEvent `json:"recurringEvent"`
+	Status         string         `json:"status"`
+}
+
+// SyncHistory defines model for SyncHistory.
+type SyncHistory struct {
+	SyncTime          time.Time `json:"syncTime"`
+	UpdatedEventCount int       `json:"updatedEventCount"`
+}
+
+// SyncHistoryListResponse defines model for SyncHistoryListResponse.
+type SyncHistoryListResponse struct {
+	Histories []SyncHistory `json:"histories"`
+
+	// NextBefore Null if there are no more histories.
+	NextBefore *time.Time `json:"nextBefore"`
+	Status     string     `json:"status"`
+}
+
+// SyncStatus defines model for SyncStatus.
+type SyncStatus struct {
+	CalendarId string `json:"calendarId"`
+
+	// ChannelExpiration Expiration of the active watch channel. Null if the calendar is not watched.
+	ChannelExpiration *time.Time `json:"channelExpiration"`
+
+	// ConsecutiveFailureCount Number of sync failures since the last successful sync.
+	ConsecutiveFailureCount int     `json:"consecutiveFailureCount"`
+	LatestFailureMessage    *string `json:"latestFailureMessage"`
+
+	// LatestFailureStage Stage of the sync process where the latest failure occurred.
+	LatestFailureStage                    *SyncStatusLatestFailureStage `json:"latestFailureStage"`
+	LatestFailureTime                     *time.Time                    `json:"latestFailureTime"`
+	LatestFutureInstanceSyncTime          *time.Time                    `json:"latestFutureInstanceSyncTime"`
+	LatestFutureInstanceUpdatedEventCount *int                          `json:"latestFutureInstanceUpdatedEventCount"`
+	LatestSyncTime                        *time.Time                    `json:"latestSyncTime"`
+	LatestUpdatedEventCount               *int                          `json:"latestUpdatedEventCount"`
+
+	// NeedsReauthAt Time when the refresh token was found to be revoked or expired. Syncs are skipped while it is set.
+	NeedsReauthAt *time.Time `json:"needsReauthAt"`
+
+	// SyncTokenAgeSeconds Elapsed seconds since the sync token currently in use was issued.
+	SyncTokenAgeSeconds *int64 `json:"syncTokenAgeSeconds"`
+}
+
+// SyncStatusLatestFailureStage Stage of the sync process where the latest failure occurred.
+type SyncStatusLatestFailureStage string
+
+// SyncStatusResponse defines model for SyncStatusResponse.
+type SyncStatusResponse struct {
+	Status     string     `json:"status"`
+	SyncStatus SyncStatus `json:"syncStatus"`
+}
+
+// TimeInterval defines model for TimeInterval.
+type TimeInterval struct {
+	End   time.Time `json:"end"`
+	Start time.Time `json:"start"`
+}
+
+// WatchFailure defines model for WatchFailure.
+type WatchFailure struct {
+	CalendarId string `json:"calendarId"`
+
+	// Code Error code of the failure, the same as `code` of `Problem`.
+	Code   string `json:"code"`
+	Detail string `json:"detail"`
+}
+
+// WorkingHours If omitted, the calendar is available at any time.
+type WorkingHours struct {
+	Days []WorkingHoursDays `json:"days"`
+
+	// End End time of day in `HH:MM` format. Must be after `start`; `24:00` means the end of the day.
+	End string `json:"end"`
+
+	// Start Start time of day in `HH:MM` format.
+	Start string `json:"start"`
+
+	// TimeZone IANA time zone name. Defaults to the time zone of the calendar.
+	TimeZone *string `json:"timeZone,omitempty"`
+}
+
+// WorkingHoursDays defines model for WorkingHours.Days.
+type WorkingHoursDays string
+
+// PostAvailabilityJSONBody defines parameters for PostAvailability.
+type PostAvailabilityJSONBody struct {
+	// BufferMinutes Free time required before and after the existing events.
+	BufferMinutes   *int                   `json:"bufferMinutes,omitempty"`
+	Calendars       []AvailabilityCalendar `json:"calendars"`
+	DurationMinutes int                    `json:"durationMinutes"`
+	From            time.Time              `json:"from"`
+	Limit           *int                   `json:"limit,omitempty"`
+
+	// To Must be after `from`, within 90 days.
+	To time.Time `json:"to"`
+}
+
+// GetCalendarsParams defines parameters for GetCalendars.
+type GetCalendarsParams struct {
+	// Tag If specified, only the calendars with this tag are listed.
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+}
+
+// PostCalendarsBulkJSONBody defines parameters for PostCalendarsBulk.
+type PostCalendarsBulkJSONBody struct {
+	Calendars []CalendarRegistration `json:"calendars"`
+
+	// RefreshToken Used for the calendars that have neither their own refresh token nor subject. Required when using OAuth 2.0 authentication to connect to the Google Calendar API.
+	RefreshToken *string `json:"refreshToken"`
+
+	// Subject Used for the calendars that have neither their own refresh token nor subject. Required when using domain-wide delegation to connect to the Google Calendar API.
+	Subject *string `json:"subject"`
+
+	// Watch If true, start watching the registered calendars. Watches are started after the calendars are registered, so failing to start a watch does not roll back the registration and is reported in `watchFailures`.
+	Watch *bool `json:"watch,omitempty"`
+}
+
+// PatchCalendarsCalendarIdJSONBody defines parameters for PatchCalendarsCalendarId.
+type PatchCalendarsCalendarIdJSONBody struct {
+	Name *string `json:"name"`
+
+	// RefreshToken Only allowed when using OAuth 2.0 authentication to connect to the Google Calendar API. The calendar is switched to OAuth 2.0 authentication.
+	RefreshToken *string `json:"refreshToken"`
+
+	// Subject Email of the Google Workspace user impersonated via domain-wide delegation. Only allowed when using domain-wide delegation to connect to the Google Calendar API. The calendar is switched to domain-wide delegation. It cannot be specified with `refreshToken`.
+	Subject *string `json:"subject"`
+
+	// Tags Replaces all tags of the calendar. Specify an empty array to remove all tags.
+	Tags *[]string `json:"tags"`
+}
+
 // PostCalendarsCalendarIdJSONBody defines parameters for PostCalendarsCalendarId.
 type PostCalendarsCalendarIdJSONBody struct {
 	Name *string `json:"name,omitempty"`
 
 	// RefreshToken Required when using OAuth 2.0 authentication to connect to the Google Calendar API.
 	RefreshToken *string `json:"refreshToken"`
+
+	// Subject Email of the Google Workspace user impersonated via domain-wide delegation. Required when using domain-wide delegation to connect to the Google Calendar API. It cannot be specified with `refreshToken`.
+	Subject *string   `json:"subject"`
+	Tags    *[]string `json:"tags,omitempty"`
+}
+
+// GetCalendarsCalendarIdEventsParams defines parameters for GetCalendarsCalendarIdEvents.
+type GetCalendarsCalendarIdEventsParams struct {
+	// From Only events ending after this time are returned. Cancelled instances without start and end time are compared by `originalStart`.
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only events starting before this time are returned. Must be after `from`. Cancelled instances without start and end time are compared by `originalStart`.
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Status Only events with this status are returned. One of `confirmed`, `tentative` and `cancelled`, otherwise `invalid_parameter` is returned.
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+
+	// RecurringEventId Only instances of this recurring event are returned.
+	RecurringEventId *string `form:"recurringEventId,omitempty" json:"recurringEventId,omitempty"`
+
+	// Summary Only events whose summary contains this string are returned.
+	Summary          *string `form:"summary,omitempty" json:"summary,omitempty"`
+	IncludeCancelled *bool   `form:"includeCancelled,omitempty" json:"includeCancelled,omitempty"`
+	Limit            *int    `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Specify `nextCursor` of the previous response to get the next page. The other parameters must be the same as the previous request.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetCalendarsCalendarIdFeedIcsParams defines parameters for GetCalendarsCalendarIdFeedIcs.
+type GetCalendarsCalendarIdFeedIcsParams struct {
+	// Token The token issued by `POST /calendars/{calendarId}/feed-token/`.
+	Token string `form:"token" json:"token"`
+}
+
+// GetCalendarsCalendarIdRecurringEventsParams defines parameters for GetCalendarsCalendarIdRecurringEvents.
+type GetCalendarsCalendarIdRecurringEventsParams struct {
+	// From Only instances ending after this time are returned.
+	From time.Time `form:"from" json:"from"`
+
+	// To Only instances starting before this time are returned. Must be after `from`, and within 366 days of it.
+	To               time.Time `form:"to" json:"to"`
+	IncludeCancelled *bool     `form:"includeCancelled,omitempty" json:"includeCancelled,omitempty"`
+	Limit            *int      `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Specify `nextCursor` of the previous response to get the next page. The other parameters must be the same as the previous request.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetCalendarsCalendarIdRecurringEventsEventIdParams defines parameters for GetCalendarsCalendarIdRecurringEventsEventId.
+type GetCalendarsCalendarIdRecurringEventsEventIdParams struct {
+	// From Only instances ending after this time are returned.
+	From time.Time `form:"from" json:"from"`
+
+	// To Only instances starting before this time are returned. Must be after `from`, and within 366 days of it.
+	To               time.Time `form:"to" json:"to"`
+	IncludeCancelled *bool     `form:"includeCancelled,omitempty" json:"includeCancelled,omitempty"`
+}
+
+// GetDiscoverParams defines parameters for GetDiscover.
+type GetDiscoverParams struct {
+	// Subject Required when using domain-wide delegation to connect to the Google Calendar API.
+	Subject *string `form:"subject,omitempty" json:"subject,omitempty"`
+
+	// XRefreshToken Required when using OAuth 2.0 authentication to connect to the Google Calendar API.
+	XRefreshToken *string `json:"X-Refresh-Token,omitempty"`
+}
+
+// GetEventsSearchParams defines parameters for GetEventsSearch.
+type GetEventsSearchParams struct {
+	Q string `form:"q" json:"q"`
+
+	// CalendarId If omitted, all calendars are searched.
+	CalendarId *string `form:"calendarId,omitempty" json:"calendarId,omitempty"`
+
+	// From Only events ending after this time are returned.
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only events starting before this time are returned. Must be after `from`.
+	To               *time.Time `form:"to,omitempty" json:"to,omitempty"`
+	IncludeCancelled *bool      `form:"includeCancelled,omitempty" json:"includeCancelled,omitempty"`
+	Limit            *int       `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Specify `nextOffset` of the previous response to get the next page. The other parameters must be the same as the previous request.
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetExportParams defines parameters for GetExport.
+type GetExportParams struct {
+	Target *GetExportParamsTarget `form:"target,omitempty" json:"target,omitempty"`
+	Format *GetExportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// CalendarId If omitted, all calendars are exported.
+	CalendarId *string `form:"calendarId,omitempty" json:"calendarId,omitempty"`
+
+	// From Only events ending after this time are exported. Ignored for recurring events.
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only events (or recurring events) starting before this time are exported. Must be after `from`.
+	To               *time.Time `form:"to,omitempty" json:"to,omitempty"`
+	IncludeCancelled *bool      `form:"includeCancelled,omitempty" json:"includeCancelled,omitempty"`
+
+	// Columns Comma-separated columns in the order to be written. If omitted, all columns are written.
+	// - events: `calendar_id`, `id`, `recurring_event_id`, `summary`, `start`, `end`, `original_start`, `all_day`, `transparency`, `status`
+	// - recurring_events: `calendar_id`, `id`, `summary`, `recurrence`, `start`, `end`, `status`
+	Columns *string `form:"columns,omitempty" json:"columns,omitempty"`
+
+	// TimeZone IANA time zone name in which times are written. Times of all-day events are written as dates in the time zone of the calendar.
+	TimeZone *string `form:"timeZone,omitempty" json:"timeZone,omitempty"`
+}
+
+// GetExportParamsTarget defines parameters for GetExport.
+type GetExportParamsTarget string
+
+// GetExportParamsFormat defines parameters for GetExport.
+type GetExportParamsFormat string
+
+// PostFreebusyJSONBody defines parameters for PostFreebusy.
+type PostFreebusyJSONBody struct {
+	CalendarIds []string  `json:"calendarIds"`
+	From        time.Time `json:"from"`
+
+	// To Must be after `from`, within 90 days.
+	To time.Time `json:"to"`
+}
+
+// GetOauthAuthorizeParams defines parameters for GetOauthAuthorize.
+type GetOauthAuthorizeParams struct {
+	CalendarId string `form:"calendarId" json:"calendarId"`
+
+	// Name Required if the calendar is not registered yet. If the calendar is registered, its name is updated.
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+}
+
+// GetOauthCallbackParams defines parameters for GetOauthCallback.
+type GetOauthCallbackParams struct {
+	State *string `form:"state,omitempty" json:"state,omitempty"`
+	Code  *string `form:"code,omitempty" json:"code,omitempty"`
+
+	// Error Set by Google when the user denied access.
+	Error *string `form:"error,omitempty" json:"error,omitempty"`
 }
 
 // PostSyncFutureInstanceParams defines parameters for PostSyncFutureInstance.
 type PostSyncFutureInstanceParams struct {
 	// All This parameter is provided to ensure that the user understands this endpoint will affect all calendars. If you do not explicitly specify true, the request will result in an error.
 	All *bool `form:"all,omitempty" json:"all,omitempty"`
+
+	// Tag If specified, only the calendars with this tag are affected.
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+}
+
+// PostSyncParams defines parameters for PostSync.
+type PostSyncParams struct {
+	// All This parameter is provided to ensure that the user understands this endpoint will affect all calendars. If you do not explicitly specify true, the request will result in an error.
+	All *bool `form:"all,omitempty" json:"all,omitempty"`
+
+	// Tag If specified, only the calendars with this tag are affected.
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// Full If true, all events and the instances of all recurring events are listed again without the sync token, to backfill the columns added after the events were synced. It calls Google Calendar much more than a normal sync.
+	Full *bool `form:"full,omitempty" json:"full,omitempty"`
+}
+
+// GetSyncCalendarIdHistoriesParams defines parameters for GetSyncCalendarIdHistories.
+type GetSyncCalendarIdHistoriesParams struct {
+	// Type `sync` lists the histories of `POST /sync/{calendarId}/`, and `future-instance` lists the histories of `POST /sync-future-instance/`.
+	Type  *GetSyncCalendarIdHistoriesParamsType `form:"type,omitempty" json:"type,omitempty"`
+	Limit *int                                  `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Before Only histories synced before this time are returned. Specify `nextBefore` of the previous response to get the next page.
+	Before *time.Time `form:"before,omitempty" json:"before,omitempty"`
 }
 
+// GetSyncCalendarIdHistoriesParamsType defines parameters for GetSyncCalendarIdHistories.
+type GetSyncCalendarIdHistoriesParamsType string
+
 // PostWatchParams defines parameters for PostWatch.
 type PostWatchParams struct {
 	// All This parameter is provided to ensure that the user understands this endpoint will affect all calendars. If you do not explicitly specify true, the request will result in an error.
 	All *bool `form:"all,omitempty" json:"all,omitempty"`
+
+	// Tag If specified, only the calendars with this tag are affected.
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
 }
 
+// PostAvailabilityJSONRequestBody defines body for PostAvailability for application/json ContentType.
+type PostAvailabilityJSONRequestBody PostAvailabilityJSONBody
+
+// PostCalendarsBulkJSONRequestBody defines body for PostCalendarsBulk for application/json ContentType.
+type PostCalendarsBulkJSONRequestBody PostCalendarsBulkJSONBody
+
+// PatchCalendarsCalendarIdJSONRequestBody defines body for PatchCalendarsCalendarId for application/json ContentType.
+type PatchCalendarsCalendarIdJSONRequestBody PatchCalendarsCalendarIdJSONBody
+
 // PostCalendarsCalendarIdJSONRequestBody defines body for PostCalendarsCalendarId for application/json ContentType.
 type PostCalendarsCalendarIdJSONRequestBody PostCalendarsCalendarIdJSONBody
 
+// PostFreebusyJSONRequestBody defines body for PostFreebusy for application/json ContentType.
+type PostFreebusyJSONRequestBody PostFreebusyJSONBody
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -117,17 +755,94 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// PostAvailabilityWithBody request with any body
+	PostAvailabilityWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAvailability(ctx context.Context, body PostAvailabilityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCalendars request
+	GetCalendars(ctx context.Context, params *GetCalendarsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostCalendarsBulkWithBody request with any body
+	PostCalendarsBulkWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostCalendarsBulk(ctx context.Context, body PostCalendarsBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteCalendarsCalendarId request
+	DeleteCalendarsCalendarId(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCalendarsCalendarId request
+	GetCalendarsCalendarId(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchCalendarsCalendarIdWithBody request with any body
+	PatchCalendarsCalendarIdWithBody(ctx context.Context, calendarId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchCalendarsCalendarId(ctx context.Context, calendarId string, body PatchCalendarsCalendarIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostCalendarsCalendarIdWithBody request with any body
 	PostCalendarsCalendarIdWithBody(ctx context.Context, calendarId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostCalendarsCalendarId(ctx context.Context, calendarId string, body PostCalendarsCalendarIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetCalendarsCalendarIdEvents request
+	GetCalendarsCalendarIdEvents(ctx context.Context, calendarId string, params *GetCalendarsCalendarIdEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteCalendarsCalendarIdFeedToken request
+	DeleteCalendarsCalendarIdFeedToken(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostCalendarsCalendarIdFeedToken request
+	PostCalendarsCalendarIdFeedToken(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCalendarsCalendarIdFeedIcs request
+	GetCalendarsCalendarIdFeedIcs(ctx context.Context, calendarId string, params *GetCalendarsCalendarIdFeedIcsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostCalendarsCalendarIdPause request
+	PostCalendarsCalendarIdPause(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCalendarsCalendarIdRecurringEvents request
+	GetCalendarsCalendarIdRecurringEvents(ctx context.Context, calendarId string, params *GetCalendarsCalendarIdRecurringEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCalendarsCalendarIdRecurringEventsEventId request
+	GetCalendarsCalendarIdRecurringEventsEventId(ctx context.Context, calendarId string, eventId string, params *GetCalendarsCalendarIdRecurringEventsEventIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostCalendarsCalendarIdResume request
+	PostCalendarsCalendarIdResume(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDiscover request
+	GetDiscover(ctx context.Context, params *GetDiscoverParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEventsSearch request
+	GetEventsSearch(ctx context.Context, params *GetEventsSearchParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetExport request
+	GetExport(ctx context.Context, params *GetExportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostFreebusyWithBody request with any body
+	PostFreebusyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostFreebusy(ctx context.Context, body PostFreebusyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOauthAuthorize request
+	GetOauthAuthorize(ctx context.Context, params *GetOauthAuthorizeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOauthCallback request
+	GetOauthCallback(ctx context.Context, params *GetOauthCallbackParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostSyncFutureInstance request
 	PostSyncFutureInstance(ctx context.Context, params *PostSyncFutureInstanceParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostSync request
+	PostSync(ctx context.Context, params *PostSyncParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSyncCalendarId request
+	GetSyncCalendarId(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostSyncCalendarId request
 	PostSyncCalendarId(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetSyncCalendarIdHistories request
+	GetSyncCalendarIdHistories(ctx context.Context, calendarId string, params *GetSyncCalendarIdHistoriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostWatch request
 	PostWatch(ctx context.Context, params *PostWatchParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -138,8 +853,8 @@ type ClientInterface interface {
 	PostWatchCalendarId(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) PostCalendarsCalendarIdWithBody(ctx context.Context, calendarId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostCalendarsCalendarIdRequestWithBody(c.Server, calendarId, contentType, body)
+func (c *Client) PostAvailabilityWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAvailabilityRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -150,8 +865,8 @@ func (c *Client) PostCalendarsCalendarIdWithBody(ctx context.Context, calendarId
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostCalendarsCalendarId(ctx context.Context, calendarId string, body PostCalendarsCalendarIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostCalendarsCalendarIdRequest(c.Server, calendarId, body)
+func (c *Client) PostAvailability(ctx context.Context, body PostAvailabilityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAvailabilityRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -162,8 +877,8 @@ func (c *Client) PostCalendarsCalendarId(ctx context.Context, calendarId string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostSyncFutureInstance(ctx context.Context, params *PostSyncFutureInstanceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostSyncFutureInstanceRequest(c.Server, params)
+func (c *Client) GetCalendars(ctx context.Context, params *GetCalendarsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCalendarsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -174,8 +889,8 @@ func (c *Client) PostSyncFutureInstance(ctx context.Context, params *PostSyncFut
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostSyncCalendarId(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostSyncCalendarIdRequest(c.Server, calendarId)
+func (c *Client) PostCalendarsBulkWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCalendarsBulkRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -186,8 +901,8 @@ func (c *Client) PostSyncCalendarId(ctx context.Context, calendarId string, reqE
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostWatch(ctx context.Context, params *PostWatchParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostWatchRequest(c.Server, params)
+func (c *Client) PostCalendarsBulk(ctx context.Context, body PostCalendarsBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCalendarsBulkRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -198,8 +913,8 @@ func (c *Client) PostWatch(ctx context.Context, params *PostWatchParams, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteWatchCalendarId(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteWatchCalendarIdRequest(c.Server, calendarId)
+func (c *Client) DeleteCalendarsCalendarId(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCalendarsCalendarIdRequest(c.Server, calendarId)
 	if err != nil {
 		return nil, err
 	}
@@ -210,8 +925,8 @@ func (c *Client) DeleteWatchCalendarId(ctx context.Context, calendarId string, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostWatchCalendarId(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostWatchCalendarIdRequest(c.Server, calendarId)
+func (c *Client) GetCalendarsCalendarId(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCalendarsCalendarIdRequest(c.Server, calendarId)
 	if err != nil {
 		return nil, err
 	}
@@ -222,815 +937,5405 @@ func (c *Client) PostWatchCalendarId(ctx context.Context, calendarId string, req
 	return c.Client.Do(req)
 }
 
-// NewPostCalendarsCalendarIdRequest calls the generic PostCalendarsCalendarId builder with application/json body
-func NewPostCalendarsCalendarIdRequest(server string, calendarId string, body PostCalendarsCalendarIdJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PatchCalendarsCalendarIdWithBody(ctx context.Context, calendarId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchCalendarsCalendarIdRequestWithBody(c.Server, calendarId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostCalendarsCalendarIdRequestWithBody(server, calendarId, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostCalendarsCalendarIdRequestWithBody generates requests for PostCalendarsCalendarId with any type of body
-func NewPostCalendarsCalendarIdRequestWithBody(server string, calendarId string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "calendarId", runtime.ParamLocationPath, calendarId)
+func (c *Client) PatchCalendarsCalendarId(ctx context.Context, calendarId string, body PatchCalendarsCalendarIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchCalendarsCalendarIdRequest(c.Server, calendarId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/calendars/%s/", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) PostCalendarsCalendarIdWithBody(ctx context.Context, calendarId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCalendarsCalendarIdRequestWithBody(c.Server, calendarId, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) PostCalendarsCalendarId(ctx context.Context, calendarId string, body PostCalendarsCalendarIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCalendarsCalendarIdRequest(c.Server, calendarId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostSyncFutureInstanceRequest generates requests for PostSyncFutureInstance
-func NewPostSyncFutureInstanceRequest(server string, params *PostSyncFutureInstanceParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetCalendarsCalendarIdEvents(ctx context.Context, calendarId string, params *GetCalendarsCalendarIdEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCalendarsCalendarIdEventsRequest(c.Server, calendarId, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/sync-future-instance/")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteCalendarsCalendarIdFeedToken(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCalendarsCalendarIdFeedTokenRequest(c.Server, calendarId)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.All != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "all", runtime.ParamLocationQuery, *params.All); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+func (c *Client) PostCalendarsCalendarIdFeedToken(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCalendarsCalendarIdFeedTokenRequest(c.Server, calendarId)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostSyncCalendarIdRequest generates requests for PostSyncCalendarId
-func NewPostSyncCalendarIdRequest(server string, calendarId string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "calendarId", runtime.ParamLocationPath, calendarId)
+func (c *Client) GetCalendarsCalendarIdFeedIcs(ctx context.Context, calendarId string, params *GetCalendarsCalendarIdFeedIcsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCalendarsCalendarIdFeedIcsRequest(c.Server, calendarId, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostCalendarsCalendarIdPause(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCalendarsCalendarIdPauseRequest(c.Server, calendarId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/sync/%s/", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetCalendarsCalendarIdRecurringEvents(ctx context.Context, calendarId string, params *GetCalendarsCalendarIdRecurringEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCalendarsCalendarIdRecurringEventsRequest(c.Server, calendarId, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostWatchRequest generates requests for PostWatch
-func NewPostWatchRequest(server string, params *PostWatchParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetCalendarsCalendarIdRecurringEventsEventId(ctx context.Context, calendarId string, eventId string, params *GetCalendarsCalendarIdRecurringEventsEventIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCalendarsCalendarIdRecurringEventsEventIdRequest(c.Server, calendarId, eventId, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/watch/")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostCalendarsCalendarIdResume(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCalendarsCalendarIdResumeRequest(c.Server, calendarId)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.All != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "all", runtime.ParamLocationQuery, *params.All); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+func (c *Client) GetDiscover(ctx context.Context, params *GetDiscoverParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDiscoverRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewDeleteWatchCalendarIdRequest generates requests for DeleteWatchCalendarId
-func NewDeleteWatchCalendarIdRequest(server string, calendarId string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "calendarId", runtime.ParamLocationPath, calendarId)
+func (c *Client) GetEventsSearch(ctx context.Context, params *GetEventsSearchParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEventsSearchRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetExport(ctx context.Context, params *GetExportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetExportRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/watch/%s/", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostFreebusyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostFreebusyRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostWatchCalendarIdRequest generates requests for PostWatchCalendarId
-func NewPostWatchCalendarIdRequest(server string, calendarId string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "calendarId", runtime.ParamLocationPath, calendarId)
+func (c *Client) PostFreebusy(ctx context.Context, body PostFreebusyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostFreebusyRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetOauthAuthorize(ctx context.Context, params *GetOauthAuthorizeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOauthAuthorizeRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/watch/%s/", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetOauthCallback(ctx context.Context, params *GetOauthCallbackParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOauthCallbackRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+func (c *Client) PostSyncFutureInstance(ctx context.Context, params *PostSyncFutureInstanceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSyncFutureInstanceRequest(c.Server, params)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return nil
+	return c.Client.Do(req)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+func (c *Client) PostSync(ctx context.Context, params *PostSyncParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSyncRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+func (c *Client) GetSyncCalendarId(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSyncCalendarIdRequest(c.Server, calendarId)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
+func (c *Client) PostSyncCalendarId(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSyncCalendarIdRequest(c.Server, calendarId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSyncCalendarIdHistories(ctx context.Context, calendarId string, params *GetSyncCalendarIdHistoriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSyncCalendarIdHistoriesRequest(c.Server, calendarId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostWatch(ctx context.Context, params *PostWatchParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWatchRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteWatchCalendarId(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWatchCalendarIdRequest(c.Server, calendarId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostWatchCalendarId(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWatchCalendarIdRequest(c.Server, calendarId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewPostAvailabilityRequest calls the generic PostAvailability builder with application/json body
+func NewPostAvailabilityRequest(server string, body PostAvailabilityJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAvailabilityRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAvailabilityRequestWithBody generates requests for PostAvailability with any type of body
+func NewPostAvailabilityRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/availability/")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetCalendarsRequest generates requests for GetCalendars
+func NewGetCalendarsRequest(server string, params *GetCalendarsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/calendars/")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Tag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag", runtime.ParamLocationQuery, *params.Tag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostCalendarsBulkRequest calls the generic PostCalendarsBulk builder with application/json body
+func NewPostCalendarsBulkRequest(server string, body PostCalendarsBulkJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostCalendarsBulkRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostCalendarsBulkRequestWithBody generates requests for PostCalendarsBulk with any type of body
+func NewPostCalendarsBulkRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/calendars/bulk/")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteCalendarsCalendarIdRequest generates requests for DeleteCalendarsCalendarId
+func NewDeleteCalendarsCalendarIdRequest(server string, calendarId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "calendarId", runtime.ParamLocationPath, calendarId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/calendars/%s/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCalendarsCalendarIdRequest generates requests for GetCalendarsCalendarId
+func NewGetCalendarsCalendarIdRequest(server string, calendarId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "calendarId", runtime.ParamLocationPath, calendarId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/calendars/%s/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchCalendarsCalendarIdRequest calls the generic PatchCalendarsCalendarId builder with application/json body
+func NewPatchCalendarsCalendarIdRequest(server string, calendarId string, body PatchCalendarsCalendarIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchCalendarsCalendarIdRequestWithBody(server, calendarId, "application/json", bodyReader)
+}
+
+// NewPatchCalendarsCalendarIdRequestWithBody generates requests for PatchCalendarsCalendarId with any type of body
+func NewPatchCalendarsCalendarIdRequestWithBody(server string, calendarId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "calendarId", runtime.ParamLocationPath, calendarId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/calendars/%s/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostCalendarsCalendarIdRequest calls the generic PostCalendarsCalendarId builder with application/json body
+func NewPostCalendarsCalendarIdRequest(server string, calendarId string, body PostCalendarsCalendarIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostCalendarsCalendarIdRequestWithBody(server, calendarId, "application/json", bodyReader)
+}
+
+// NewPostCalendarsCalendarIdRequestWithBody generates requests for PostCalendarsCalendarId with any type of body
+func NewPostCalendarsCalendarIdRequestWithBody(server string, calendarId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "calendarId", runtime.ParamLocationPath, calendarId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/calendars/%s/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetCalendarsCalendarIdEventsRequest generates requests for GetCalendarsCalendarIdEvents
+func NewGetCalendarsCalendarIdEventsRequest(server string, calendarId string, params *GetCalendarsCalendarIdEventsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "calendarId", runtime.ParamLocationPath, calendarId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/calendars/%s/events/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.RecurringEventId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "recurringEventId", runtime.ParamLocationQuery, *params.RecurringEventId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Summary != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "summary", runtime.ParamLocationQuery, *params.Summary); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeCancelled != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "includeCancelled", runtime.ParamLocationQuery, *params.IncludeCancelled); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteCalendarsCalendarIdFeedTokenRequest generates requests for DeleteCalendarsCalendarIdFeedToken
+func NewDeleteCalendarsCalendarIdFeedTokenRequest(server string, calendarId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "calendarId", runtime.ParamLocationPath, calendarId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/calendars/%s/feed-token/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostCalendarsCalendarIdFeedTokenRequest generates requests for PostCalendarsCalendarIdFeedToken
+func NewPostCalendarsCalendarIdFeedTokenRequest(server string, calendarId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "calendarId", runtime.ParamLocationPath, calendarId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/calendars/%s/feed-token/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCalendarsCalendarIdFeedIcsRequest generates requests for GetCalendarsCalendarIdFeedIcs
+func NewGetCalendarsCalendarIdFeedIcsRequest(server string, calendarId string, params *GetCalendarsCalendarIdFeedIcsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "calendarId", runtime.ParamLocationPath, calendarId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/calendars/%s/feed.ics", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "token", runtime.ParamLocationQuery, params.Token); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostCalendarsCalendarIdPauseRequest generates requests for PostCalendarsCalendarIdPause
+func NewPostCalendarsCalendarIdPauseRequest(server string, calendarId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "calendarId", runtime.ParamLocationPath, calendarId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/calendars/%s/pause/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCalendarsCalendarIdRecurringEventsRequest generates requests for GetCalendarsCalendarIdRecurringEvents
+func NewGetCalendarsCalendarIdRecurringEventsRequest(server string, calendarId string, params *GetCalendarsCalendarIdRecurringEventsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "calendarId", runtime.ParamLocationPath, calendarId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/calendars/%s/recurring-events/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, params.To); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.IncludeCancelled != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "includeCancelled", runtime.ParamLocationQuery, *params.IncludeCancelled); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCalendarsCalendarIdRecurringEventsEventIdRequest generates requests for GetCalendarsCalendarIdRecurringEventsEventId
+func NewGetCalendarsCalendarIdRecurringEventsEventIdRequest(server string, calendarId string, eventId string, params *GetCalendarsCalendarIdRecurringEventsEventIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "calendarId", runtime.ParamLocationPath, calendarId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "eventId", runtime.ParamLocationPath, eventId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/calendars/%s/recurring-events/%s/", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, params.To); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.IncludeCancelled != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "includeCancelled", runtime.ParamLocationQuery, *params.IncludeCancelled); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostCalendarsCalendarIdResumeRequest generates requests for PostCalendarsCalendarIdResume
+func NewPostCalendarsCalendarIdResumeRequest(server string, calendarId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "calendarId", runtime.ParamLocationPath, calendarId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/calendars/%s/resume/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDiscoverRequest generates requests for GetDiscover
+func NewGetDiscoverRequest(server string, params *GetDiscoverParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/discover/")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Subject != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "subject", runtime.ParamLocationQuery, *params.Subject); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XRefreshToken != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Refresh-Token", runtime.ParamLocationHeader, *params.XRefreshToken)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Refresh-Token", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetEventsSearchRequest generates requests for GetEventsSearch
+func NewGetEventsSearchRequest(server string, params *GetEventsSearchParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/events/search/")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, params.Q); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.CalendarId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "calendarId", runtime.ParamLocationQuery, *params.CalendarId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeCancelled != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "includeCancelled", runtime.ParamLocationQuery, *params.IncludeCancelled); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetExportRequest generates requests for GetExport
+func NewGetExportRequest(server string, params *GetExportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/export/")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Target != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "target", runtime.ParamLocationQuery, *params.Target); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CalendarId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "calendarId", runtime.ParamLocationQuery, *params.CalendarId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeCancelled != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "includeCancelled", runtime.ParamLocationQuery, *params.IncludeCancelled); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Columns != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "columns", runtime.ParamLocationQuery, *params.Columns); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TimeZone != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timeZone", runtime.ParamLocationQuery, *params.TimeZone); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostFreebusyRequest calls the generic PostFreebusy builder with application/json body
+func NewPostFreebusyRequest(server string, body PostFreebusyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostFreebusyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostFreebusyRequestWithBody generates requests for PostFreebusy with any type of body
+func NewPostFreebusyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/freebusy/")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetOauthAuthorizeRequest generates requests for GetOauthAuthorize
+func NewGetOauthAuthorizeRequest(server string, params *GetOauthAuthorizeParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/oauth/authorize/")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "calendarId", runtime.ParamLocationQuery, params.CalendarId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Name != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "name", runtime.ParamLocationQuery, *params.Name); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOauthCallbackRequest generates requests for GetOauthCallback
+func NewGetOauthCallbackRequest(server string, params *GetOauthCallbackParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/oauth/callback/")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.State != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "state", runtime.ParamLocationQuery, *params.State); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Code != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "code", runtime.ParamLocationQuery, *params.Code); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Error != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "error", runtime.ParamLocationQuery, *params.Error); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostSyncFutureInstanceRequest generates requests for PostSyncFutureInstance
+func NewPostSyncFutureInstanceRequest(server string, params *PostSyncFutureInstanceParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sync-future-instance/")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.All != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "all", runtime.ParamLocationQuery, *params.All); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Tag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag", runtime.ParamLocationQuery, *params.Tag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostSyncRequest generates requests for PostSync
+func NewPostSyncRequest(server string, params *PostSyncParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sync/")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.All != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "all", runtime.ParamLocationQuery, *params.All); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Tag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag", runtime.ParamLocationQuery, *params.Tag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Full != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "full", runtime.ParamLocationQuery, *params.Full); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSyncCalendarIdRequest generates requests for GetSyncCalendarId
+func NewGetSyncCalendarIdRequest(server string, calendarId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "calendarId", runtime.ParamLocationPath, calendarId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sync/%s/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostSyncCalendarIdRequest generates requests for PostSyncCalendarId
+func NewPostSyncCalendarIdRequest(server string, calendarId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "calendarId", runtime.ParamLocationPath, calendarId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sync/%s/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSyncCalendarIdHistoriesRequest generates requests for GetSyncCalendarIdHistories
+func NewGetSyncCalendarIdHistoriesRequest(server string, calendarId string, params *GetSyncCalendarIdHistoriesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "calendarId", runtime.ParamLocationPath, calendarId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sync/%s/histories/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Type != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Before != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "before", runtime.ParamLocationQuery, *params.Before); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostWatchRequest generates requests for PostWatch
+func NewPostWatchRequest(server string, params *PostWatchParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/watch/")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.All != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "all", runtime.ParamLocationQuery, *params.All); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Tag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag", runtime.ParamLocationQuery, *params.Tag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteWatchCalendarIdRequest generates requests for DeleteWatchCalendarId
+func NewDeleteWatchCalendarIdRequest(server string, calendarId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "calendarId", runtime.ParamLocationPath, calendarId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/watch/%s/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostWatchCalendarIdRequest generates requests for PostWatchCalendarId
+func NewPostWatchCalendarIdRequest(server string, calendarId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "calendarId", runtime.ParamLocationPath, calendarId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/watch/%s/", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
 		if err != nil {
 			return err
 		}
-		c.Server = newBaseURL.String()
-		return nil
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// PostAvailabilityWithBodyWithResponse request with any body
+	PostAvailabilityWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAvailabilityResponse, error)
+
+	PostAvailabilityWithResponse(ctx context.Context, body PostAvailabilityJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAvailabilityResponse, error)
+
+	// GetCalendarsWithResponse request
+	GetCalendarsWithResponse(ctx context.Context, params *GetCalendarsParams, reqEditors ...RequestEditorFn) (*GetCalendarsResponse, error)
+
+	// PostCalendarsBulkWithBodyWithResponse request with any body
+	PostCalendarsBulkWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCalendarsBulkResponse, error)
+
+	PostCalendarsBulkWithResponse(ctx context.Context, body PostCalendarsBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCalendarsBulkResponse, error)
+
+	// DeleteCalendarsCalendarIdWithResponse request
+	DeleteCalendarsCalendarIdWithResponse(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*DeleteCalendarsCalendarIdResponse, error)
+
+	// GetCalendarsCalendarIdWithResponse request
+	GetCalendarsCalendarIdWithResponse(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*GetCalendarsCalendarIdResponse, error)
+
+	// PatchCalendarsCalendarIdWithBodyWithResponse request with any body
+	PatchCalendarsCalendarIdWithBodyWithResponse(ctx context.Context, calendarId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchCalendarsCalendarIdResponse, error)
+
+	PatchCalendarsCalendarIdWithResponse(ctx context.Context, calendarId string, body PatchCalendarsCalendarIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchCalendarsCalendarIdResponse, error)
+
+	// PostCalendarsCalendarIdWithBodyWithResponse request with any body
+	PostCalendarsCalendarIdWithBodyWithResponse(ctx context.Context, calendarId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCalendarsCalendarIdResponse, error)
+
+	PostCalendarsCalendarIdWithResponse(ctx context.Context, calendarId string, body PostCalendarsCalendarIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCalendarsCalendarIdResponse, error)
+
+	// GetCalendarsCalendarIdEventsWithResponse request
+	GetCalendarsCalendarIdEventsWithResponse(ctx context.Context, calendarId string, params *GetCalendarsCalendarIdEventsParams, reqEditors ...RequestEditorFn) (*GetCalendarsCalendarIdEventsResponse, error)
+
+	// DeleteCalendarsCalendarIdFeedTokenWithResponse request
+	DeleteCalendarsCalendarIdFeedTokenWithResponse(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*DeleteCalendarsCalendarIdFeedTokenResponse, error)
+
+	// PostCalendarsCalendarIdFeedTokenWithResponse request
+	PostCalendarsCalendarIdFeedTokenWithResponse(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*PostCalendarsCalendarIdFeedTokenResponse, error)
+
+	// GetCalendarsCalendarIdFeedIcsWithResponse request
+	GetCalendarsCalendarIdFeedIcsWithResponse(ctx context.Context, calendarId string, params *GetCalendarsCalendarIdFeedIcsParams, reqEditors ...RequestEditorFn) (*GetCalendarsCalendarIdFeedIcsResponse, error)
+
+	// PostCalendarsCalendarIdPauseWithResponse request
+	PostCalendarsCalendarIdPauseWithResponse(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*PostCalendarsCalendarIdPauseResponse, error)
+
+	// GetCalendarsCalendarIdRecurringEventsWithResponse request
+	GetCalendarsCalendarIdRecurringEventsWithResponse(ctx context.Context, calendarId string, params *GetCalendarsCalendarIdRecurringEventsParams, reqEditors ...RequestEditorFn) (*GetCalendarsCalendarIdRecurringEventsResponse, error)
+
+	// GetCalendarsCalendarIdRecurringEventsEventIdWithResponse request
+	GetCalendarsCalendarIdRecurringEventsEventIdWithResponse(ctx context.Context, calendarId string, eventId string, params *GetCalendarsCalendarIdRecurringEventsEventIdParams, reqEditors ...RequestEditorFn) (*GetCalendarsCalendarIdRecurringEventsEventIdResponse, error)
+
+	// PostCalendarsCalendarIdResumeWithResponse request
+	PostCalendarsCalendarIdResumeWithResponse(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*PostCalendarsCalendarIdResumeResponse, error)
+
+	// GetDiscoverWithResponse request
+	GetDiscoverWithResponse(ctx context.Context, params *GetDiscoverParams, reqEditors ...RequestEditorFn) (*GetDiscoverResponse, error)
+
+	// GetEventsSearchWithResponse request
+	GetEventsSearchWithResponse(ctx context.Context, params *GetEventsSearchParams, reqEditors ...RequestEditorFn) (*GetEventsSearchResponse, error)
+
+	// GetExportWithResponse request
+	GetExportWithResponse(ctx context.Context, params *GetExportParams, reqEditors ...RequestEditorFn) (*GetExportResponse, error)
+
+	// PostFreebusyWithBodyWithResponse request with any body
+	PostFreebusyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFreebusyResponse, error)
+
+	PostFreebusyWithResponse(ctx context.Context, body PostFreebusyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostFreebusyResponse, error)
+
+	// GetOauthAuthorizeWithResponse request
+	GetOauthAuthorizeWithResponse(ctx context.Context, params *GetOauthAuthorizeParams, reqEditors ...RequestEditorFn) (*GetOauthAuthorizeResponse, error)
+
+	// GetOauthCallbackWithResponse request
+	GetOauthCallbackWithResponse(ctx context.Context, params *GetOauthCallbackParams, reqEditors ...RequestEditorFn) (*GetOauthCallbackResponse, error)
+
+	// PostSyncFutureInstanceWithResponse request
+	PostSyncFutureInstanceWithResponse(ctx context.Context, params *PostSyncFutureInstanceParams, reqEditors ...RequestEditorFn) (*PostSyncFutureInstanceResponse, error)
+
+	// PostSyncWithResponse request
+	PostSyncWithResponse(ctx context.Context, params *PostSyncParams, reqEditors ...RequestEditorFn) (*PostSyncResponse, error)
+
+	// GetSyncCalendarIdWithResponse request
+	GetSyncCalendarIdWithResponse(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*GetSyncCalendarIdResponse, error)
+
+	// PostSyncCalendarIdWithResponse request
+	PostSyncCalendarIdWithResponse(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*PostSyncCalendarIdResponse, error)
+
+	// GetSyncCalendarIdHistoriesWithResponse request
+	GetSyncCalendarIdHistoriesWithResponse(ctx context.Context, calendarId string, params *GetSyncCalendarIdHistoriesParams, reqEditors ...RequestEditorFn) (*GetSyncCalendarIdHistoriesResponse, error)
+
+	// PostWatchWithResponse request
+	PostWatchWithResponse(ctx context.Context, params *PostWatchParams, reqEditors ...RequestEditorFn) (*PostWatchResponse, error)
+
+	// DeleteWatchCalendarIdWithResponse request
+	DeleteWatchCalendarIdWithResponse(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*DeleteWatchCalendarIdResponse, error)
+
+	// PostWatchCalendarIdWithResponse request
+	PostWatchCalendarIdWithResponse(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*PostWatchCalendarIdResponse, error)
+}
+
+type PostAvailabilityResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AvailabilityResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAvailabilityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAvailabilityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCalendarsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *CalendarListResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCalendarsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCalendarsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostCalendarsBulkResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *CalendarBulkResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON429 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostCalendarsBulkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostCalendarsBulkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCalendarsCalendarIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Status *string `json:"status,omitempty"`
+	}
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCalendarsCalendarIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCalendarsCalendarIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCalendarsCalendarIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *CalendarResponse
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCalendarsCalendarIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCalendarsCalendarIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchCalendarsCalendarIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Status *string `json:"status,omitempty"`
+	}
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON429 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchCalendarsCalendarIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchCalendarsCalendarIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostCalendarsCalendarIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Status *string `json:"status,omitempty"`
+	}
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON429 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostCalendarsCalendarIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostCalendarsCalendarIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCalendarsCalendarIdEventsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *EventListResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCalendarsCalendarIdEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCalendarsCalendarIdEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCalendarsCalendarIdFeedTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Status *string `json:"status,omitempty"`
+	}
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCalendarsCalendarIdFeedTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCalendarsCalendarIdFeedTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostCalendarsCalendarIdFeedTokenResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *FeedTokenResponse
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostCalendarsCalendarIdFeedTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostCalendarsCalendarIdFeedTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCalendarsCalendarIdFeedIcsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCalendarsCalendarIdFeedIcsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCalendarsCalendarIdFeedIcsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostCalendarsCalendarIdPauseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Status *string `json:"status,omitempty"`
+	}
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostCalendarsCalendarIdPauseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostCalendarsCalendarIdPauseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCalendarsCalendarIdRecurringEventsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *RecurringEventListResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCalendarsCalendarIdRecurringEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCalendarsCalendarIdRecurringEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCalendarsCalendarIdRecurringEventsEventIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *RecurringEventResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCalendarsCalendarIdRecurringEventsEventIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCalendarsCalendarIdRecurringEventsEventIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostCalendarsCalendarIdResumeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Status *string `json:"status,omitempty"`
+	}
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostCalendarsCalendarIdResumeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostCalendarsCalendarIdResumeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDiscoverResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *DiscoverResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON429 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDiscoverResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDiscoverResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEventsSearchResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *EventSearchResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEventsSearchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEventsSearchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetExportResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostFreebusyResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *FreeBusyResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostFreebusyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostFreebusyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOauthAuthorizeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOauthAuthorizeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOauthAuthorizeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOauthCallbackResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *OAuthCallbackResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON429 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOauthCallbackResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOauthCallbackResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSyncFutureInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Status *string `json:"status,omitempty"`
+	}
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSyncFutureInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSyncFutureInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSyncResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Status *string `json:"status,omitempty"`
+	}
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSyncResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSyncResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSyncCalendarIdResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *SyncStatusResponse
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSyncCalendarIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSyncCalendarIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSyncCalendarIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Status *string `json:"status,omitempty"`
+	}
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSyncCalendarIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSyncCalendarIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSyncCalendarIdHistoriesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *SyncHistoryListResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSyncCalendarIdHistoriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSyncCalendarIdHistoriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWatchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Status *string `json:"status,omitempty"`
+	}
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteWatchCalendarIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Status *string `json:"status,omitempty"`
+	}
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteWatchCalendarIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteWatchCalendarIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWatchCalendarIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Status *string `json:"status,omitempty"`
+	}
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON500 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWatchCalendarIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWatchCalendarIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// PostAvailabilityWithBodyWithResponse request with arbitrary body returning *PostAvailabilityResponse
+func (c *ClientWithResponses) PostAvailabilityWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAvailabilityResponse, error) {
+	rsp, err := c.PostAvailabilityWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAvailabilityResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAvailabilityWithResponse(ctx context.Context, body PostAvailabilityJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAvailabilityResponse, error) {
+	rsp, err := c.PostAvailability(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAvailabilityResponse(rsp)
+}
+
+// GetCalendarsWithResponse request returning *GetCalendarsResponse
+func (c *ClientWithResponses) GetCalendarsWithResponse(ctx context.Context, params *GetCalendarsParams, reqEditors ...RequestEditorFn) (*GetCalendarsResponse, error) {
+	rsp, err := c.GetCalendars(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCalendarsResponse(rsp)
+}
+
+// PostCalendarsBulkWithBodyWithResponse request with arbitrary body returning *PostCalendarsBulkResponse
+func (c *ClientWithResponses) PostCalendarsBulkWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCalendarsBulkResponse, error) {
+	rsp, err := c.PostCalendarsBulkWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCalendarsBulkResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostCalendarsBulkWithResponse(ctx context.Context, body PostCalendarsBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCalendarsBulkResponse, error) {
+	rsp, err := c.PostCalendarsBulk(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCalendarsBulkResponse(rsp)
+}
+
+// DeleteCalendarsCalendarIdWithResponse request returning *DeleteCalendarsCalendarIdResponse
+func (c *ClientWithResponses) DeleteCalendarsCalendarIdWithResponse(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*DeleteCalendarsCalendarIdResponse, error) {
+	rsp, err := c.DeleteCalendarsCalendarId(ctx, calendarId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCalendarsCalendarIdResponse(rsp)
+}
+
+// GetCalendarsCalendarIdWithResponse request returning *GetCalendarsCalendarIdResponse
+func (c *ClientWithResponses) GetCalendarsCalendarIdWithResponse(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*GetCalendarsCalendarIdResponse, error) {
+	rsp, err := c.GetCalendarsCalendarId(ctx, calendarId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCalendarsCalendarIdResponse(rsp)
+}
+
+// PatchCalendarsCalendarIdWithBodyWithResponse request with arbitrary body returning *PatchCalendarsCalendarIdResponse
+func (c *ClientWithResponses) PatchCalendarsCalendarIdWithBodyWithResponse(ctx context.Context, calendarId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchCalendarsCalendarIdResponse, error) {
+	rsp, err := c.PatchCalendarsCalendarIdWithBody(ctx, calendarId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchCalendarsCalendarIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchCalendarsCalendarIdWithResponse(ctx context.Context, calendarId string, body PatchCalendarsCalendarIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchCalendarsCalendarIdResponse, error) {
+	rsp, err := c.PatchCalendarsCalendarId(ctx, calendarId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchCalendarsCalendarIdResponse(rsp)
+}
+
+// PostCalendarsCalendarIdWithBodyWithResponse request with arbitrary body returning *PostCalendarsCalendarIdResponse
+func (c *ClientWithResponses) PostCalendarsCalendarIdWithBodyWithResponse(ctx context.Context, calendarId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCalendarsCalendarIdResponse, error) {
+	rsp, err := c.PostCalendarsCalendarIdWithBody(ctx, calendarId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCalendarsCalendarIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostCalendarsCalendarIdWithResponse(ctx context.Context, calendarId string, body PostCalendarsCalendarIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCalendarsCalendarIdResponse, error) {
+	rsp, err := c.PostCalendarsCalendarId(ctx, calendarId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCalendarsCalendarIdResponse(rsp)
+}
+
+// GetCalendarsCalendarIdEventsWithResponse request returning *GetCalendarsCalendarIdEventsResponse
+func (c *ClientWithResponses) GetCalendarsCalendarIdEventsWithResponse(ctx context.Context, calendarId string, params *GetCalendarsCalendarIdEventsParams, reqEditors ...RequestEditorFn) (*GetCalendarsCalendarIdEventsResponse, error) {
+	rsp, err := c.GetCalendarsCalendarIdEvents(ctx, calendarId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCalendarsCalendarIdEventsResponse(rsp)
+}
+
+// DeleteCalendarsCalendarIdFeedTokenWithResponse request returning *DeleteCalendarsCalendarIdFeedTokenResponse
+func (c *ClientWithResponses) DeleteCalendarsCalendarIdFeedTokenWithResponse(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*DeleteCalendarsCalendarIdFeedTokenResponse, error) {
+	rsp, err := c.DeleteCalendarsCalendarIdFeedToken(ctx, calendarId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCalendarsCalendarIdFeedTokenResponse(rsp)
+}
+
+// PostCalendarsCalendarIdFeedTokenWithResponse request returning *PostCalendarsCalendarIdFeedTokenResponse
+func (c *ClientWithResponses) PostCalendarsCalendarIdFeedTokenWithResponse(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*PostCalendarsCalendarIdFeedTokenResponse, error) {
+	rsp, err := c.PostCalendarsCalendarIdFeedToken(ctx, calendarId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCalendarsCalendarIdFeedTokenResponse(rsp)
+}
+
+// GetCalendarsCalendarIdFeedIcsWithResponse request returning *GetCalendarsCalendarIdFeedIcsResponse
+func (c *ClientWithResponses) GetCalendarsCalendarIdFeedIcsWithResponse(ctx context.Context, calendarId string, params *GetCalendarsCalendarIdFeedIcsParams, reqEditors ...RequestEditorFn) (*GetCalendarsCalendarIdFeedIcsResponse, error) {
+	rsp, err := c.GetCalendarsCalendarIdFeedIcs(ctx, calendarId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCalendarsCalendarIdFeedIcsResponse(rsp)
+}
+
+// PostCalendarsCalendarIdPauseWithResponse request returning *PostCalendarsCalendarIdPauseResponse
+func (c *ClientWithResponses) PostCalendarsCalendarIdPauseWithResponse(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*PostCalendarsCalendarIdPauseResponse, error) {
+	rsp, err := c.PostCalendarsCalendarIdPause(ctx, calendarId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCalendarsCalendarIdPauseResponse(rsp)
+}
+
+// GetCalendarsCalendarIdRecurringEventsWithResponse request returning *GetCalendarsCalendarIdRecurringEventsResponse
+func (c *ClientWithResponses) GetCalendarsCalendarIdRecurringEventsWithResponse(ctx context.Context, calendarId string, params *GetCalendarsCalendarIdRecurringEventsParams, reqEditors ...RequestEditorFn) (*GetCalendarsCalendarIdRecurringEventsResponse, error) {
+	rsp, err := c.GetCalendarsCalendarIdRecurringEvents(ctx, calendarId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCalendarsCalendarIdRecurringEventsResponse(rsp)
+}
+
+// GetCalendarsCalendarIdRecurringEventsEventIdWithResponse request returning *GetCalendarsCalendarIdRecurringEventsEventIdResponse
+func (c *ClientWithResponses) GetCalendarsCalendarIdRecurringEventsEventIdWithResponse(ctx context.Context, calendarId string, eventId string, params *GetCalendarsCalendarIdRecurringEventsEventIdParams, reqEditors ...RequestEditorFn) (*GetCalendarsCalendarIdRecurringEventsEventIdResponse, error) {
+	rsp, err := c.GetCalendarsCalendarIdRecurringEventsEventId(ctx, calendarId, eventId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCalendarsCalendarIdRecurringEventsEventIdResponse(rsp)
+}
+
+// PostCalendarsCalendarIdResumeWithResponse request returning *PostCalendarsCalendarIdResumeResponse
+func (c *ClientWithResponses) PostCalendarsCalendarIdResumeWithResponse(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*PostCalendarsCalendarIdResumeResponse, error) {
+	rsp, err := c.PostCalendarsCalendarIdResume(ctx, calendarId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCalendarsCalendarIdResumeResponse(rsp)
+}
+
+// GetDiscoverWithResponse request returning *GetDiscoverResponse
+func (c *ClientWithResponses) GetDiscoverWithResponse(ctx context.Context, params *GetDiscoverParams, reqEditors ...RequestEditorFn) (*GetDiscoverResponse, error) {
+	rsp, err := c.GetDiscover(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDiscoverResponse(rsp)
+}
+
+// GetEventsSearchWithResponse request returning *GetEventsSearchResponse
+func (c *ClientWithResponses) GetEventsSearchWithResponse(ctx context.Context, params *GetEventsSearchParams, reqEditors ...RequestEditorFn) (*GetEventsSearchResponse, error) {
+	rsp, err := c.GetEventsSearch(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEventsSearchResponse(rsp)
+}
+
+// GetExportWithResponse request returning *GetExportResponse
+func (c *ClientWithResponses) GetExportWithResponse(ctx context.Context, params *GetExportParams, reqEditors ...RequestEditorFn) (*GetExportResponse, error) {
+	rsp, err := c.GetExport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetExportResponse(rsp)
+}
+
+// PostFreebusyWithBodyWithResponse request with arbitrary body returning *PostFreebusyResponse
+func (c *ClientWithResponses) PostFreebusyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFreebusyResponse, error) {
+	rsp, err := c.PostFreebusyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostFreebusyResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostFreebusyWithResponse(ctx context.Context, body PostFreebusyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostFreebusyResponse, error) {
+	rsp, err := c.PostFreebusy(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostFreebusyResponse(rsp)
+}
+
+// GetOauthAuthorizeWithResponse request returning *GetOauthAuthorizeResponse
+func (c *ClientWithResponses) GetOauthAuthorizeWithResponse(ctx context.Context, params *GetOauthAuthorizeParams, reqEditors ...RequestEditorFn) (*GetOauthAuthorizeResponse, error) {
+	rsp, err := c.GetOauthAuthorize(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOauthAuthorizeResponse(rsp)
+}
+
+// GetOauthCallbackWithResponse request returning *GetOauthCallbackResponse
+func (c *ClientWithResponses) GetOauthCallbackWithResponse(ctx context.Context, params *GetOauthCallbackParams, reqEditors ...RequestEditorFn) (*GetOauthCallbackResponse, error) {
+	rsp, err := c.GetOauthCallback(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOauthCallbackResponse(rsp)
+}
+
+// PostSyncFutureInstanceWithResponse request returning *PostSyncFutureInstanceResponse
+func (c *ClientWithResponses) PostSyncFutureInstanceWithResponse(ctx context.Context, params *PostSyncFutureInstanceParams, reqEditors ...RequestEditorFn) (*PostSyncFutureInstanceResponse, error) {
+	rsp, err := c.PostSyncFutureInstance(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSyncFutureInstanceResponse(rsp)
+}
+
+// PostSyncWithResponse request returning *PostSyncResponse
+func (c *ClientWithResponses) PostSyncWithResponse(ctx context.Context, params *PostSyncParams, reqEditors ...RequestEditorFn) (*PostSyncResponse, error) {
+	rsp, err := c.PostSync(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSyncResponse(rsp)
+}
+
+// GetSyncCalendarIdWithResponse request returning *GetSyncCalendarIdResponse
+func (c *ClientWithResponses) GetSyncCalendarIdWithResponse(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*GetSyncCalendarIdResponse, error) {
+	rsp, err := c.GetSyncCalendarId(ctx, calendarId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSyncCalendarIdResponse(rsp)
+}
+
+// PostSyncCalendarIdWithResponse request returning *PostSyncCalendarIdResponse
+func (c *ClientWithResponses) PostSyncCalendarIdWithResponse(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*PostSyncCalendarIdResponse, error) {
+	rsp, err := c.PostSyncCalendarId(ctx, calendarId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSyncCalendarIdResponse(rsp)
+}
+
+// GetSyncCalendarIdHistoriesWithResponse request returning *GetSyncCalendarIdHistoriesResponse
+func (c *ClientWithResponses) GetSyncCalendarIdHistoriesWithResponse(ctx context.Context, calendarId string, params *GetSyncCalendarIdHistoriesParams, reqEditors ...RequestEditorFn) (*GetSyncCalendarIdHistoriesResponse, error) {
+	rsp, err := c.GetSyncCalendarIdHistories(ctx, calendarId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSyncCalendarIdHistoriesResponse(rsp)
+}
+
+// PostWatchWithResponse request returning *PostWatchResponse
+func (c *ClientWithResponses) PostWatchWithResponse(ctx context.Context, params *PostWatchParams, reqEditors ...RequestEditorFn) (*PostWatchResponse, error) {
+	rsp, err := c.PostWatch(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWatchResponse(rsp)
+}
+
+// DeleteWatchCalendarIdWithResponse request returning *DeleteWatchCalendarIdResponse
+func (c *ClientWithResponses) DeleteWatchCalendarIdWithResponse(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*DeleteWatchCalendarIdResponse, error) {
+	rsp, err := c.DeleteWatchCalendarId(ctx, calendarId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteWatchCalendarIdResponse(rsp)
+}
+
+// PostWatchCalendarIdWithResponse request returning *PostWatchCalendarIdResponse
+func (c *ClientWithResponses) PostWatchCalendarIdWithResponse(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*PostWatchCalendarIdResponse, error) {
+	rsp, err := c.PostWatchCalendarId(ctx, calendarId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWatchCalendarIdResponse(rsp)
+}
+
+// ParsePostAvailabilityResponse parses an HTTP response from a PostAvailabilityWithResponse call
+func ParsePostAvailabilityResponse(rsp *http.Response) (*PostAvailabilityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAvailabilityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AvailabilityResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCalendarsResponse parses an HTTP response from a GetCalendarsWithResponse call
+func ParseGetCalendarsResponse(rsp *http.Response) (*GetCalendarsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCalendarsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CalendarListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostCalendarsBulkResponse parses an HTTP response from a PostCalendarsBulkWithResponse call
+func ParsePostCalendarsBulkResponse(rsp *http.Response) (*PostCalendarsBulkResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostCalendarsBulkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CalendarBulkResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteCalendarsCalendarIdResponse parses an HTTP response from a DeleteCalendarsCalendarIdWithResponse call
+func ParseDeleteCalendarsCalendarIdResponse(rsp *http.Response) (*DeleteCalendarsCalendarIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteCalendarsCalendarIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Status *string `json:"status,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCalendarsCalendarIdResponse parses an HTTP response from a GetCalendarsCalendarIdWithResponse call
+func ParseGetCalendarsCalendarIdResponse(rsp *http.Response) (*GetCalendarsCalendarIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCalendarsCalendarIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CalendarResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchCalendarsCalendarIdResponse parses an HTTP response from a PatchCalendarsCalendarIdWithResponse call
+func ParsePatchCalendarsCalendarIdResponse(rsp *http.Response) (*PatchCalendarsCalendarIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchCalendarsCalendarIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Status *string `json:"status,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostCalendarsCalendarIdResponse parses an HTTP response from a PostCalendarsCalendarIdWithResponse call
+func ParsePostCalendarsCalendarIdResponse(rsp *http.Response) (*PostCalendarsCalendarIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostCalendarsCalendarIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Status *string `json:"status,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCalendarsCalendarIdEventsResponse parses an HTTP response from a GetCalendarsCalendarIdEventsWithResponse call
+func ParseGetCalendarsCalendarIdEventsResponse(rsp *http.Response) (*GetCalendarsCalendarIdEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCalendarsCalendarIdEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EventListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteCalendarsCalendarIdFeedTokenResponse parses an HTTP response from a DeleteCalendarsCalendarIdFeedTokenWithResponse call
+func ParseDeleteCalendarsCalendarIdFeedTokenResponse(rsp *http.Response) (*DeleteCalendarsCalendarIdFeedTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteCalendarsCalendarIdFeedTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Status *string `json:"status,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostCalendarsCalendarIdFeedTokenResponse parses an HTTP response from a PostCalendarsCalendarIdFeedTokenWithResponse call
+func ParsePostCalendarsCalendarIdFeedTokenResponse(rsp *http.Response) (*PostCalendarsCalendarIdFeedTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostCalendarsCalendarIdFeedTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FeedTokenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCalendarsCalendarIdFeedIcsResponse parses an HTTP response from a GetCalendarsCalendarIdFeedIcsWithResponse call
+func ParseGetCalendarsCalendarIdFeedIcsResponse(rsp *http.Response) (*GetCalendarsCalendarIdFeedIcsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCalendarsCalendarIdFeedIcsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostCalendarsCalendarIdPauseResponse parses an HTTP response from a PostCalendarsCalendarIdPauseWithResponse call
+func ParsePostCalendarsCalendarIdPauseResponse(rsp *http.Response) (*PostCalendarsCalendarIdPauseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostCalendarsCalendarIdPauseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Status *string `json:"status,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCalendarsCalendarIdRecurringEventsResponse parses an HTTP response from a GetCalendarsCalendarIdRecurringEventsWithResponse call
+func ParseGetCalendarsCalendarIdRecurringEventsResponse(rsp *http.Response) (*GetCalendarsCalendarIdRecurringEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCalendarsCalendarIdRecurringEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecurringEventListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCalendarsCalendarIdRecurringEventsEventIdResponse parses an HTTP response from a GetCalendarsCalendarIdRecurringEventsEventIdWithResponse call
+func ParseGetCalendarsCalendarIdRecurringEventsEventIdResponse(rsp *http.Response) (*GetCalendarsCalendarIdRecurringEventsEventIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCalendarsCalendarIdRecurringEventsEventIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecurringEventResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostCalendarsCalendarIdResumeResponse parses an HTTP response from a PostCalendarsCalendarIdResumeWithResponse call
+func ParsePostCalendarsCalendarIdResumeResponse(rsp *http.Response) (*PostCalendarsCalendarIdResumeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostCalendarsCalendarIdResumeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Status *string `json:"status,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDiscoverResponse parses an HTTP response from a GetDiscoverWithResponse call
+func ParseGetDiscoverResponse(rsp *http.Response) (*GetDiscoverResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDiscoverResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DiscoverResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEventsSearchResponse parses an HTTP response from a GetEventsSearchWithResponse call
+func ParseGetEventsSearchResponse(rsp *http.Response) (*GetEventsSearchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEventsSearchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EventSearchResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetExportResponse parses an HTTP response from a GetExportWithResponse call
+func ParseGetExportResponse(rsp *http.Response) (*GetExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostFreebusyResponse parses an HTTP response from a PostFreebusyWithResponse call
+func ParsePostFreebusyResponse(rsp *http.Response) (*PostFreebusyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostFreebusyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FreeBusyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOauthAuthorizeResponse parses an HTTP response from a GetOauthAuthorizeWithResponse call
+func ParseGetOauthAuthorizeResponse(rsp *http.Response) (*GetOauthAuthorizeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOauthAuthorizeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOauthCallbackResponse parses an HTTP response from a GetOauthCallbackWithResponse call
+func ParseGetOauthCallbackResponse(rsp *http.Response) (*GetOauthCallbackResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOauthCallbackResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OAuthCallbackResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSyncFutureInstanceResponse parses an HTTP response from a PostSyncFutureInstanceWithResponse call
+func ParsePostSyncFutureInstanceResponse(rsp *http.Response) (*PostSyncFutureInstanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSyncFutureInstanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Status *string `json:"status,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSyncResponse parses an HTTP response from a PostSyncWithResponse call
+func ParsePostSyncResponse(rsp *http.Response) (*PostSyncResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSyncResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Status *string `json:"status,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSyncCalendarIdResponse parses an HTTP response from a GetSyncCalendarIdWithResponse call
+func ParseGetSyncCalendarIdResponse(rsp *http.Response) (*GetSyncCalendarIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSyncCalendarIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SyncStatusResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSyncCalendarIdResponse parses an HTTP response from a PostSyncCalendarIdWithResponse call
+func ParsePostSyncCalendarIdResponse(rsp *http.Response) (*PostSyncCalendarIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSyncCalendarIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Status *string `json:"status,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSyncCalendarIdHistoriesResponse parses an HTTP response from a GetSyncCalendarIdHistoriesWithResponse call
+func ParseGetSyncCalendarIdHistoriesResponse(rsp *http.Response) (*GetSyncCalendarIdHistoriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSyncCalendarIdHistoriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SyncHistoryListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostWatchResponse parses an HTTP response from a PostWatchWithResponse call
+func ParsePostWatchResponse(rsp *http.Response) (*PostWatchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// PostCalendarsCalendarIdWithBodyWithResponse request with any body
-	PostCalendarsCalendarIdWithBodyWithResponse(ctx context.Context, calendarId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCalendarsCalendarIdResponse, error)
+	response := &PostWatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	PostCalendarsCalendarIdWithResponse(ctx context.Context, calendarId string, body PostCalendarsCalendarIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCalendarsCalendarIdResponse, error)
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Status *string `json:"status,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	// PostSyncFutureInstanceWithResponse request
-	PostSyncFutureInstanceWithResponse(ctx context.Context, params *PostSyncFutureInstanceParams, reqEditors ...RequestEditorFn) (*PostSyncFutureInstanceResponse, error)
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
 
-	// PostSyncCalendarIdWithResponse request
-	PostSyncCalendarIdWithResponse(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*PostSyncCalendarIdResponse, error)
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
 
-	// PostWatchWithResponse request
-	PostWatchWithResponse(ctx context.Context, params *PostWatchParams, reqEditors ...RequestEditorFn) (*PostWatchResponse, error)
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
 
-	// DeleteWatchCalendarIdWithResponse request
-	DeleteWatchCalendarIdWithResponse(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*DeleteWatchCalendarIdResponse, error)
+	}
 
-	// PostWatchCalendarIdWithResponse request
-	PostWatchCalendarIdWithResponse(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*PostWatchCalendarIdResponse, error)
+	return response, nil
 }
 
-type PostCalendarsCalendarIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *struct {
-		Status *string `json:"status,omitempty"`
+// ParseDeleteWatchCalendarIdResponse parses an HTTP response from a DeleteWatchCalendarIdWithResponse call
+func ParseDeleteWatchCalendarIdResponse(rsp *http.Response) (*DeleteWatchCalendarIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	JSON401 *struct {
-		Message *string `json:"message,omitempty"`
-		Status  *string `json:"status,omitempty"`
+
+	response := &DeleteWatchCalendarIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r PostCalendarsCalendarIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Status *string `json:"status,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostCalendarsCalendarIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParsePostWatchCalendarIdResponse parses an HTTP response from a PostWatchCalendarIdWithResponse call
+func ParsePostWatchCalendarIdResponse(rsp *http.Response) (*PostWatchCalendarIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PostSyncFutureInstanceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Status *string `json:"status,omitempty"`
+	response := &PostWatchCalendarIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	JSON404 *struct {
-		Message *string `json:"message,omitempty"`
-		Status  *string `json:"status,omitempty"`
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Status *string `json:"status,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
 	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PostSyncFutureInstanceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Find common free slots of calendars
+	// (POST /availability/)
+	PostAvailability(ctx echo.Context) error
+	// List registered calendars
+	// (GET /calendars/)
+	GetCalendars(ctx echo.Context, params GetCalendarsParams) error
+	// Register multiple calendars at once
+	// (POST /calendars/bulk/)
+	PostCalendarsBulk(ctx echo.Context) error
+	// Delete a calendar
+	// (DELETE /calendars/{calendarId}/)
+	DeleteCalendarsCalendarId(ctx echo.Context, calendarId string) error
+	// Get a calendar
+	// (GET /calendars/{calendarId}/)
+	GetCalendarsCalendarId(ctx echo.Context, calendarId string) error
+	// Update a calendar
+	// (PATCH /calendars/{calendarId}/)
+	PatchCalendarsCalendarId(ctx echo.Context, calendarId string) error
+	// Create a new calendar
+	// (POST /calendars/{calendarId}/)
+	PostCalendarsCalendarId(ctx echo.Context, calendarId string) error
+	// List synced events of a calendar
+	// (GET /calendars/{calendarId}/events/)
+	GetCalendarsCalendarIdEvents(ctx echo.Context, calendarId string, params GetCalendarsCalendarIdEventsParams) error
+	// Unpublish the iCalendar feed of a calendar
+	// (DELETE /calendars/{calendarId}/feed-token/)
+	DeleteCalendarsCalendarIdFeedToken(ctx echo.Context, calendarId string) error
+	// Publish the iCalendar feed of a calendar
+	// (POST /calendars/{calendarId}/feed-token/)
+	PostCalendarsCalendarIdFeedToken(ctx echo.Context, calendarId string) error
+	// Get the iCalendar feed of a calendar
+	// (GET /calendars/{calendarId}/feed.ics)
+	GetCalendarsCalendarIdFeedIcs(ctx echo.Context, calendarId string, params GetCalendarsCalendarIdFeedIcsParams) error
+	// Pause syncing a calendar
+	// (POST /calendars/{calendarId}/pause/)
+	PostCalendarsCalendarIdPause(ctx echo.Context, calendarId string) error
+	// List synced recurring events of a calendar with their instances
+	// (GET /calendars/{calendarId}/recurring-events/)
+	GetCalendarsCalendarIdRecurringEvents(ctx echo.Context, calendarId string, params GetCalendarsCalendarIdRecurringEventsParams) error
+	// Get a synced recurring event with its instances
+	// (GET /calendars/{calendarId}/recurring-events/{eventId}/)
+	GetCalendarsCalendarIdRecurringEventsEventId(ctx echo.Context, calendarId string, eventId string, params GetCalendarsCalendarIdRecurringEventsEventIdParams) error
+	// Resume syncing a calendar
+	// (POST /calendars/{calendarId}/resume/)
+	PostCalendarsCalendarIdResume(ctx echo.Context, calendarId string) error
+	// List calendars accessible from this application
+	// (GET /discover/)
+	GetDiscover(ctx echo.Context, params GetDiscoverParams) error
+	// Search synced events by text
+	// (GET /events/search/)
+	GetEventsSearch(ctx echo.Context, params GetEventsSearchParams) error
+	// Export synced events as CSV or JSON Lines
+	// (GET /export/)
+	GetExport(ctx echo.Context, params GetExportParams) error
+	// Get busy intervals of calendars
+	// (POST /freebusy/)
+	PostFreebusy(ctx echo.Context) error
+	// Start the OAuth 2.0 authorization code flow
+	// (GET /oauth/authorize/)
+	GetOauthAuthorize(ctx echo.Context, params GetOauthAuthorizeParams) error
+	// Complete the OAuth 2.0 authorization code flow
+	// (GET /oauth/callback/)
+	GetOauthCallback(ctx echo.Context, params GetOauthCallbackParams) error
+	// Sync future instance events for all calendars, or the calendars with a tag
+	// (POST /sync-future-instance/)
+	PostSyncFutureInstance(ctx echo.Context, params PostSyncFutureInstanceParams) error
+	// Sync all calendars, or the calendars with a tag
+	// (POST /sync/)
+	PostSync(ctx echo.Context, params PostSyncParams) error
+	// Get the sync status of a calendar
+	// (GET /sync/{calendarId}/)
+	GetSyncCalendarId(ctx echo.Context, calendarId string) error
+	// Sync calendar information with local DB
+	// (POST /sync/{calendarId}/)
+	PostSyncCalendarId(ctx echo.Context, calendarId string) error
+	// List sync histories of a calendar
+	// (GET /sync/{calendarId}/histories/)
+	GetSyncCalendarIdHistories(ctx echo.Context, calendarId string, params GetSyncCalendarIdHistoriesParams) error
+	// Start watching all calendars, or the calendars with a tag
+	// (POST /watch/)
+	PostWatch(ctx echo.Context, params PostWatchParams) error
+	// Stop watching a calendar
+	// (DELETE /watch/{calendarId}/)
+	DeleteWatchCalendarId(ctx echo.Context, calendarId string) error
+	// Start watching a calendar
+	// (POST /watch/{calendarId}/)
+	PostWatchCalendarId(ctx echo.Context, calendarId string) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// PostAvailability converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAvailability(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAvailability(ctx)
+	return err
+}
+
+// GetCalendars converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCalendars(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCalendarsParams
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", ctx.QueryParams(), &params.Tag)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag: %s", err))
 	}
-	return http.StatusText(0)
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCalendars(ctx, params)
+	return err
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostSyncFutureInstanceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PostCalendarsBulk converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCalendarsBulk(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostCalendarsBulk(ctx)
+	return err
+}
+
+// DeleteCalendarsCalendarId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCalendarsCalendarId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "calendarId" -------------
+	var calendarId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "calendarId", ctx.Param("calendarId"), &calendarId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter calendarId: %s", err))
 	}
-	return 0
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteCalendarsCalendarId(ctx, calendarId)
+	return err
 }
 
-type PostSyncCalendarIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Status *string `json:"status,omitempty"`
+// GetCalendarsCalendarId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCalendarsCalendarId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "calendarId" -------------
+	var calendarId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "calendarId", ctx.Param("calendarId"), &calendarId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter calendarId: %s", err))
 	}
-	JSON404 *struct {
-		Message *string `json:"message,omitempty"`
-		Status  *string `json:"status,omitempty"`
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCalendarsCalendarId(ctx, calendarId)
+	return err
+}
+
+// PatchCalendarsCalendarId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchCalendarsCalendarId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "calendarId" -------------
+	var calendarId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "calendarId", ctx.Param("calendarId"), &calendarId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter calendarId: %s", err))
 	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchCalendarsCalendarId(ctx, calendarId)
+	return err
 }
 
-// Status returns HTTPResponse.Status
-func (r PostSyncCalendarIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// PostCalendarsCalendarId converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCalendarsCalendarId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "calendarId" -------------
+	var calendarId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "calendarId", ctx.Param("calendarId"), &calendarId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter calendarId: %s", err))
 	}
-	return http.StatusText(0)
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostCalendarsCalendarId(ctx, calendarId)
+	return err
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostSyncCalendarIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetCalendarsCalendarIdEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCalendarsCalendarIdEvents(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "calendarId" -------------
+	var calendarId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "calendarId", ctx.Param("calendarId"), &calendarId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter calendarId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCalendarsCalendarIdEventsParams
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
 	}
-	return 0
-}
 
-type PostWatchResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Status *string `json:"status,omitempty"`
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
 	}
-	JSON404 *struct {
-		Message *string `json:"message,omitempty"`
-		Status  *string `json:"status,omitempty"`
+
+	// ------------- Optional query parameter "recurringEventId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "recurringEventId", ctx.QueryParams(), &params.RecurringEventId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter recurringEventId: %s", err))
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r PostWatchResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	// ------------- Optional query parameter "summary" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "summary", ctx.QueryParams(), &params.Summary)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter summary: %s", err))
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostWatchResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// ------------- Optional query parameter "includeCancelled" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeCancelled", ctx.QueryParams(), &params.IncludeCancelled)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter includeCancelled: %s", err))
 	}
-	return 0
-}
 
-type DeleteWatchCalendarIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Status *string `json:"status,omitempty"`
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
-	JSON404 *struct {
-		Message *string `json:"message,omitempty"`
-		Status  *string `json:"status,omitempty"`
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
 	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCalendarsCalendarIdEvents(ctx, calendarId, params)
+	return err
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteWatchCalendarIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeleteCalendarsCalendarIdFeedToken converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCalendarsCalendarIdFeedToken(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "calendarId" -------------
+	var calendarId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "calendarId", ctx.Param("calendarId"), &calendarId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter calendarId: %s", err))
 	}
-	return http.StatusText(0)
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteCalendarsCalendarIdFeedToken(ctx, calendarId)
+	return err
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteWatchCalendarIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PostCalendarsCalendarIdFeedToken converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCalendarsCalendarIdFeedToken(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "calendarId" -------------
+	var calendarId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "calendarId", ctx.Param("calendarId"), &calendarId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter calendarId: %s", err))
 	}
-	return 0
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostCalendarsCalendarIdFeedToken(ctx, calendarId)
+	return err
 }
 
-type PostWatchCalendarIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Status *string `json:"status,omitempty"`
-	}
-	JSON404 *struct {
-		Message *string `json:"message,omitempty"`
-		Status  *string `json:"status,omitempty"`
+// GetCalendarsCalendarIdFeedIcs converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCalendarsCalendarIdFeedIcs(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "calendarId" -------------
+	var calendarId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "calendarId", ctx.Param("calendarId"), &calendarId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter calendarId: %s", err))
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r PostWatchCalendarIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCalendarsCalendarIdFeedIcsParams
+	// ------------- Required query parameter "token" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "token", ctx.QueryParams(), &params.Token)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
 	}
-	return http.StatusText(0)
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCalendarsCalendarIdFeedIcs(ctx, calendarId, params)
+	return err
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostWatchCalendarIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PostCalendarsCalendarIdPause converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCalendarsCalendarIdPause(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "calendarId" -------------
+	var calendarId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "calendarId", ctx.Param("calendarId"), &calendarId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter calendarId: %s", err))
 	}
-	return 0
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostCalendarsCalendarIdPause(ctx, calendarId)
+	return err
 }
 
-// PostCalendarsCalendarIdWithBodyWithResponse request with arbitrary body returning *PostCalendarsCalendarIdResponse
-func (c *ClientWithResponses) PostCalendarsCalendarIdWithBodyWithResponse(ctx context.Context, calendarId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostCalendarsCalendarIdResponse, error) {
-	rsp, err := c.PostCalendarsCalendarIdWithBody(ctx, calendarId, contentType, body, reqEditors...)
+// GetCalendarsCalendarIdRecurringEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCalendarsCalendarIdRecurringEvents(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "calendarId" -------------
+	var calendarId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "calendarId", ctx.Param("calendarId"), &calendarId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter calendarId: %s", err))
 	}
-	return ParsePostCalendarsCalendarIdResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostCalendarsCalendarIdWithResponse(ctx context.Context, calendarId string, body PostCalendarsCalendarIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PostCalendarsCalendarIdResponse, error) {
-	rsp, err := c.PostCalendarsCalendarId(ctx, calendarId, body, reqEditors...)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCalendarsCalendarIdRecurringEventsParams
+	// ------------- Required query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "from", ctx.QueryParams(), &params.From)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
 	}
-	return ParsePostCalendarsCalendarIdResponse(rsp)
-}
 
-// PostSyncFutureInstanceWithResponse request returning *PostSyncFutureInstanceResponse
-func (c *ClientWithResponses) PostSyncFutureInstanceWithResponse(ctx context.Context, params *PostSyncFutureInstanceParams, reqEditors ...RequestEditorFn) (*PostSyncFutureInstanceResponse, error) {
-	rsp, err := c.PostSyncFutureInstance(ctx, params, reqEditors...)
+	// ------------- Required query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "to", ctx.QueryParams(), &params.To)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
 	}
-	return ParsePostSyncFutureInstanceResponse(rsp)
-}
 
-// PostSyncCalendarIdWithResponse request returning *PostSyncCalendarIdResponse
-func (c *ClientWithResponses) PostSyncCalendarIdWithResponse(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*PostSyncCalendarIdResponse, error) {
-	rsp, err := c.PostSyncCalendarId(ctx, calendarId, reqEditors...)
+	// ------------- Optional query parameter "includeCancelled" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeCancelled", ctx.QueryParams(), &params.IncludeCancelled)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter includeCancelled: %s", err))
 	}
-	return ParsePostSyncCalendarIdResponse(rsp)
-}
 
-// PostWatchWithResponse request returning *PostWatchResponse
-func (c *ClientWithResponses) PostWatchWithResponse(ctx context.Context, params *PostWatchParams, reqEditors ...RequestEditorFn) (*PostWatchResponse, error) {
-	rsp, err := c.PostWatch(ctx, params, reqEditors...)
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
-	return ParsePostWatchResponse(rsp)
-}
 
-// DeleteWatchCalendarIdWithResponse request returning *DeleteWatchCalendarIdResponse
-func (c *ClientWithResponses) DeleteWatchCalendarIdWithResponse(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*DeleteWatchCalendarIdResponse, error) {
-	rsp, err := c.DeleteWatchCalendarId(ctx, calendarId, reqEditors...)
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
 	}
-	return ParseDeleteWatchCalendarIdResponse(rsp)
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCalendarsCalendarIdRecurringEvents(ctx, calendarId, params)
+	return err
 }
 
-// PostWatchCalendarIdWithResponse request returning *PostWatchCalendarIdResponse
-func (c *ClientWithResponses) PostWatchCalendarIdWithResponse(ctx context.Context, calendarId string, reqEditors ...RequestEditorFn) (*PostWatchCalendarIdResponse, error) {
-	rsp, err := c.PostWatchCalendarId(ctx, calendarId, reqEditors...)
+// GetCalendarsCalendarIdRecurringEventsEventId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCalendarsCalendarIdRecurringEventsEventId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "calendarId" -------------
+	var calendarId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "calendarId", ctx.Param("calendarId"), &calendarId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter calendarId: %s", err))
 	}
-	return ParsePostWatchCalendarIdResponse(rsp)
-}
 
-// ParsePostCalendarsCalendarIdResponse parses an HTTP response from a PostCalendarsCalendarIdWithResponse call
-func ParsePostCalendarsCalendarIdResponse(rsp *http.Response) (*PostCalendarsCalendarIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	// ------------- Path parameter "eventId" -------------
+	var eventId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "eventId", ctx.Param("eventId"), &eventId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eventId: %s", err))
 	}
 
-	response := &PostCalendarsCalendarIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCalendarsCalendarIdRecurringEventsEventIdParams
+	// ------------- Required query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest struct {
-			Status *string `json:"status,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	// ------------- Required query parameter "to" -------------
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest struct {
-			Message *string `json:"message,omitempty"`
-			Status  *string `json:"status,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	err = runtime.BindQueryParameter("form", true, true, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
 
+	// ------------- Optional query parameter "includeCancelled" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeCancelled", ctx.QueryParams(), &params.IncludeCancelled)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter includeCancelled: %s", err))
 	}
 
-	return response, nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCalendarsCalendarIdRecurringEventsEventId(ctx, calendarId, eventId, params)
+	return err
 }
 
-// ParsePostSyncFutureInstanceResponse parses an HTTP response from a PostSyncFutureInstanceWithResponse call
-func ParsePostSyncFutureInstanceResponse(rsp *http.Response) (*PostSyncFutureInstanceResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// PostCalendarsCalendarIdResume converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCalendarsCalendarIdResume(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "calendarId" -------------
+	var calendarId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "calendarId", ctx.Param("calendarId"), &calendarId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter calendarId: %s", err))
 	}
 
-	response := &PostSyncFutureInstanceResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostCalendarsCalendarIdResume(ctx, calendarId)
+	return err
+}
+
+// GetDiscover converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDiscover(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDiscoverParams
+	// ------------- Optional query parameter "subject" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "subject", ctx.QueryParams(), &params.Subject)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter subject: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Status *string `json:"status,omitempty"`
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "X-Refresh-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Refresh-Token")]; found {
+		var XRefreshToken string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Refresh-Token, got %d", n))
 		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest struct {
-			Message *string `json:"message,omitempty"`
-			Status  *string `json:"status,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Refresh-Token", valueList[0], &XRefreshToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Refresh-Token: %s", err))
 		}
-		response.JSON404 = &dest
 
+		params.XRefreshToken = &XRefreshToken
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDiscover(ctx, params)
+	return err
+}
+
+// GetEventsSearch converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEventsSearch(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEventsSearchParams
+	// ------------- Required query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
 	}
 
-	return response, nil
-}
+	// ------------- Optional query parameter "calendarId" -------------
 
-// ParsePostSyncCalendarIdResponse parses an HTTP response from a PostSyncCalendarIdWithResponse call
-func ParsePostSyncCalendarIdResponse(rsp *http.Response) (*PostSyncCalendarIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindQueryParameter("form", true, false, "calendarId", ctx.QueryParams(), &params.CalendarId)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter calendarId: %s", err))
 	}
 
-	response := &PostSyncCalendarIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// ------------- Optional query parameter "from" -------------
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Status *string `json:"status,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest struct {
-			Message *string `json:"message,omitempty"`
-			Status  *string `json:"status,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	// ------------- Optional query parameter "to" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
 	}
 
-	return response, nil
-}
+	// ------------- Optional query parameter "includeCancelled" -------------
 
-// ParsePostWatchResponse parses an HTTP response from a PostWatchWithResponse call
-func ParsePostWatchResponse(rsp *http.Response) (*PostWatchResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindQueryParameter("form", true, false, "includeCancelled", ctx.QueryParams(), &params.IncludeCancelled)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter includeCancelled: %s", err))
 	}
 
-	response := &PostWatchResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// ------------- Optional query parameter "limit" -------------
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Status *string `json:"status,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest struct {
-			Message *string `json:"message,omitempty"`
-			Status  *string `json:"status,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	// ------------- Optional query parameter "offset" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	return response, nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEventsSearch(ctx, params)
+	return err
 }
 
-// ParseDeleteWatchCalendarIdResponse parses an HTTP response from a DeleteWatchCalendarIdWithResponse call
-func ParseDeleteWatchCalendarIdResponse(rsp *http.Response) (*DeleteWatchCalendarIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetExport converts echo context to params.
+func (w *ServerInterfaceWrapper) GetExport(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetExportParams
+	// ------------- Optional query parameter "target" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "target", ctx.QueryParams(), &params.Target)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter target: %s", err))
 	}
 
-	response := &DeleteWatchCalendarIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Status *string `json:"status,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Optional query parameter "calendarId" -------------
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest struct {
-			Message *string `json:"message,omitempty"`
-			Status  *string `json:"status,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "calendarId", ctx.QueryParams(), &params.CalendarId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter calendarId: %s", err))
+	}
+
+	// ------------- Optional query parameter "from" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
 	}
 
-	return response, nil
-}
+	// ------------- Optional query parameter "to" -------------
 
-// ParsePostWatchCalendarIdResponse parses an HTTP response from a PostWatchCalendarIdWithResponse call
-func ParsePostWatchCalendarIdResponse(rsp *http.Response) (*PostWatchCalendarIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
 	}
 
-	response := &PostWatchCalendarIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Optional query parameter "includeCancelled" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeCancelled", ctx.QueryParams(), &params.IncludeCancelled)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter includeCancelled: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Status *string `json:"status,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Optional query parameter "columns" -------------
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest struct {
-			Message *string `json:"message,omitempty"`
-			Status  *string `json:"status,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "columns", ctx.QueryParams(), &params.Columns)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter columns: %s", err))
+	}
 
+	// ------------- Optional query parameter "timeZone" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timeZone", ctx.QueryParams(), &params.TimeZone)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter timeZone: %s", err))
 	}
 
-	return response, nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetExport(ctx, params)
+	return err
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Create a new calendar
-	// (POST /calendars/{calendarId}/)
-	PostCalendarsCalendarId(ctx echo.Context, calendarId string) error
-	// Sync future instance events for all calendars
-	// (POST /sync-future-instance/)
-	PostSyncFutureInstance(ctx echo.Context, params PostSyncFutureInstanceParams) error
-	// Sync calendar information with local DB
-	// (POST /sync/{calendarId}/)
-	PostSyncCalendarId(ctx echo.Context, calendarId string) error
-	// Start watching all calendars
-	// (POST /watch/)
-	PostWatch(ctx echo.Context, params PostWatchParams) error
-	// Stop watching a calendar
-	// (DELETE /watch/{calendarId}/)
-	DeleteWatchCalendarId(ctx echo.Context, calendarId string) error
-	// Start watching a calendar
-	// (POST /watch/{calendarId}/)
-	PostWatchCalendarId(ctx echo.Context, calendarId string) error
-}
+// PostFreebusy converts echo context to params.
+func (w *ServerInterfaceWrapper) PostFreebusy(ctx echo.Context) error {
+	var err error
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostFreebusy(ctx)
+	return err
 }
 
-// PostCalendarsCalendarId converts echo context to params.
-func (w *ServerInterfaceWrapper) PostCalendarsCalendarId(ctx echo.Context) error {
+// GetOauthAuthorize converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOauthAuthorize(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "calendarId" -------------
-	var calendarId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "calendarId", ctx.Param("calendarId"), &calendarId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOauthAuthorizeParams
+	// ------------- Required query parameter "calendarId" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "calendarId", ctx.QueryParams(), &params.CalendarId)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter calendarId: %s", err))
 	}
 
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostCalendarsCalendarId(ctx, calendarId)
+	err = w.Handler.GetOauthAuthorize(ctx, params)
+	return err
+}
+
+// GetOauthCallback converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOauthCallback(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOauthCallbackParams
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", ctx.QueryParams(), &params.State)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter state: %s", err))
+	}
+
+	// ------------- Optional query parameter "code" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "code", ctx.QueryParams(), &params.Code)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter code: %s", err))
+	}
+
+	// ------------- Optional query parameter "error" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "error", ctx.QueryParams(), &params.Error)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter error: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOauthCallback(ctx, params)
 	return err
 }
 
@@ -1047,11 +6352,66 @@ func (w *ServerInterfaceWrapper) PostSyncFutureInstance(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter all: %s", err))
 	}
 
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", ctx.QueryParams(), &params.Tag)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostSyncFutureInstance(ctx, params)
 	return err
 }
 
+// PostSync converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSync(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostSyncParams
+	// ------------- Optional query parameter "all" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "all", ctx.QueryParams(), &params.All)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter all: %s", err))
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", ctx.QueryParams(), &params.Tag)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag: %s", err))
+	}
+
+	// ------------- Optional query parameter "full" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "full", ctx.QueryParams(), &params.Full)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter full: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSync(ctx, params)
+	return err
+}
+
+// GetSyncCalendarId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSyncCalendarId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "calendarId" -------------
+	var calendarId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "calendarId", ctx.Param("calendarId"), &calendarId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter calendarId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSyncCalendarId(ctx, calendarId)
+	return err
+}
+
 // PostSyncCalendarId converts echo context to params.
 func (w *ServerInterfaceWrapper) PostSyncCalendarId(ctx echo.Context) error {
 	var err error
@@ -1068,6 +6428,45 @@ func (w *ServerInterfaceWrapper) PostSyncCalendarId(ctx echo.Context) error {
 	return err
 }
 
+// GetSyncCalendarIdHistories converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSyncCalendarIdHistories(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "calendarId" -------------
+	var calendarId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "calendarId", ctx.Param("calendarId"), &calendarId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter calendarId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSyncCalendarIdHistoriesParams
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", ctx.QueryParams(), &params.Type)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "before", ctx.QueryParams(), &params.Before)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter before: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSyncCalendarIdHistories(ctx, calendarId, params)
+	return err
+}
+
 // PostWatch converts echo context to params.
 func (w *ServerInterfaceWrapper) PostWatch(ctx echo.Context) error {
 	var err error
@@ -1081,6 +6480,13 @@ func (w *ServerInterfaceWrapper) PostWatch(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter all: %s", err))
 	}
 
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", ctx.QueryParams(), &params.Tag)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostWatch(ctx, params)
 	return err
@@ -1146,9 +6552,32 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.POST(baseURL+"/availability/", wrapper.PostAvailability)
+	router.GET(baseURL+"/calendars/", wrapper.GetCalendars)
+	router.POST(baseURL+"/calendars/bulk/", wrapper.PostCalendarsBulk)
+	router.DELETE(baseURL+"/calendars/:calendarId/", wrapper.DeleteCalendarsCalendarId)
+	router.GET(baseURL+"/calendars/:calendarId/", wrapper.GetCalendarsCalendarId)
+	router.PATCH(baseURL+"/calendars/:calendarId/", wrapper.PatchCalendarsCalendarId)
 	router.POST(baseURL+"/calendars/:calendarId/", wrapper.PostCalendarsCalendarId)
+	router.GET(baseURL+"/calendars/:calendarId/events/", wrapper.GetCalendarsCalendarIdEvents)
+	router.DELETE(baseURL+"/calendars/:calendarId/feed-token/", wrapper.DeleteCalendarsCalendarIdFeedToken)
+	router.POST(baseURL+"/calendars/:calendarId/feed-token/", wrapper.PostCalendarsCalendarIdFeedToken)
+	router.GET(baseURL+"/calendars/:calendarId/feed.ics", wrapper.GetCalendarsCalendarIdFeedIcs)
+	router.POST(baseURL+"/calendars/:calendarId/pause/", wrapper.PostCalendarsCalendarIdPause)
+	router.GET(baseURL+"/calendars/:calendarId/recurring-events/", wrapper.GetCalendarsCalendarIdRecurringEvents)
+	router.GET(baseURL+"/calendars/:calendarId/recurring-events/:eventId/", wrapper.GetCalendarsCalendarIdRecurringEventsEventId)
+	router.POST(baseURL+"/calendars/:calendarId/resume/", wrapper.PostCalendarsCalendarIdResume)
+	router.GET(baseURL+"/discover/", wrapper.GetDiscover)
+	router.GET(baseURL+"/events/search/", wrapper.GetEventsSearch)
+	router.GET(baseURL+"/export/", wrapper.GetExport)
+	router.POST(baseURL+"/freebusy/", wrapper.PostFreebusy)
+	router.GET(baseURL+"/oauth/authorize/", wrapper.GetOauthAuthorize)
+	router.GET(baseURL+"/oauth/callback/", wrapper.GetOauthCallback)
 	router.POST(baseURL+"/sync-future-instance/", wrapper.PostSyncFutureInstance)
+	router.POST(baseURL+"/sync/", wrapper.PostSync)
+	router.GET(baseURL+"/sync/:calendarId/", wrapper.GetSyncCalendarId)
 	router.POST(baseURL+"/sync/:calendarId/", wrapper.PostSyncCalendarId)
+	router.GET(baseURL+"/sync/:calendarId/histories/", wrapper.GetSyncCalendarIdHistories)
 	router.POST(baseURL+"/watch/", wrapper.PostWatch)
 	router.DELETE(baseURL+"/watch/:calendarId/", wrapper.DeleteWatchCalendarId)
 	router.POST(baseURL+"/watch/:calendarId/", wrapper.PostWatchCalendarId)
@@ -1158,20 +6587,147 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xWTW8bNxD9KwOeFUlJfdLNidFCl9ZoA/TQ5kCTs1qmFMlwhpYXxv73YriSvLKUuoWb",
-	"IIh18no1H2+G773lvXKhiWpxr9ixR7VQP8W48gjvtMdgdYbfumDg8nqpJuoWM7kY1ELNp6+nc9VPVEwY",
-	"dHJqoX6YzqcXaqKS5pak4MxsS9Dsfve4tP1MfkuRWP7GhFmzi2Fp1UJdR+JdX3q3T6lFs14jYya1+EMw",
-	"q0VtpCYq6LXANuPwjJ+Ky2jVgnPBiSLT4lrXKbsk0cTZhZXq+w9DMBK/jbaTCBMDY6jodEremYpv9pFk",
-	"8PtRqZQFPTus0w4wjhpI+SYjte/jX1gLWCSTXeJhkb9ukcKmxQCFXFjBL5eFW3gznYMu3GLgLQbgCCaG",
-	"gIblkVuEx4d1eb2cyk6K9/pGjnOY//HU+zfx5iMaVn3fP15afUEpBhrmezN//YztEGsu9Qnv9DpVolEx",
-	"BonUv4R3uLf9xCajZrSwrdYU7ztZ+8Wz8K6RSK/wEPC+p/YZte0A7xzxiQEmJ+fFnGN+5rSPOksklfVa",
-	"506i6ipAQ8AN7PQgDfVKZLOvoj5I3oy6YF41hUvGVy4Q62DwCXGKF/xYM5bbhGNtHiJ/3zqCfQTIPzne",
-	"OotWKIyBSkbgVnOlcyHMUILFLNUtAUs6BpuiCwwb5z3ophEBaO/3M9IUlg10sYCNECID3slRO/YdUELj",
-	"mg6qEGqTrd6HahmpeAYXQAeoJzT9M6jJ4DCfCubuwWK092rsJfujPRDZTYweddh5y4GE5t+ShH7XbFog",
-	"1ln008R8uNMTirr4vxX1cxz1y7hyxCgO9OUV9bnOh4qq375BIrCTCOAtBqbjhY2UJnkjlf2X75+kfsVP",
-	"37dLz7r7Bw5+IQY+7K4aRxNLsF/T0JdXo74nyLfDB3JNy+vhHrBx3IKPRnu4enuadhsR9xNUqwZwNvCz",
-	"gX/HBi7LgSoGuVl/zq8HJYyVc+TYFj0yHgvpqr6vBc62PSJlTOnkrfxFOTjHNCLfqTv5jnmTp3z6TK4j",
-	"x3vp5Dq0tn9iV9/3fwcAAP//Q1ekQukRAAA=",
+	"H4sIAAAAAAAC/+x9a3MbN7LoX0Hx3g9J3RFFO86eE506VSvLcqy7duwryTf7yJYIzTRJxEOAATCSuFv+",
+	"76e6AcwTw4cs2ZbF/bCxODNAd6PR3egX/j1I1XyhJEhrBgf/Hph0BnNO/zy84iLnlyIXdnnEc5AZ1/j7",
+	"QqsFaCuA3kr9k5MM/7LLBQwOBsZqIaeDj8ngWukPQk5fqULT6/9bw2RwMPhf+9W0+37O/V/r7378mAw0",
+	"/FEIDdng4B/1ef6ZhHnU5e+QWpynDuwpmIWSBrrAmlw5NIWF+Vp4/Jg5nOWKJvGzcq35Ev82ltuChoEb",
+	"Pl/khHqRpmDMIGnTooWP/zbxMK3Ayc/fQQYkkXyi9JzbwcEg4xb2rJhDd+5kMOd6KuQbIQvrvs7ApFos",
+	"rFBycDB4qQEYfsvMjGvI2OWS8TxngeqGXcJEaWBcZoxPLGhmZ8AQ9oRdz0Q6gyvQTBhmZkpb0AlL+WIB",
+	"GeOW/WnE5m7iYQWakBamoD0Ztd0UlS4ZtR0kRIw2ljGa9jMyp3U7VTl0yXM8X9glExNCOtAEsZXKMiGb",
+	"P+fCWKbcuzxNVSEt4l2xiAaegY6tEi/s7Hy5iEDwSl13JncQQ8aUZD8rNc2BBfSG7MTiK2OFQ45joGuY",
+	"CmMBF/ta2BnjTMNEg5kxqz6ATNg4U3Mu5MW1yOAigxymHIGhwfwXpiDCJkzZGehrYYCNDegrkcKFR308",
+	"/E3S+hRzWq/m00EyIAgHySA+2+CfETLNuDl1sJ4jqF1qnc+giQ0T1kA+oSUjTtVgCy0hq3HkpVI5cIkT",
+	"iLgwE+YdLwzUH9Y+knwO0c8kQGZOAfE8tBFgcdtdz8CxURPsa27YRBUyY1axS3x6pT7gimsGNwvcBEP2",
+	"60zkwASttwGbMLOUqZBT2qvX3KYz/KOx/FwDMx8EbtDAKWkOtPEJEM4kXLcpaJgpFotcQOaWNLpbZZGT",
+	"yBocWF1AZO08y8T2GBd52DeenVEjmAVPgRUGNBPzBWijJLeQsSvBmeOZPeQZVvHMcDM45nOul7HlsDkE",
+	"OEqKRbZYbANbPm3ql+4bLR2CdPu7ktDUIodG8P1z9WGp1kpAkQ0873U3Rk2iVJSv8XGbNz0CFXVq8CV1",
+	"CblKtD4v8g8rVPA2OjMZEP++5CIvdExvHZX66XqmDDh2Z6kq8oxk8yUw0hDI56UQH9NbY+ToCc8N4G5C",
+	"ZUc/g3G7w381SKq1XGm71ODsLnOf7m9it4qor4Wx/UQt1fTGtk0Y997MmgqkVWidkhLSTtR30OoRw72C",
+	"VreUwjbCaO27227t3m26mh7rlnibhb3ThYzC/UKYVF2BvkvWDGNC9oWZNALIVlbjW0l6ZKIBnhdmeUpm",
+	"X8K0/++1FhY0aWl1LUFvaiT27IqFFkGhdW2TmrbrfHg3GqjSGDWSVFDVpomR+vgKZOSIw/P8BY/o6JMJ",
+	"o13KxiSnx0TEMchsTMKbjib4IKjxjC9NsNMRDvYvJTs6Pm4KNiaOUG/VKWytTOlZylylvHdCpcVUSJ6f",
+	"hVNTkzT0s0PS4yeksVymEAigIS20BpnCkP1S5Hk4HACuQTjUcFl9pyaM+6/QkqT3hrc2AMuBaM1Psi4K",
+	"Jy8C6K1JO5C2oBR2M9Nv5Xlzk887kidVciL0nEyqVaZm55nVXJoFx+WI8Pm4emzHHezNTF1Lxg3jwVHQ",
+	"FCFqwf8oYLPt21mV+o6uA1XjzqR1+m5yZgu3JGzmkoC9cmC1rUPob65NaMSYApFwY48KbZTu0r22LTSQ",
+	"SJGKzZX2xDfD2/LJ1hrKY9sAt5dwZ8B1OusnHY7xdjIxYO8U5ZorR4Mp8m1XpwS7yO/PzRYgS+pUWE9J",
+	"BGlbnycEZbYRX5pU6YjxcAo5XAXBVm77IXvN9dR52mhxtHvNDtlbmS8ZTsQ1rhG5aLzEN3wOzBBKTbmt",
+	"isu8JiBkMb8E3aFhDd0AbkAyRsCXABmZ4Hd2CLRxL887bgyKP0RxTO+M2R8F6CVDGszBgkbijX8+Pmf7",
+	"pam3/+8Kn4/7E4BsKFIzHm7OSg6cKOre1Ou3Fy8Ls9x4d6Bn6ERa0Fc8j22MlXy4ahEJilUI3KU93yHK",
+	"l7Hm3x4WdnbE8/ySpx/W49ezt+8U0J5YxjutLnOYR1xkWivNtIc92HNuOyOnn748Yv/xn6P/YN9xdNQ5",
+	"Nb2/cMP9n9+Nkt8PB0kbYZVB1IxEITLn6D2EPQ08ox+AQMBvhjWvrpBXPBfZBWILhlxM/hecdFAjQzKQ",
+	"yl7wPFfXkNXeK3esMxSqvy/IRTNIBlNyv12ED1INGUgreG6qhwvQc2GMUPIiAykgqxH7AicmX2rd3Lkg",
+	"OdZ4hiKh8YMfPDpQ+SPPkUbLC7gRhtTM7+qy/FEXUiJHeG/3RSYMUjMrf3Bnpgpo9+uEixxqEPxRKMsv",
+	"4CYFyDz1LGjJ8wtaFsRLFRYaAM7BzlR20aR6hQpAZi40+f+irvYMLBd5y9ilr0HTQSHM0/kyGObNb/f5",
+	"QtRksaHf/+z+M0zVfH+wmSX1bPQsZn9YYfPWjL8oy172AWmjwZbD/BoPjGN+qQp7cJlz+WE8ZO8xuoGc",
+	"P2ZWMUHcN1k63YzkbxrftW/XCgbrHLQO+KSSE574NVombrvGpMZpeayL2Mw3L7iFJgX/MXg6evrj6MmT",
+	"H85HP41GoxGOurnfOhcy5pB9688A7PT0/evjhB3/1f339MXh+TEd04//Sv+k74PQ+vHHZz82yPePAX1/",
+	"8PL0+P/996/Hx395/bf/ev63F4d/++83b7eDU/dj/nR0K8x1kcPm2q9altMiX+8admQNk5TgJ+USrl56",
+	"mqNrdCy9F6VGgm3peLl8o6SdxTyg9f0X/8pPv8WHLjrY75etfQo3aV4Y7y5pxXJ0AeHYjBT1PgPHlHF/",
+	"z0TDH00B4tgvLuK8bVZ//0kMxkLathRFHnzy9Icn5yP639/XHylbrFLh7aGuARQIGGZOPA80VqT8YwVT",
+	"BY9Ab/pBy0KRWcP3NBHa2NJHc3unUV9A1g+87XYs/Rz+++g2b8jTzbZ4w7u0zi93V7S5Q3/UasdujSJt",
+	"10+psqoVWc9TJzULYfPUllvzinmjMjERkK2WE6ZcqIRlhQtNMaWZp0PHq4oheiEzcSWygud4Bp9xOYWM",
+	"TbSax7yYcblzL25dYQk8TAiALKTw4Mththq27k2rtIt63poXvxLX6mpWXu24rLi54pn17LzabXk7d2OL",
+	"dTZzPDZ9ubeVjffoiWuCt9a32YSrn8S6o7G2w/YesIvic7aU6SuBW23ZRQL3Kvp9Nk/tKxb4OKP5joLZ",
+	"1DZB2uCGWWKfr4F5NZ/P6CWxhUquk6PHT/+cJNc2G6cE42716tbsUJGjgUkfic/KObfySqHKkZAfY0pY",
+	"GTlsWWblsyo30YqrMmXGjdCMCLYzHenVT1EPKfJMWuC8PuulZNj2wqInGiFFTmUT97JhRsjUabCcG8v8",
+	"ckyKnN6Lp5fm3IKxfr43YAyfwkYZH40Pz6z/rKOXp6VKJlgXWiFMmEinA6g4TkCCqZQUdVZ3oGXc8ktO",
+	"fq5cGHtRhn3or8qo8kE1nobcyO2QWClYNh2qsIWGYLudrRNXtxr1fUykrT8JuqHuCqRbAnHP2Z6Inakn",
+	"cGL6dT3/8xMEHhIOATmcwhmkSmYRB89xzhcGMmbcC7UdiZ97RJwlavMlE5IVxhvJxhQt4SGk/dOzDQKL",
+	"q2IZrUXvX704gmvYelP+jMngfnkX25ZRedMjvdpctlqd3FkczjQ01Dqd7t/sL3yo3onB3wh+fVrxw10V",
+	"GMTAbCR+bq23o7GX4zLGUroK3PhJFc3lJviiMcLpI0bjpgt6RVBkhZ9/izAigV9+G6VOq/ynk8al5sJa",
+	"yJJucUPIaWHcMi6XdEbtRq8ws6thaQaN+ubtIBmcvx8kg1+P8V+vBsng5ekgGZwd4v+9j0Y75kKeuIGe",
+	"dA3RtQ6vjJO8G796dfDmzdjH5YbsTWEoEdmVzfiMtf9i46fPDkajMZsDly6GDTKrJaw11/LJfx6MRit5",
+	"e5W/IA5ZY4LRTz0T1NMDW8t3+MthLZ1O8jkM2QuYcEy0QBW2Nt3uVumGzbM7MUCX9T6Sd3CiiKNdUGjQ",
+	"SuAnVcoO350MksEVaOdBHoyGT4YjxFstQPKFGBwMfhiOhqilFtzOiMf2ea3abB9/WSgTWYSXAjVkKJQy",
+	"vrYizxt0cMp8ogESSt8obN135AzB4MHxNA3WIuV4qMLiWDk6CTpVQBh3Z8ErbKrkSC9C7MzNvI8JAch/",
+	"CyWkTVyVlwa0OrjMXDnY+LKYTED74qpxpyBsyM4ISVoe3LTzIrdikbsoz5MfQw1YObjm8oMbOoDhmKW3",
+	"0ox9VyyQBFU92ffI1UhzkBnirzRl1uKnFml9uaw5uFzVCgoPUs8okgfvlLH10kEfqgZjn6ts6cLj0npv",
+	"Qj2oTnHtsl4yludRI5bjDNoXg4NR0lt7F7g8TgKKLDddQXN+I+Yo754+G5H0cn+NYnbp9okb0QJQqiW8",
+	"8VLyx9EamRkcqDVClDA/edYAOho3wX2wuZ7PxVzYBrGfjGpEejJaO59V3V3ckt8I0jgJ6VU/jSi5uNfo",
+	"3kybmoFHlSDoki0u36qRrC6AfnCGHtH56Wi0Ff9uygmlNUkwtJLW3JZOucwEUsHJPSTss5XQ1FNUNocq",
+	"5MlEAHnOM+Y38pBhNaA3loRhNVU0UZgRIeT04De5x8b1nJXxAX3mx2CXKlsGLwi9xP7v2dtfhvRZWIbx",
+	"ATustnCVgoapemh/yemwMU35Bn3YeN+/ghKLaPfsc9Lul5DVsTnluqkxiJNctnW+CTSsik89jj9+Xv44",
+	"8YkzDOtRQfsEji04pZ53Q7iyQsLNAlILmRut8vMghvVYBdkFqOvnSjq958wDNWF1geAKjv7hayP+iUPU",
+	"MmcQ+alL5G2qtJ/BHtVGKfkKx4oY4GYBKUU4EqZkvmwtF9X52pkwzPIpaW10SPm6OByB8i1DZdMBwkw5",
+	"omF5KgPPAp/v8YhE/Oc9yq1o9VxMbpXsWFuBBya1/IctwZUpcDtuTm7eZr4g/nX47oR9B8PpkPFY8ixn",
+	"11rJKcNV+/4x7lVkHaZj/FHt0NI4am/SyyL/sOJ8cNjosEA2cTWRkIRUzd87ZCcTOgo7ROfkFjAJkxXy",
+	"82Zlf5/JWwoIrNW9M5v39lWojTLQaC7Gqmr/9wZPTEq3ZJedcctm/AqYBGFnzooWGovtWn5XSeF9sqyG",
+	"7DRocDqtFai3GeUOs6fDEUOHG0jrScKsYqmSElIbzmbtQ+bhuxO3CrevjL9//OJ19HeKHcWOGrY5JfYm",
+	"fZV97tzWaF8Q24VD9mu3art2aOrbXgkzivYPDa78dNxNWElNrfKcYcJ4DQAfRcPDGW22haI50b/SqOf2",
+	"nS/aqR69R4AvbeRH6/cj8riqua8tSBWJy5c7e79h79dSr7uWPk7gH5aNZFzwpC1qXCL3LY4Qe2zcnzvv",
+	"8a79QpKmvc+/6warvDzyDV1Cp5vvaZ/5iZjSIYZVHmV++Jxs8VLpS5FlIDdnij6n+figQxT3wLfhCRKy",
+	"dG8+lLNbb3VDYI0S3SAUyREV6YiSMFXytG8j5c8PVSOkQJWfPidVjpSc5CK1tzjQNss62iTBOIV7oXui",
+	"ffb0s+J4rhSbo3HoRZjZmgOa1SUeVfqR9jG3wMjB1urRU7cFcJ4wwGM8LIRzZOn7rtsfuGVS2OTY0KhQ",
+	"dDZTDjaafaIWpq/Hk8yY+840H1g1BTIXaXMKa7xDOelkG9IYVSZV5DDxgiYoLYKjenSw5XkgdwGGUCpv",
+	"QSOY2DR06k6Eu/YYfEIM/GPURovbR576MdvoQfrzziMZYY/bj+e4n/GSLPHNnax31D2MjbPZUX79yWG3",
+	"Cb6hTfAz2A12wCIc/1vOKPz5S+yC2/m72v2dvL+89KGziYA8c74Gn1fdTVkJ7cs2yONf5fWi2cPR8e58",
+	"VR0ON9eCsn7xo76xP9XDdYe9H1kfXT7Jx7WSKn2QnFiWchnaEJY8QmbXuL644w3pF1rQtYMXi5ynYFxm",
+	"CZ+aTqYNO6PJl4xLBtQFkTyrCLuGubqC8tNGv8PO/D0AVpWs9+6/+lymm9+8O7fWzq21c2vt3FobuLU+",
+	"yWDc+Yoen93sagg2MZ197HhFGPerN5zjFvCWFu89RGa/Gqv1zqOyW1uflQmFkP8ZbsqePNuYprUOIz7j",
+	"Z4suI7ezIJ98lRZkqmFnQe4syJ0FubMgd4HRnbH7yI3dI9KG/l6Z1QbvilCoi0rWU5/bBqItdCimW1k4",
+	"JSTjzaohxLve+mdtadURlynkOWRhKlcwleZFBhkrZI6ybywk/VC+PGbK1QDawhDJS8ssFl6Nh4jKvin3",
+	"ZuknUWezx9NTLeTYCUMU8/l1/lalGnXK5gUlSR2ZucxwqOpj17vYF5s1WvJ4CzWWcO6rZipUNqvCWYUf",
+	"gYcYlv2S4ijG6oI+H+JW3THaVaq/484Wtv4yi3HZjmmcsLEFaTlWt/sbGNKSzRtXgnWtMpc56cfuxbEq",
+	"FY8UFKzoC9WDaLUgJOMIhOb9Ag2Ue8GK9cq/3VZylxZ5MYmnOsuFNGEVCLDNQKp1tloFSezTtoRqjNFO",
+	"1u0ms8YHJX0YH+nJqFWTt6Yor0vBEM0YV32jxkFtLTRcCVWYqkuyVWwKlh7i+2zBp+D0HnFodTgwbO53",
+	"dLtEtjaoO0n1rkNK0Ay+VBC+24csYgmcOZXoWXCdJtyVwcTKYB5RGd8u4yFaEmQau4hYJGLXxmv3OjcQ",
+	"7JHnYGWq34njJR7S+fwlnUa5OhDCElztLZOK5UpOQZN54m8J3Sp9r7zD4RHn8SENWCEXxWUuzGyXx/cN",
+	"h2LCItPYojy404bq29rRGE0LK2MKMP7UayDVYL2PMFRzdeequ8pcJwvR2vmlNaIk+HQX/HnGzSwMUN3Y",
+	"Sv1cvZio/05XRylnFQdb2G3FtTWDD0xKrLyapHNVTdSB5Ihmip0M+HZlwLtPkgBr9DveMHQHXitO3evD",
+	"hQk1MJW/hptuYicTAGN+xSVOc+kGoo5C5ZKnucCJMO7YTvnXQDdTWpClk1roWndpaqRvyiY7QrO575HM",
+	"lGZpxP1RH5Ibpq5Aa5F5vwgbnx4fvT89Pf7l6Hjv5MW4x7HWAGl8dn54/v7s4Ojwl6Pj16+P8SvnFHPG",
+	"EFDPorlz3nDJOFsC14xPFY1V9hxDj+zCdpss/yZ/k+coE0NHpOr8QPW2nFntS0dNjmI3WGGerDiimkrx",
+	"L3eUnIgcPYMWpClzJTfx86F0Okk/r6PvvKYlTOF9Uu/enp2zDezXcW9bCK8k7lLqW7ix+/VbcfsH64ia",
+	"5gZ/2EfcUqdXsdWHoZia9yytVUpJdcbxz0rLPAkx15IWdFp/rNn4W6iwDc6nC14YWNHE4ldqP0tvZQm7",
+	"hsuZUh9IgznJzdMPUl3nkE1RmhQ29K0tNUhjzcsACukrL3mowG3f+5jdTzj+3oSasu4FTbNfqYFG4RqB",
+	"8QEWdgvTli7I3xWxab+wO7v3m7V7cX1pu1K04fZ2bmlF7W0Tpu2UnG4VsD15kTDg6axKp6isTnf0DblD",
+	"YaC6eRktd22arRvEc28Xwz3tXILxmYO5FZ6bxHPXxV/7ofmEwGR9LW8fknV6xrdr/OFPf3KXwbvbwldH",
+	"Vz8dqa8h3vZ0qxaYu2jb5jJ9xfU//WG3jtDpk2y7wNsu8LazVXoDb5191Dji1F1XjdtTNj/3dOyZf4NL",
+	"9/i4We/NXl1fZY3cp8qPDAblxDvj4VEaD59PGa6Mp3TN/qoVTbVsu8SUnX78NP1IJSk997yXy9DkQz+M",
+	"0zGPt5tHXMdGdum2KtUU81W+RHe5FWLlLlE1bM4z8DdcNVyFeKGUc01RV1x81rjILlyY5b8N7slIV9Fy",
+	"SD7lIgSY6n7HBgrjLXyIp4TuzomomVv4jJSp563H5FB8OFU9EiAzF5ruFWtjR8+Yhj18qLT4F4HPLiEl",
+	"F6KwplUIh3s0eoVdsVjkS58W0/rE7b7D86NXvQFHV0Lx8/E521cIyn6AB/bHj1FkOzGzlQs3EybFMHy/",
+	"j/aI57mTxPUe+mSNqEmnwql0v7aKHpPqyVRcQbsv9QZ13BTa46Y2hC/HXl8o3eOMfeFxX3c3wv1Um5Ps",
+	"nwHPQFfS/697p44ueyGbaosD2Z1XjveWGDg5/6W8bWHdVh0tDkmniMt6482Hck74Cqqnd9XQX6waelcT",
+	"+zi9mbX+wJXs8rf/CcNq6Pdrc++fNMB1OruL6lhfiEbWhL+t8FrpjBxf4z/GXqIIHSrXElabCdc/V0F7",
+	"R+7nw1E05HDFZQplxgb6MjrXoTiUauHXyg5sBV5vXY7bCt/+Jn8lTN3sKFOtS4K7ngkLjFq7uEDx3F2G",
+	"zlJuKAUFpBFYComWraHkS9KKeEMFjRjCXdyyHLix7CmeVTVPKRiW+3yliMHiCHRGpOgaLdVRbaEVGUYY",
+	"PoPrHi3+x6d5f+s3tvYvV58J0Tjl3mP18YOpFr7r6t5vLt78djIxYL+WeLMiaOJor7l/8/7LPp2AWGUd",
+	"v/ESqwo8rxTNOwf7JzvY/cCoM2ccwfB61CovKxEWOuLiY05PsTZHO+HGZUNJfL+LZ39bFqDbsq1SUrwP",
+	"GW5sj2cdbhZK234j78xq4POGkaf0pll2SSuIrnRTyQ/Zqbpu1WME1/zS6zteu7y6GtcolnM9pRullfbW",
+	"GTJArnhGZ1Sr2BzmSi+rHF4NTio5A6yECpP+qjAg2WL4E/63g2fzO/8umXlHZ//fjeLLUDhzXhmm1fWQ",
+	"mpqx10JikYoWFoxLM9Tq2hfhKO+ECu6tVOXFXBp8+gGWZsjeuLM6NkkrQjIhtV4WkuHclMssizwnc7qa",
+	"j4Cje/7q7GNqV5qVug9lhr/xLGk+QRNEFzKlBuQ9ViUtRE9wonOrp5726T2XVWAGSXnzfvlDK/hmIlfu",
+	"99kX3uCJz5iaq9p07i+UCHnPBNuYsI4/v5gJW07PTqaS8lcnke37WQ3c7yIAfL/G7K3QeLhmbzumMp/z",
+	"vepEGPa7d64568kqRNWLxiHrcJr/piY/yaBwND2oqU1B3XXc/7dD2O5Xr0Ton9QpKGFjkPQodBC6KB/w",
+	"PL/IOL1M14kuuAaZho+xExWC0d6ufQDVpq6qAmOAlGM3uouKLPEjJPRBAjLr22yOYNvttJPDXw4dJ/5L",
+	"SWA4FK6SK43E35sLwM7pJ+d+2Mv4MlZ1yA1z9dd+uavh2632G6geGsH3z9WHperjdzGHvysJPYLu/fnR",
+	"J1+YfLMns665FAnmUjmdudqyku7Y73RUjbsrk3cpOTsTv2niu/3RMvG5IRNQ6Zrd12PvTzTAZWGWK5Jm",
+	"jtR8UYTmEPguI5ivKEgTTPE1dv8tuh3KjFXKxJbWgussgUbyFRfUM/r7hit2yA6bchZpt0D/qsrBpVeu",
+	"FbLs7RXonC8WznHBePY7TxGICnGccg56GuoMm0/SnEoQAx38zoeMLUAL1Xtt9ku/GHd+Y/ZJ1rwzu9Mn",
+	"e85vTtzDH52jyf/1pHtXNi75psZTMrCqy1DxRFifBPvTiBYJFd1m5ln0tmNEOAnWqlVf/O5jXNnnhVmu",
+	"cp89b26tFlO2TEFqlVghO961/m4Erx+LQjyUywij7BSjSzS97Oyokkg96rCd8LUi2JkJDamtAu64raVl",
+	"JtUAspbE5L0/eFhxziTO3v3l6JghCdD5mucgMaZwWDpg6GaHqebShqhtEgbT9WnrOWqoWPEe+f1x6NIS",
+	"whEGLKrL8dvD9+evLk6PX5ycHh+dX7w/fT0Ol5kFTbpJKlKPu+ctvnUY6LaZ22fDpNRasij948+mfnPE",
+	"5vlLYtLpBtDcJmwJtsz2rb9WvZJQGqI785n6FXwx/Og/26Qz/TB62s9p6xhtkPi8LxrqtY+QrzluPRCt",
+	"QTx+kQmDXIpyr487w6KCpDeHX+Y4du/a6nFFMZwvfgatVa9ShEmUTnJ1XZPq9G5DqpcisleoH9+EsgCc",
+	"LTYHHgaaCWghMOAkROsqbF/QEBUq3fsLkkiOM0kfun8xG7JjjBE4ReJ7cFHvDGqzp2QKDhaXB0324pMR",
+	"mwuJh7dVYvvIE2YzqU3z36YRNFJwO1fbGerwZalIUTmV+rGR9dYngYm1vlhG6dvDGnE3ube4rovUw74k",
+	"8tMktvvarW2V+HgeXXumZEQv1seZcJGXAzR3tXsUH8LtdunyPDRwo2SCazEjcyrIbJOqBYw/g9zf5c/u",
+	"bhP62m4T2mUVP76bdhQevix8kkEW7a7W74F+53qEtSPp3uEbdaVipedLmuDEj7+uHocacjZk70KrK5E5",
+	"Ny5IU7h2n7ayQQqZgcbRM3+nRtnP81rkOeOTCaS2neJyMmFLVbBM+U2InCJsvvRpwktG59+639iNpsEU",
+	"uaWydZ+60R/n5nkev9WkcbviinD0yaTKWk6cgdn0MVW3uVjuLhFx2K7KjrV8GocqXOWYPLQC1F/pyOhz",
+	"ZMqm2y6dpUash3xH42c7NGMSQfnOBSVO+Enx0bi5NenxQ6l+/V1dltfZ6UJi3YPHjNKEf1eXjfOgewMV",
+	"czA+g4h8lKf/pUyZUxUlHUJob9JOICwbtbYEFWdO9gRdhIPWVNGtVQ87pBMEwqYmRIOumWWsWpTFszYk",
+	"jvsG1+6OKKVdjiKd//FVf0VWX5yQwN+ps4enzmKAOfogVeuR70arSV+7pGO91HNBgeWquQSG2fFz4iPv",
+	"osLEMZ5+mAhfbVVmimXID1Xqpx/3GjSEHiXunuc8Nx1jeI5H4XoTdIkaIKcPV2QQFnkeT0e6r65C92wD",
+	"kICqNPxOv+/0+06/b6nf70SJN5p4rGqhhx8e1WOPD/JuGcTijITZuq6g3prY5f19g334a9ZibxN+v1Gq",
+	"W6TiFuXD2BKfV5vjegrjxLdRZbltdeOut+vL2FSdS31re9XX2ij5TYaLXTo+6aB/NVjtjFV/88Eugfcb",
+	"1H8VSaQzs4TyvapylfKcvXi+qd4rL6XYQgO+Ct981i7xYwR+TOcXFzUvQafkxuoajiaCvmXquOU93mSg",
+	"yH0e/Yc7BD9eNWHc6TvUh/k/W2NvUYt2j7XulN5VkcNnZ6/pJNAokH9O725bIN9LVjf19kVY921IuS2w",
+	"3KTHeo2ePRXu7uS9ayG7q1fZqbveFutNKb3KbkVF5zql3mmEkGI3Oy/qLii4CwrunIY7p+EDTAku22zf",
+	"yn3o5H9dvXQciNVd+bFr7WmAndOkJvEUFT3urvL7ZnedWtQ2XcxkC5tqla9xt2/ilsKuY/2uY/2uY/19",
+	"mAerJNXHjx//ZwBKhDnbpP4AAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,70 @@
+package cloudkms
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	cloudkms "google.golang.org/api/cloudkms/v1"
+
+	"github.com/takuoki/google-calendar-sync/api/domain/service"
+)
+
+type keyManager struct {
+	service *cloudkms.Service
+	keyName string
+}
+
+// NewKeyManager returns a KeyManager that wraps data keys with the Cloud KMS key.
+// keyName is the resource name in the format of "projects/*/locations/*/keyRings/*/cryptoKeys/*".
+func NewKeyManager(ctx context.Context, keyName string) (service.KeyManager, error) {
+
+	if keyName == "" {
+		return nil, fmt.Errorf("cloud kms key name is required")
+	}
+
+	kmsService, err := cloudkms.NewService(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fail to create cloud kms service: %w", err)
+	}
+
+	return &keyManager{
+		service: kmsService,
+		keyName: keyName,
+	}, nil
+}
+
+func (m *keyManager) WrapKey(ctx context.Context, dataKey []byte) ([]byte, error) {
+
+	resp, err := m.service.Projects.Locations.KeyRings.CryptoKeys.Encrypt(m.keyName, &cloudkms.EncryptRequest{
+		Plaintext: base64.StdEncoding.EncodeToString(dataKey),
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("fail to encrypt with cloud kms: %w", err)
+	}
+
+	wrappedKey, err := base64.StdEncoding.DecodeString(resp.Ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("fail to decode base64 ciphertext: %w", err)
+	}
+
+	return wrappedKey, nil
+}
+
+func (m *keyManager) UnwrapKey(ctx context.Context, wrappedKey []byte) ([]byte, error) {
+
+	// 鍵のバージョンは暗号文に含まれるため、鍵をローテーションしても古いデータキーを復号できる
+	resp, err := m.service.Projects.Locations.KeyRings.CryptoKeys.Decrypt(m.keyName, &cloudkms.DecryptRequest{
+		Ciphertext: base64.StdEncoding.EncodeToString(wrappedKey),
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("fail to decrypt with cloud kms: %w", err)
+	}
+
+	dataKey, err := base64.StdEncoding.DecodeString(resp.Plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("fail to decode base64 plaintext: %w", err)
+	}
+
+	return dataKey, nil
+}
@@ -0,0 +1,72 @@
+package googlecalendar
+
+import (
+	"context"
+	"fmt"
+
+	calendar "google.golang.org/api/calendar/v3"
+
+	"github.com/takuoki/google-calendar-sync/api/domain/entity"
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+)
+
+func (r *googleCalendarRepository) ListCalendarList(ctx context.Context, credential entity.Credential) ([]entity.CalendarListEntry, error) {
+
+	if err := checkCredential(credential, valueobject.AuthTypeServiceAccount); err != nil {
+		return nil, err
+	}
+
+	return listCalendarList(ctx, r.service)
+}
+
+func (r *googleCalendarWithOauthRepository) ListCalendarList(ctx context.Context, credential entity.Credential) ([]entity.CalendarListEntry, error) {
+
+	if err := checkCredential(credential, valueobject.AuthTypeOAuth); err != nil {
+		return nil, err
+	}
+
+	service, err := r.newCalendarService(ctx, *credential.RefreshToken)
+	if err != nil {
+		return nil, fmt.Errorf("fail to create calendar service: %w", err)
+	}
+
+	return listCalendarList(ctx, service)
+}
+
+func (r *googleCalendarWithDelegationRepository) ListCalendarList(ctx context.Context, credential entity.Credential) ([]entity.CalendarListEntry, error) {
+
+	if err := checkCredential(credential, valueobject.AuthTypeDelegation); err != nil {
+		return nil, err
+	}
+
+	service, err := r.getCalendarServiceForSubject(*credential.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get calendar service: %w", err)
+	}
+
+	return listCalendarList(ctx, service)
+}
+
+func listCalendarList(ctx context.Context, service *calendar.Service) ([]entity.CalendarListEntry, error) {
+
+	entries := []entity.CalendarListEntry{}
+
+	err := service.CalendarList.List().Context(ctx).Pages(ctx, func(list *calendar.CalendarList) error {
+		for _, item := range list.Items {
+			entries = append(entries, entity.CalendarListEntry{
+				ID:         valueobject.CalendarID(item.Id),
+				Summary:    item.Summary,
+				AccessRole: item.AccessRole,
+				Primary:    item.Primary,
+				TimeZone:   item.TimeZone,
+			})
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, convertError(err, "fail to list calendar list")
+	}
+
+	return entries, nil
+}
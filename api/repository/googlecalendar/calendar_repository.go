@@ -0,0 +1,83 @@
+package googlecalendar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	calendar "google.golang.org/api/calendar/v3"
+	"google.golang.org/api/googleapi"
+
+	"github.com/takuoki/google-calendar-sync/api/domain/entity"
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+)
+
+func (r *googleCalendarRepository) GetCalendarMetadata(ctx context.Context,
+	calendarID valueobject.CalendarID, credential entity.Credential) (*entity.CalendarMetadata, error) {
+
+	if err := checkCredential(credential, valueobject.AuthTypeServiceAccount); err != nil {
+		return nil, err
+	}
+
+	return getCalendarMetadata(ctx, r.service, calendarID)
+}
+
+func (r *googleCalendarWithOauthRepository) GetCalendarMetadata(ctx context.Context,
+	calendarID valueobject.CalendarID, credential entity.Credential) (*entity.CalendarMetadata, error) {
+
+	if err := checkCredential(credential, valueobject.AuthTypeOAuth); err != nil {
+		return nil, err
+	}
+
+	service, err := r.newCalendarService(ctx, *credential.RefreshToken)
+	if err != nil {
+		return nil, fmt.Errorf("fail to create calendar service: %w", err)
+	}
+
+	return getCalendarMetadata(ctx, service, calendarID)
+}
+
+func (r *googleCalendarWithDelegationRepository) GetCalendarMetadata(ctx context.Context,
+	calendarID valueobject.CalendarID, credential entity.Credential) (*entity.CalendarMetadata, error) {
+
+	if err := checkCredential(credential, valueobject.AuthTypeDelegation); err != nil {
+		return nil, err
+	}
+
+	service, err := r.getCalendarServiceForSubject(*credential.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get calendar service: %w", err)
+	}
+
+	return getCalendarMetadata(ctx, service, calendarID)
+}
+
+func getCalendarMetadata(ctx context.Context, service *calendar.Service,
+	calendarID valueobject.CalendarID) (*entity.CalendarMetadata, error) {
+
+	cal, err := service.Calendars.Get(string(calendarID)).Context(ctx).Do()
+	if err != nil {
+		return nil, convertError(err, "fail to get calendar")
+	}
+
+	metadata := &entity.CalendarMetadata{
+		Summary:  cal.Summary,
+		TimeZone: cal.TimeZone,
+	}
+
+	// 共有されただけのカレンダーはカレンダーリストに含まれないことがあるため、
+	// 取得できない場合もエラーとはせず、アクセス権限を空とする
+	entry, err := service.CalendarList.Get(string(calendarID)).Context(ctx).Do()
+	if err != nil {
+		var gErr *googleapi.Error
+		if errors.As(err, &gErr) && gErr.Code == http.StatusNotFound {
+			return metadata, nil
+		}
+		return nil, convertError(err, "fail to get calendar list entry")
+	}
+
+	metadata.AccessRole = entry.AccessRole
+
+	return metadata, nil
+}
@@ -20,7 +20,25 @@ func (r *googleCalendarWithOauthRepository) StopWatch(ctx context.Context, chann
 		return fmt.Errorf("fail to get calendar service: %w", err)
 	}
 
-	return stopWatch(ctx, service, channel, r.logger)
+	if err := stopWatch(ctx, service, channel, r.logger); err != nil {
+		return convertRevokedGrantError(err)
+	}
+
+	return nil
+}
+
+func (r *googleCalendarWithDelegationRepository) StopWatch(ctx context.Context, channel entity.Channel) error {
+
+	service, err := r.getCalendarService(ctx, channel.CalendarID)
+	if err != nil {
+		return fmt.Errorf("fail to get calendar service: %w", err)
+	}
+
+	if err := stopWatch(ctx, service, channel, r.logger); err != nil {
+		return convertRevokedDelegationError(err)
+	}
+
+	return nil
 }
 
 func stopWatch(ctx context.Context, service *calendar.Service, channel entity.Channel, logger applog.Logger) error {
@@ -35,7 +53,7 @@ func stopWatch(ctx context.Context, service *calendar.Service, channel entity.Ch
 	}).Context(ctx).Do()
 
 	if err != nil {
-		return fmt.Errorf("fail to stop watch: %w", err)
+		return wrapError(err, "fail to stop watch")
 	}
 
 	return nil
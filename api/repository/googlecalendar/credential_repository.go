@@ -0,0 +1,24 @@
+package googlecalendar
+
+import (
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+)
+
+func (r *googleCalendarRepository) InvalidateCredential(calendarID valueobject.CalendarID) {
+	// サービスアカウントはカレンダーごとの認証情報を持たないため、何もしない
+}
+
+func (r *googleCalendarWithOauthRepository) InvalidateCredential(calendarID valueobject.CalendarID) {
+	r.serviceCache.Delete(calendarID)
+}
+
+func (r *googleCalendarWithDelegationRepository) InvalidateCredential(calendarID valueobject.CalendarID) {
+	// サブジェクトは呼び出しごとに DB から取得しているため、何もしない
+}
+
+func (r *routingRepository) InvalidateCredential(calendarID valueobject.CalendarID) {
+	// 認証方式が変更された可能性もあるため、すべての実装で破棄する
+	for _, repo := range r.repos {
+		repo.InvalidateCredential(calendarID)
+	}
+}
@@ -0,0 +1,121 @@
+package googlecalendar
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/api/googleapi"
+
+	"github.com/takuoki/google-calendar-sync/api/domain"
+)
+
+// convertError converts an error returned by the Google Calendar API into a typed domain error.
+// The original error is kept in the chain so that it remains in the logs.
+//
+// This must be used only on the paths initiated by the client, such as registering a calendar,
+// because the converted errors are returned to the client as they are.
+// On the sync, watch and webhook paths, use wrapError so that the errors are treated as internal errors.
+func convertError(err error, message string) error {
+
+	var gErr *googleapi.Error
+	if errors.As(err, &gErr) {
+		switch {
+		case gErr.Code == http.StatusNotFound:
+			return fmt.Errorf("%s: %w: %w", message, domain.GoogleCalendarNotFoundError, err)
+		case gErr.Code == http.StatusUnauthorized:
+			return fmt.Errorf("%s: %w: %w", message, domain.GoogleInvalidCredentialsError, err)
+		case gErr.Code == http.StatusTooManyRequests || isRateLimitError(gErr):
+			return fmt.Errorf("%s: %w: %w", message, domain.GoogleQuotaExceededError, err)
+		case gErr.Code == http.StatusForbidden:
+			return fmt.Errorf("%s: %w: %w", message, domain.GooglePermissionDeniedError, err)
+		}
+	}
+
+	// リフレッシュトークンが失効している場合などは、アクセストークンの取得時にエラーとなる
+	var rErr *oauth2.RetrieveError
+	if errors.As(err, &rErr) {
+		return fmt.Errorf("%s: %w: %w", message, domain.GoogleInvalidCredentialsError, err)
+	}
+
+	return fmt.Errorf("%s: %w", message, err)
+}
+
+// wrapError wraps an error returned by the Google Calendar API without converting it into a client error.
+// The errors on the sync, watch and webhook paths are not caused by the request of the client,
+// so they are returned as internal errors and logged with the original error.
+func wrapError(err error, message string) error {
+	return fmt.Errorf("%s: %w", message, err)
+}
+
+// isRateLimitError reports whether the error is a rate limit error.
+// The Google Calendar API returns rate limit errors with 403 as well as 429.
+// see: https://developers.google.com/workspace/calendar/api/guides/errors
+func isRateLimitError(gErr *googleapi.Error) bool {
+	for _, item := range gErr.Errors {
+		switch item.Reason {
+		case "rateLimitExceeded", "userRateLimitExceeded", "quotaExceeded", "dailyLimitExceeded":
+			return true
+		}
+	}
+	return false
+}
+
+// convertRevokedGrantError marks the error as requiring re-authorization
+// when the refresh token of the calendar is revoked or expired.
+// This is only used for the calendars authorized with OAuth 2.0,
+// because the same errors indicate a misconfiguration when using a service account.
+func convertRevokedGrantError(err error) error {
+
+	var rErr *oauth2.RetrieveError
+	if errors.As(err, &rErr) && rErr.ErrorCode == invalidGrantErrorCode {
+		return fmt.Errorf("%w: %w", domain.CalendarNeedsReauthError, err)
+	}
+
+	var gErr *googleapi.Error
+	if errors.As(err, &gErr) && gErr.Code == http.StatusUnauthorized {
+		return fmt.Errorf("%w: %w", domain.CalendarNeedsReauthError, err)
+	}
+
+	return err
+}
+
+// convertRevokedDelegationError marks the error as requiring re-authorization
+// when the domain-wide delegation of the service account is revoked, or the subject is suspended or deleted.
+func convertRevokedDelegationError(err error) error {
+
+	var rErr *oauth2.RetrieveError
+	if errors.As(err, &rErr) {
+		switch retrieveErrorCode(rErr) {
+		case invalidGrantErrorCode, unauthorizedClientErrorCode:
+			return fmt.Errorf("%w: %w", domain.CalendarNeedsReauthError, err)
+		}
+	}
+
+	var gErr *googleapi.Error
+	if errors.As(err, &gErr) && gErr.Code == http.StatusUnauthorized {
+		return fmt.Errorf("%w: %w", domain.CalendarNeedsReauthError, err)
+	}
+
+	return err
+}
+
+// retrieveErrorCode returns the error code of the token endpoint.
+// The token source of the service account does not parse the response, so the code is read from the body.
+func retrieveErrorCode(rErr *oauth2.RetrieveError) string {
+
+	if rErr.ErrorCode != "" {
+		return rErr.ErrorCode
+	}
+
+	var body struct {
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal(rErr.Body, &body); err != nil {
+		return ""
+	}
+
+	return body.Error
+}
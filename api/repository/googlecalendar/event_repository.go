@@ -26,7 +26,28 @@ func (r *googleCalendarWithOauthRepository) ListEventsWithAfter(
 		return nil, nil, "", fmt.Errorf("fail to get calendar service: %w", err)
 	}
 
-	return listEventsWithAfter(ctx, service, r.clockService, r.logger, calendarID, after)
+	events, recurringEvents, nextSyncToken, err := listEventsWithAfter(ctx, service, r.clockService, r.logger, calendarID, after)
+	if err != nil {
+		return nil, nil, "", convertRevokedGrantError(err)
+	}
+
+	return events, recurringEvents, nextSyncToken, nil
+}
+
+func (r *googleCalendarWithDelegationRepository) ListEventsWithAfter(
+	ctx context.Context, calendarID valueobject.CalendarID, after time.Time) ([]entity.Event, []entity.RecurringEvent, string, error) {
+
+	service, err := r.getCalendarService(ctx, calendarID)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("fail to get calendar service: %w", err)
+	}
+
+	events, recurringEvents, nextSyncToken, err := listEventsWithAfter(ctx, service, r.clockService, r.logger, calendarID, after)
+	if err != nil {
+		return nil, nil, "", convertRevokedDelegationError(err)
+	}
+
+	return events, recurringEvents, nextSyncToken, nil
 }
 
 func listEventsWithAfter(
@@ -55,7 +76,28 @@ func (r *googleCalendarWithOauthRepository) ListEventsWithSyncToken(
 		return nil, nil, "", fmt.Errorf("fail to get calendar service: %w", err)
 	}
 
-	return listEventsWithSyncToken(ctx, service, r.clockService, r.logger, calendarID, syncToken)
+	events, recurringEvents, nextSyncToken, err := listEventsWithSyncToken(ctx, service, r.clockService, r.logger, calendarID, syncToken)
+	if err != nil {
+		return nil, nil, "", convertRevokedGrantError(err)
+	}
+
+	return events, recurringEvents, nextSyncToken, nil
+}
+
+func (r *googleCalendarWithDelegationRepository) ListEventsWithSyncToken(
+	ctx context.Context, calendarID valueobject.CalendarID, syncToken string) ([]entity.Event, []entity.RecurringEvent, string, error) {
+
+	service, err := r.getCalendarService(ctx, calendarID)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("fail to get calendar service: %w", err)
+	}
+
+	events, recurringEvents, nextSyncToken, err := listEventsWithSyncToken(ctx, service, r.clockService, r.logger, calendarID, syncToken)
+	if err != nil {
+		return nil, nil, "", convertRevokedDelegationError(err)
+	}
+
+	return events, recurringEvents, nextSyncToken, nil
 }
 
 func listEventsWithSyncToken(ctx context.Context, service *calendar.Service, clockService service.Clock, logger applog.Logger,
@@ -82,7 +124,28 @@ func (r *googleCalendarWithOauthRepository) ListEventInstancesBetween(
 		return nil, fmt.Errorf("fail to get calendar service: %w", err)
 	}
 
-	return listEventInstancesBetween(ctx, service, r.clockService, r.logger, calendarID, eventID, from, to)
+	events, err := listEventInstancesBetween(ctx, service, r.clockService, r.logger, calendarID, eventID, from, to)
+	if err != nil {
+		return nil, convertRevokedGrantError(err)
+	}
+
+	return events, nil
+}
+
+func (r *googleCalendarWithDelegationRepository) ListEventInstancesBetween(
+	ctx context.Context, calendarID valueobject.CalendarID, eventID valueobject.EventID, from, to time.Time) ([]entity.Event, error) {
+
+	service, err := r.getCalendarService(ctx, calendarID)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get calendar service: %w", err)
+	}
+
+	events, err := listEventInstancesBetween(ctx, service, r.clockService, r.logger, calendarID, eventID, from, to)
+	if err != nil {
+		return nil, convertRevokedDelegationError(err)
+	}
+
+	return events, nil
 }
 
 func listEventInstancesBetween(ctx context.Context, service *calendar.Service, clockService service.Clock, logger applog.Logger,
@@ -124,7 +187,27 @@ func (r *googleCalendarWithOauthRepository) Watch(ctx context.Context, calendarI
 		return nil, fmt.Errorf("fail to get calendar service: %w", err)
 	}
 
-	return watch(ctx, service, r.webhookBaseURL, r.clockService, calendarID)
+	channel, err := watch(ctx, service, r.webhookBaseURL, r.clockService, calendarID)
+	if err != nil {
+		return nil, convertRevokedGrantError(err)
+	}
+
+	return channel, nil
+}
+
+func (r *googleCalendarWithDelegationRepository) Watch(ctx context.Context, calendarID valueobject.CalendarID) (*entity.Channel, error) {
+
+	service, err := r.getCalendarService(ctx, calendarID)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get calendar service: %w", err)
+	}
+
+	channel, err := watch(ctx, service, r.webhookBaseURL, r.clockService, calendarID)
+	if err != nil {
+		return nil, convertRevokedDelegationError(err)
+	}
+
+	return channel, nil
 }
 
 func watch(ctx context.Context, service *calendar.Service, webhookBaseURL string, clockService service.Clock,
@@ -139,7 +222,7 @@ func watch(ctx context.Context, service *calendar.Service, webhookBaseURL string
 
 	channel, err := service.Events.Watch(string(calendarID), &request).Context(ctx).Do()
 	if err != nil {
-		return nil, fmt.Errorf("fail to watch: %w", err)
+		return nil, wrapError(err, "fail to watch")
 	}
 
 	expiration, err := convertUnitTime(channel.Expiration)
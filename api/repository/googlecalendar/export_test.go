@@ -0,0 +1,22 @@
+package googlecalendar
+
+import (
+	"context"
+
+	calendar "google.golang.org/api/calendar/v3"
+
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+	"github.com/takuoki/google-calendar-sync/api/repository"
+)
+
+// Exported for testing
+func GetCalendarService(ctx context.Context, repo repository.GoogleCalendarRepository,
+	calendarID valueobject.CalendarID) (*calendar.Service, error) {
+	return repo.(*googleCalendarWithOauthRepository).getCalendarService(ctx, calendarID)
+}
+
+func SetTokenURL(repo repository.GoogleCalendarRepository, tokenURL string) {
+	repo.(*googleCalendarWithOauthRepository).oauth2Config.Endpoint.TokenURL = tokenURL
+}
+
+var ConvertRevokedDelegationError = convertRevokedDelegationError
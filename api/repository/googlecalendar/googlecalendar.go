@@ -2,15 +2,19 @@ package googlecalendar
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
+	"golang.org/x/oauth2/jwt"
 	calendar "google.golang.org/api/calendar/v3"
 	"google.golang.org/api/option"
 
 	"github.com/takuoki/golib/applog"
+	"github.com/takuoki/google-calendar-sync/api/domain"
+	"github.com/takuoki/google-calendar-sync/api/domain/entity"
 	"github.com/takuoki/google-calendar-sync/api/domain/service"
 	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
 	"github.com/takuoki/google-calendar-sync/api/repository"
@@ -47,10 +51,25 @@ type RefreshTokenResolver interface {
 	GetRefreshToken(ctx context.Context, calendarID valueobject.CalendarID) (string, error)
 }
 
+// calendarServiceCacheTTL is the lifetime of a cached calendar service.
+// The token source in the service refreshes access tokens by itself, so this only limits how long unused services are kept.
+const calendarServiceCacheTTL = 1 * time.Hour
+
+// invalidGrantErrorCode is the error code returned by Google when the refresh token is revoked or expired.
+const invalidGrantErrorCode = "invalid_grant"
+
+// unauthorizedClientErrorCode is the error code returned by Google when the domain-wide delegation is not granted.
+const unauthorizedClientErrorCode = "unauthorized_client"
+
+type calendarServiceCacheEntry struct {
+	service *calendar.Service
+}
+
 type googleCalendarWithOauthRepository struct {
 	webhookBaseURL       string
 	oauth2Config         *oauth2.Config
 	refreshTokenResolver RefreshTokenResolver
+	serviceCache         service.Cache[valueobject.CalendarID, *calendarServiceCacheEntry]
 	clockService         service.Clock
 	logger               applog.Logger
 }
@@ -62,6 +81,26 @@ func NewGoogleCalendarWithOauthRepository(webhookBaseURL, oauthClientID, oauthCl
 		return nil, fmt.Errorf("webhook base url is required")
 	}
 
+	oauth2Config, err := newOauth2Config(oauthClientID, oauthClientSecret, oauthRedirectURL)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceCache := service.NewInMemoryCache[valueobject.CalendarID, *calendarServiceCacheEntry](
+		service.WithTTL(calendarServiceCacheTTL), service.WithCacheClock(clockService))
+
+	return &googleCalendarWithOauthRepository{
+		webhookBaseURL:       webhookBaseURL,
+		oauth2Config:         oauth2Config,
+		refreshTokenResolver: refreshTokenResolver,
+		serviceCache:         serviceCache,
+		clockService:         clockService,
+		logger:               logger,
+	}, nil
+}
+
+func newOauth2Config(oauthClientID, oauthClientSecret, oauthRedirectURL string) (*oauth2.Config, error) {
+
 	if oauthClientID == "" {
 		return nil, fmt.Errorf("oauth client id is required")
 	}
@@ -74,29 +113,56 @@ func NewGoogleCalendarWithOauthRepository(webhookBaseURL, oauthClientID, oauthCl
 		return nil, fmt.Errorf("oauth redirect url is required")
 	}
 
-	oauth2Config := &oauth2.Config{
+	return &oauth2.Config{
 		ClientID:     oauthClientID,
 		ClientSecret: oauthClientSecret,
 		Endpoint:     google.Endpoint,
 		RedirectURL:  oauthRedirectURL,
-	}
-
-	return &googleCalendarWithOauthRepository{
-		webhookBaseURL:       webhookBaseURL,
-		oauth2Config:         oauth2Config,
-		refreshTokenResolver: refreshTokenResolver,
-		clockService:         clockService,
-		logger:               logger,
+		// 同期とチャネルの作成には読み取り権限のみで十分
+		Scopes: []string{calendar.CalendarReadonlyScope},
 	}, nil
 }
 
 func (r *googleCalendarWithOauthRepository) getCalendarService(ctx context.Context, calendarID valueobject.CalendarID) (*calendar.Service, error) {
 
-	refreshToken, err := r.refreshTokenResolver.GetRefreshToken(ctx, calendarID)
+	// リフレッシュトークンの変更時は InvalidateCredential で破棄されるため、キャッシュがあれば DB を参照しない
+	entry, err := r.serviceCache.GetOrLoad(calendarID, func() (*calendarServiceCacheEntry, error) {
+
+		refreshToken, err := r.refreshTokenResolver.GetRefreshToken(ctx, calendarID)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get refresh token: %w", err)
+		}
+
+		entry := &calendarServiceCacheEntry{}
+
+		// キャッシュしたサービスは複数のリクエストで使用するため、リクエストのコンテキストに依存させない
+		tokenSource := &invalidatingTokenSource{
+			base: r.oauth2Config.TokenSource(context.Background(), &oauth2.Token{RefreshToken: refreshToken}),
+			onInvalidGrant: func() {
+				r.logger.Warnf(context.Background(), "refresh token is rejected, cached calendar service is invalidated (calendarID: %q)", calendarID)
+				if cached, ok := r.serviceCache.Get(calendarID); ok && cached == entry {
+					r.serviceCache.Delete(calendarID)
+				}
+			},
+		}
+
+		entry.service, err = calendar.NewService(context.Background(),
+			option.WithHTTPClient(oauth2.NewClient(context.Background(), tokenSource)))
+		if err != nil {
+			return nil, fmt.Errorf("fail to create calendar service: %w", err)
+		}
+
+		return entry, nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("fail to get refresh token: %w", err)
+		return nil, err
 	}
 
+	return entry.service, nil
+}
+
+func (r *googleCalendarWithOauthRepository) newCalendarService(ctx context.Context, refreshToken string) (*calendar.Service, error) {
+
 	token := &oauth2.Token{
 		RefreshToken: refreshToken,
 	}
@@ -112,6 +178,117 @@ func (r *googleCalendarWithOauthRepository) getCalendarService(ctx context.Conte
 	return calendarService, nil
 }
 
+type SubjectResolver interface {
+	GetSubject(ctx context.Context, calendarID valueobject.CalendarID) (string, error)
+}
+
+type googleCalendarWithDelegationRepository struct {
+	webhookBaseURL  string
+	jwtConfig       *jwt.Config
+	subjectResolver SubjectResolver
+	serviceCache    service.Cache[string, *calendar.Service]
+	clockService    service.Clock
+	logger          applog.Logger
+}
+
+// NewGoogleCalendarWithDelegationRepository returns a repository that impersonates the subject of each calendar
+// with the service account key via domain-wide delegation.
+func NewGoogleCalendarWithDelegationRepository(webhookBaseURL string, credentialsJSON []byte,
+	subjectResolver SubjectResolver, clockService service.Clock, logger applog.Logger) (repository.GoogleCalendarRepository, error) {
+
+	if webhookBaseURL == "" {
+		return nil, fmt.Errorf("webhook base url is required")
+	}
+
+	// 同期とチャネルの作成には読み取り権限のみで十分
+	jwtConfig, err := google.JWTConfigFromJSON(credentialsJSON, calendar.CalendarReadonlyScope)
+	if err != nil {
+		return nil, fmt.Errorf("fail to parse service account key: %w", err)
+	}
+
+	serviceCache := service.NewInMemoryCache[string, *calendar.Service](
+		service.WithTTL(calendarServiceCacheTTL), service.WithCacheClock(clockService))
+
+	return &googleCalendarWithDelegationRepository{
+		webhookBaseURL:  webhookBaseURL,
+		jwtConfig:       jwtConfig,
+		subjectResolver: subjectResolver,
+		serviceCache:    serviceCache,
+		clockService:    clockService,
+		logger:          logger,
+	}, nil
+}
+
+func (r *googleCalendarWithDelegationRepository) getCalendarService(ctx context.Context, calendarID valueobject.CalendarID) (*calendar.Service, error) {
+
+	subject, err := r.subjectResolver.GetSubject(ctx, calendarID)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get subject: %w", err)
+	}
+
+	return r.getCalendarServiceForSubject(subject)
+}
+
+// getCalendarServiceForSubject returns the calendar service impersonating the subject.
+// The service is shared by the calendars of the same subject.
+func (r *googleCalendarWithDelegationRepository) getCalendarServiceForSubject(subject string) (*calendar.Service, error) {
+
+	return r.serviceCache.GetOrLoad(subject, func() (*calendar.Service, error) {
+		jwtConfig := *r.jwtConfig
+		jwtConfig.Subject = subject
+
+		// キャッシュしたサービスは複数のリクエストで使用するため、リクエストのコンテキストに依存させない
+		calendarService, err := calendar.NewService(context.Background(),
+			option.WithTokenSource(jwtConfig.TokenSource(context.Background())))
+		if err != nil {
+			return nil, fmt.Errorf("fail to create calendar service: %w", err)
+		}
+
+		return calendarService, nil
+	})
+}
+
+// checkCredential checks that the credential is the one used by the auth type.
+func checkCredential(credential entity.Credential, authType valueobject.AuthType) error {
+
+	if authType != valueobject.AuthTypeOAuth && credential.RefreshToken != nil {
+		return domain.NotAllowedError("refreshToken")
+	}
+
+	if authType != valueobject.AuthTypeDelegation && credential.Subject != nil {
+		return domain.NotAllowedError("subject")
+	}
+
+	if authType == valueobject.AuthTypeOAuth && (credential.RefreshToken == nil || *credential.RefreshToken == "") {
+		return domain.RequiredError("refreshToken")
+	}
+
+	if authType == valueobject.AuthTypeDelegation && (credential.Subject == nil || *credential.Subject == "") {
+		return domain.RequiredError("subject")
+	}
+
+	return nil
+}
+
+// invalidatingTokenSource calls onInvalidGrant when the refresh token is rejected by Google.
+type invalidatingTokenSource struct {
+	base           oauth2.TokenSource
+	onInvalidGrant func()
+}
+
+func (s *invalidatingTokenSource) Token() (*oauth2.Token, error) {
+	token, err := s.base.Token()
+	if err != nil {
+		var rErr *oauth2.RetrieveError
+		if errors.As(err, &rErr) && rErr.ErrorCode == invalidGrantErrorCode {
+			s.onInvalidGrant()
+		}
+		return nil, err
+	}
+
+	return token, nil
+}
+
 func convertDateTime(datetime *calendar.EventDateTime, location string) (*time.Time, error) {
 	if datetime == nil {
 		return nil, nil
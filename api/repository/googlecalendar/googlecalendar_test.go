@@ -0,0 +1,158 @@
+package googlecalendar_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/takuoki/golib/applog"
+	"golang.org/x/oauth2"
+	"google.golang.org/api/googleapi"
+
+	"github.com/takuoki/google-calendar-sync/api/domain"
+	"github.com/takuoki/google-calendar-sync/api/domain/service"
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+	"github.com/takuoki/google-calendar-sync/api/repository"
+	"github.com/takuoki/google-calendar-sync/api/repository/googlecalendar"
+)
+
+type countingRefreshTokenResolver struct {
+	count atomic.Int32
+}
+
+func (r *countingRefreshTokenResolver) GetRefreshToken(ctx context.Context, calendarID valueobject.CalendarID) (string, error) {
+	r.count.Add(1)
+	return "refresh-token", nil
+}
+
+func setupOauthRepository(t *testing.T) (repository.GoogleCalendarRepository, *countingRefreshTokenResolver) {
+	t.Helper()
+
+	logger, err := applog.NewSimpleLogger(io.Discard)
+	require.NoError(t, err)
+
+	resolver := &countingRefreshTokenResolver{}
+	repo, err := googlecalendar.NewGoogleCalendarWithOauthRepository("https://example.com/webhook",
+		"client-id", "client-secret", "https://example.com/oauth/callback", resolver, service.NewMockClock(), logger)
+	require.NoError(t, err)
+
+	return repo, resolver
+}
+
+func TestGoogleCalendarWithOauthRepository_GetCalendarService_CacheHit(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	repo, resolver := setupOauthRepository(t)
+	first, err := googlecalendar.GetCalendarService(ctx, repo, "calendar-1")
+	require.NoError(t, err)
+
+	// When
+	second, err := googlecalendar.GetCalendarService(ctx, repo, "calendar-1")
+	require.NoError(t, err)
+
+	// Then
+	assert.Same(t, first, second)
+	assert.Equal(t, int32(1), resolver.count.Load())
+}
+
+func TestGoogleCalendarWithOauthRepository_GetCalendarService_Invalidated(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	repo, resolver := setupOauthRepository(t)
+	first, err := googlecalendar.GetCalendarService(ctx, repo, "calendar-1")
+	require.NoError(t, err)
+
+	// When
+	repo.InvalidateCredential("calendar-1")
+	second, err := googlecalendar.GetCalendarService(ctx, repo, "calendar-1")
+	require.NoError(t, err)
+
+	// Then
+	assert.NotSame(t, first, second)
+	assert.Equal(t, int32(2), resolver.count.Load())
+}
+
+func TestGoogleCalendarWithOauthRepository_GetCalendarService_InvalidGrant(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"invalid_grant","error_description":"Token has been expired or revoked."}`))
+	}))
+	t.Cleanup(tokenServer.Close)
+
+	repo, resolver := setupOauthRepository(t)
+	googlecalendar.SetTokenURL(repo, tokenServer.URL)
+
+	first, err := googlecalendar.GetCalendarService(ctx, repo, "calendar-1")
+	require.NoError(t, err)
+
+	// When: the refresh token is rejected when the access token is retrieved
+	_, err = first.CalendarList.Get("calendar-1").Context(ctx).Do()
+	require.Error(t, err)
+
+	second, err := googlecalendar.GetCalendarService(ctx, repo, "calendar-1")
+	require.NoError(t, err)
+
+	// Then
+	assert.NotSame(t, first, second)
+	assert.Equal(t, int32(2), resolver.count.Load())
+}
+
+func TestConvertRevokedDelegationError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		err         error
+		needsReauth bool
+	}{
+		"delegation revoked": {
+			err:         &oauth2.RetrieveError{Body: []byte(`{"error":"unauthorized_client"}`)},
+			needsReauth: true,
+		},
+		"subject suspended": {
+			err:         &oauth2.RetrieveError{Body: []byte(`{"error":"invalid_grant"}`)},
+			needsReauth: true,
+		},
+		"unauthorized": {
+			err:         &googleapi.Error{Code: http.StatusUnauthorized},
+			needsReauth: true,
+		},
+		"other token error": {
+			err:         &oauth2.RetrieveError{Body: []byte(`{"error":"invalid_client"}`)},
+			needsReauth: false,
+		},
+		"server error": {
+			err:         &googleapi.Error{Code: http.StatusInternalServerError},
+			needsReauth: false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := googlecalendar.ConvertRevokedDelegationError(fmt.Errorf("fail to list events: %w", tt.err))
+
+			assert.Equal(t, tt.needsReauth, errors.Is(err, domain.CalendarNeedsReauthError))
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
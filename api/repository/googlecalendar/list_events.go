@@ -7,6 +7,7 @@ import (
 
 	"github.com/takuoki/golib/applog"
 	"github.com/takuoki/google-calendar-sync/api/domain"
+	"github.com/takuoki/google-calendar-sync/api/domain/constant"
 	"github.com/takuoki/google-calendar-sync/api/domain/entity"
 	"github.com/takuoki/google-calendar-sync/api/domain/service"
 	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
@@ -71,7 +72,7 @@ func listEvents(ctx context.Context, clockService service.Clock, logger applog.L
 			if gErr, ok := err.(*googleapi.Error); ok && gErr.Code == 410 {
 				return nil, nil, "", domain.SyncTokenIsOldError
 			}
-			return nil, nil, "", fmt.Errorf("fail to list events: %w", err)
+			return nil, nil, "", wrapError(err, "fail to list events")
 		}
 
 		for _, item := range events.Items {
@@ -86,13 +87,30 @@ func listEvents(ctx context.Context, clockService service.Clock, logger applog.L
 			}
 
 			if len(item.Recurrence) == 0 {
+				originalStart, err := convertDateTime(item.OriginalStartTime, events.TimeZone)
+				if err != nil {
+					return nil, nil, "", fmt.Errorf("fail to convert original start datetime: %w", err)
+				}
+
+				// 既定値の opaque は省略されるため補完する
+				transparency := item.Transparency
+				if transparency == "" {
+					transparency = constant.EventTransparencyOpaque
+				}
+
 				resEvents = append(resEvents, entity.Event{
 					ID:               valueobject.EventID(item.Id),
 					CalendarID:       calendarID,
 					RecurringEventID: valueobject.NewEventID(item.RecurringEventId),
 					Summary:          item.Summary,
+					Description:      item.Description,
+					Location:         item.Location,
 					Start:            start,
 					End:              end,
+					OriginalStart:    originalStart,
+					Transparency:     transparency,
+					AllDay:           item.Start != nil && item.Start.Date != "",
+					ICalUID:          item.ICalUID,
 					Status:           item.Status,
 				})
 			} else {
@@ -108,6 +126,7 @@ func listEvents(ctx context.Context, clockService service.Clock, logger applog.L
 					Recurrence: string(recurrenceStr),
 					Start:      start,
 					End:        end,
+					ICalUID:    item.ICalUID,
 					Status:     item.Status,
 				})
 			}
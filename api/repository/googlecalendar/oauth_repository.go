@@ -0,0 +1,49 @@
+package googlecalendar
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/oauth2"
+
+	"github.com/takuoki/google-calendar-sync/api/repository"
+)
+
+type oauthRepository struct {
+	oauth2Config *oauth2.Config
+}
+
+func NewOAuthRepository(oauthClientID, oauthClientSecret, oauthRedirectURL string) (repository.OAuthRepository, error) {
+
+	oauth2Config, err := newOauth2Config(oauthClientID, oauthClientSecret, oauthRedirectURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &oauthRepository{
+		oauth2Config: oauth2Config,
+	}, nil
+}
+
+func (r *oauthRepository) AuthCodeURL(state, codeVerifier string) string {
+	// リフレッシュトークンは初回の同意時にしか発行されないため、毎回同意画面を表示する
+	return r.oauth2Config.AuthCodeURL(state,
+		oauth2.AccessTypeOffline,
+		oauth2.ApprovalForce,
+		oauth2.S256ChallengeOption(codeVerifier),
+	)
+}
+
+func (r *oauthRepository) ExchangeRefreshToken(ctx context.Context, code, codeVerifier string) (string, error) {
+
+	token, err := r.oauth2Config.Exchange(ctx, code, oauth2.VerifierOption(codeVerifier))
+	if err != nil {
+		return "", convertError(err, "fail to exchange authorization code")
+	}
+
+	if token.RefreshToken == "" {
+		return "", fmt.Errorf("refresh token is not issued")
+	}
+
+	return token.RefreshToken, nil
+}
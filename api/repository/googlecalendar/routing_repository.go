@@ -0,0 +1,157 @@
+package googlecalendar
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/takuoki/google-calendar-sync/api/domain"
+	"github.com/takuoki/google-calendar-sync/api/domain/entity"
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+	"github.com/takuoki/google-calendar-sync/api/repository"
+)
+
+type AuthTypeResolver interface {
+	GetAuthType(ctx context.Context, calendarID valueobject.CalendarID) (valueobject.AuthType, error)
+}
+
+// routingRepository dispatches each call to the service account, OAuth or domain-wide delegation implementation
+// according to the auth type of the calendar.
+type routingRepository struct {
+	repos            map[valueobject.AuthType]repository.GoogleCalendarRepository
+	authTypeResolver AuthTypeResolver
+}
+
+// NewRoutingRepository returns a repository that dispatches to the implementation of the auth type per calendar.
+// Any of the implementations can be nil if the auth type is not enabled.
+func NewRoutingRepository(serviceAccountRepo, oauthRepo, delegationRepo repository.GoogleCalendarRepository,
+	authTypeResolver AuthTypeResolver) (repository.GoogleCalendarRepository, error) {
+
+	repos := map[valueobject.AuthType]repository.GoogleCalendarRepository{}
+	if serviceAccountRepo != nil {
+		repos[valueobject.AuthTypeServiceAccount] = serviceAccountRepo
+	}
+	if oauthRepo != nil {
+		repos[valueobject.AuthTypeOAuth] = oauthRepo
+	}
+	if delegationRepo != nil {
+		repos[valueobject.AuthTypeDelegation] = delegationRepo
+	}
+
+	if len(repos) == 0 {
+		return nil, fmt.Errorf("at least one google calendar repository is required")
+	}
+
+	return &routingRepository{
+		repos:            repos,
+		authTypeResolver: authTypeResolver,
+	}, nil
+}
+
+func (r *routingRepository) GetCalendarMetadata(ctx context.Context,
+	calendarID valueobject.CalendarID, credential entity.Credential) (*entity.CalendarMetadata, error) {
+
+	// 登録前のカレンダーにも使用されるため、指定された認証情報で振り分ける
+	repo, err := r.repositoryForCredential(credential)
+	if err != nil {
+		return nil, err
+	}
+
+	return repo.GetCalendarMetadata(ctx, calendarID, credential)
+}
+
+func (r *routingRepository) ListEventsWithAfter(
+	ctx context.Context, calendarID valueobject.CalendarID, after time.Time) ([]entity.Event, []entity.RecurringEvent, string, error) {
+
+	repo, err := r.repositoryForCalendar(ctx, calendarID)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	return repo.ListEventsWithAfter(ctx, calendarID, after)
+}
+
+func (r *routingRepository) ListEventsWithSyncToken(
+	ctx context.Context, calendarID valueobject.CalendarID, syncToken string) ([]entity.Event, []entity.RecurringEvent, string, error) {
+
+	repo, err := r.repositoryForCalendar(ctx, calendarID)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	return repo.ListEventsWithSyncToken(ctx, calendarID, syncToken)
+}
+
+func (r *routingRepository) ListEventInstancesBetween(
+	ctx context.Context, calendarID valueobject.CalendarID, eventID valueobject.EventID, from, to time.Time) ([]entity.Event, error) {
+
+	repo, err := r.repositoryForCalendar(ctx, calendarID)
+	if err != nil {
+		return nil, err
+	}
+
+	return repo.ListEventInstancesBetween(ctx, calendarID, eventID, from, to)
+}
+
+func (r *routingRepository) Watch(ctx context.Context, calendarID valueobject.CalendarID) (*entity.Channel, error) {
+
+	repo, err := r.repositoryForCalendar(ctx, calendarID)
+	if err != nil {
+		return nil, err
+	}
+
+	return repo.Watch(ctx, calendarID)
+}
+
+func (r *routingRepository) StopWatch(ctx context.Context, channel entity.Channel) error {
+
+	repo, err := r.repositoryForCalendar(ctx, channel.CalendarID)
+	if err != nil {
+		return err
+	}
+
+	return repo.StopWatch(ctx, channel)
+}
+
+func (r *routingRepository) ListCalendarList(ctx context.Context, credential entity.Credential) ([]entity.CalendarListEntry, error) {
+
+	repo, err := r.repositoryForCredential(credential)
+	if err != nil {
+		return nil, err
+	}
+
+	return repo.ListCalendarList(ctx, credential)
+}
+
+func (r *routingRepository) repositoryForCalendar(ctx context.Context,
+	calendarID valueobject.CalendarID) (repository.GoogleCalendarRepository, error) {
+
+	authType, err := r.authTypeResolver.GetAuthType(ctx, calendarID)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get auth type: %w", err)
+	}
+
+	repo, ok := r.repos[authType]
+	if !ok {
+		return nil, fmt.Errorf("auth type is not enabled: %q (calendarID: %q)", authType, calendarID)
+	}
+
+	return repo, nil
+}
+
+func (r *routingRepository) repositoryForCredential(credential entity.Credential) (repository.GoogleCalendarRepository, error) {
+
+	repo, ok := r.repos[credential.AuthType()]
+	if !ok {
+		switch credential.AuthType() {
+		case valueobject.AuthTypeOAuth:
+			return nil, domain.NotAllowedError("refreshToken")
+		case valueobject.AuthTypeDelegation:
+			return nil, domain.NotAllowedError("subject")
+		default:
+			return nil, domain.RequiredError("refreshToken or subject")
+		}
+	}
+
+	return repo, nil
+}
@@ -5,7 +5,9 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-sql-driver/mysql"
 
@@ -15,18 +17,59 @@ import (
 	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
 )
 
-var refreshTokenCache = service.NewInMemoryCache[valueobject.CalendarID, string]()
+// Calendars may be updated by other instances, so the cached values expire after calendarCacheTTL.
+const (
+	calendarCacheTTL     = 10 * time.Minute
+	calendarCacheMaxSize = 10000
+)
+
+var (
+	refreshTokenCache = service.NewInMemoryCache[valueobject.CalendarID, string](
+		service.WithTTL(calendarCacheTTL), service.WithMaxSize(calendarCacheMaxSize))
+	authTypeCache = service.NewInMemoryCache[valueobject.CalendarID, valueobject.AuthType](
+		service.WithTTL(calendarCacheTTL), service.WithMaxSize(calendarCacheMaxSize))
+	subjectCache = service.NewInMemoryCache[valueobject.CalendarID, string](
+		service.WithTTL(calendarCacheTTL), service.WithMaxSize(calendarCacheMaxSize))
+)
 
 func (r *MysqlRepository) GetCalendar(ctx context.Context, calendarID valueobject.CalendarID) (*entity.Calendar, error) {
 
+	calendar, err := getCalendar(ctx, r.db, r.cryptService, calendarID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if calendar.RefreshToken != nil {
+		refreshTokenCache.Set(calendar.ID, *calendar.RefreshToken)
+	}
+	authTypeCache.Set(calendar.ID, calendar.AuthType)
+	if calendar.Subject != nil {
+		subjectCache.Set(calendar.ID, *calendar.Subject)
+	}
+
+	calendar.Tags, err = r.listCalendarTags(ctx, calendar.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fail to list calendar tags: %w", err)
+	}
+
+	return calendar, nil
+}
+
+// getCalendar selects the calendar without its tags. The row is locked until the end of the transaction if forUpdate is true.
+func getCalendar(ctx context.Context, db database, cryptService service.Crypt,
+	calendarID valueobject.CalendarID, forUpdate bool) (*entity.Calendar, error) {
+
+	query := "SELECT id, name, refresh_token, subject, auth_type, summary, time_zone, access_role, is_paused, needs_reauth_at " +
+		"FROM calendars WHERE id = ?"
+	if forUpdate {
+		query += " FOR UPDATE"
+	}
+
 	var calendar entity.Calendar
 	var refreshToken sql.NullString
 
-	err := r.db.QueryRowContext(
-		ctx,
-		"SELECT id, name, refresh_token FROM calendars WHERE id = ?",
-		calendarID,
-	).Scan(&calendar.ID, &calendar.Name, &refreshToken)
+	err := db.QueryRowContext(ctx, query, calendarID).Scan(&calendar.ID, &calendar.Name, &refreshToken, &calendar.Subject,
+		&calendar.AuthType, &calendar.Summary, &calendar.TimeZone, &calendar.AccessRole, &calendar.IsPaused, &calendar.NeedsReauthAt)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -36,8 +79,8 @@ func (r *MysqlRepository) GetCalendar(ctx context.Context, calendarID valueobjec
 	}
 
 	if refreshToken.Valid {
-		if r.cryptService != nil {
-			decrypted, err := r.cryptService.Decrypt(refreshToken.String)
+		if cryptService != nil {
+			decrypted, err := cryptService.Decrypt(refreshToken.String)
 			if err != nil {
 				return nil, fmt.Errorf("fail to decrypt refresh token: %w", err)
 			}
@@ -47,18 +90,42 @@ func (r *MysqlRepository) GetCalendar(ctx context.Context, calendarID valueobjec
 		}
 	}
 
-	if calendar.RefreshToken != nil {
-		refreshTokenCache.Set(calendar.ID, *calendar.RefreshToken)
+	return &calendar, nil
+}
+
+func (r *MysqlRepository) ListCalendars(ctx context.Context, tag *string) ([]entity.Calendar, error) {
+
+	if tag == nil {
+		return r.listCalendars(ctx, "", nil)
 	}
 
-	return &calendar, nil
+	return r.listCalendars(ctx, "WHERE id IN (SELECT calendar_id FROM calendar_tags WHERE tag = ?)", []any{*tag})
 }
 
-func (r *MysqlRepository) ListCalendars(ctx context.Context) ([]entity.Calendar, error) {
-	rows, err := r.db.QueryContext(
-		ctx,
-		"SELECT id, name, refresh_token FROM calendars",
-	)
+func (r *MysqlRepository) ListCalendarsWithIDs(ctx context.Context, calendarIDs []valueobject.CalendarID) ([]entity.Calendar, error) {
+
+	if len(calendarIDs) == 0 {
+		return []entity.Calendar{}, nil
+	}
+
+	placeholders := make([]string, len(calendarIDs))
+	args := make([]any, 0, len(calendarIDs))
+	for i, id := range calendarIDs {
+		placeholders[i] = "?"
+		args = append(args, id)
+	}
+
+	return r.listCalendars(ctx, "WHERE id IN ("+strings.Join(placeholders, ",")+")", args)
+}
+
+func (r *MysqlRepository) listCalendars(ctx context.Context, where string, args []any) ([]entity.Calendar, error) {
+
+	query := "SELECT id, name, refresh_token, subject, auth_type, summary, time_zone, access_role, is_paused, needs_reauth_at FROM calendars"
+	if where != "" {
+		query += " " + where
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("fail to select calendars: %w", err)
 	}
@@ -73,7 +140,8 @@ func (r *MysqlRepository) ListCalendars(ctx context.Context) ([]entity.Calendar,
 		var calendar entity.Calendar
 		var refreshToken sql.NullString
 
-		if err := rows.Scan(&calendar.ID, &calendar.Name, &refreshToken); err != nil {
+		if err := rows.Scan(&calendar.ID, &calendar.Name, &refreshToken, &calendar.Subject, &calendar.AuthType,
+			&calendar.Summary, &calendar.TimeZone, &calendar.AccessRole, &calendar.IsPaused, &calendar.NeedsReauthAt); err != nil {
 			return nil, fmt.Errorf("fail to scan calendar: %w", err)
 		}
 
@@ -94,6 +162,22 @@ func (r *MysqlRepository) ListCalendars(ctx context.Context) ([]entity.Calendar,
 		if calendar.RefreshToken != nil {
 			refreshTokenCache.Set(calendar.ID, *calendar.RefreshToken)
 		}
+		authTypeCache.Set(calendar.ID, calendar.AuthType)
+		if calendar.Subject != nil {
+			subjectCache.Set(calendar.ID, *calendar.Subject)
+		}
+	}
+
+	tagMap, err := r.listAllCalendarTags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fail to list calendar tags: %w", err)
+	}
+
+	for i := range calendars {
+		calendars[i].Tags = tagMap[calendars[i].ID]
+		if calendars[i].Tags == nil {
+			calendars[i].Tags = []string{}
+		}
 	}
 
 	return calendars, nil
@@ -119,6 +203,85 @@ func (r *MysqlRepository) GetRefreshToken(ctx context.Context, calendarID valueo
 	return *calendar.RefreshToken, nil
 }
 
+func (r *MysqlRepository) GetAuthType(ctx context.Context, calendarID valueobject.CalendarID) (valueobject.AuthType, error) {
+	if authType, ok := authTypeCache.Get(calendarID); ok {
+		return authType, nil
+	}
+
+	r.logger.Debug(ctx, "get auth type from database")
+
+	calendar, err := r.GetCalendar(ctx, calendarID)
+	if err != nil {
+		return "", fmt.Errorf("fail to get calendar: %w", err)
+	}
+
+	return calendar.AuthType, nil
+}
+
+func (r *MysqlRepository) GetSubject(ctx context.Context, calendarID valueobject.CalendarID) (string, error) {
+	if subject, ok := subjectCache.Get(calendarID); ok {
+		return subject, nil
+	}
+
+	r.logger.Debug(ctx, "get subject from database")
+
+	calendar, err := r.GetCalendar(ctx, calendarID)
+	if err != nil {
+		return "", fmt.Errorf("fail to get calendar: %w", err)
+	}
+
+	if calendar.Subject == nil {
+		return "", errors.New("calendar does not have subject")
+	}
+
+	return *calendar.Subject, nil
+}
+
+func (r *MysqlRepository) GetConsecutiveSyncFailureCount(ctx context.Context, calendarID valueobject.CalendarID) (int, error) {
+
+	var count int
+
+	err := r.db.QueryRowContext(
+		ctx,
+		"SELECT consecutive_sync_failure_count FROM calendars WHERE id = ?",
+		calendarID,
+	).Scan(&count)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, domain.CalendarNotFoundError
+		}
+		return 0, fmt.Errorf("fail to select consecutive sync failure count: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetFeedTokenHash returns nil if the feed of the calendar is not published.
+func (r *MysqlRepository) GetFeedTokenHash(ctx context.Context, calendarID valueobject.CalendarID) (*string, error) {
+
+	var hash sql.NullString
+
+	err := r.db.QueryRowContext(
+		ctx,
+		"SELECT feed_token_hash FROM calendars WHERE id = ?",
+		calendarID,
+	).Scan(&hash)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.CalendarNotFoundError
+		}
+		return nil, fmt.Errorf("fail to select feed token hash: %w", err)
+	}
+
+	if !hash.Valid {
+		return nil, nil
+	}
+
+	return &hash.String, nil
+}
+
 func (tx *mysqlTransaction) LockCalendar(ctx context.Context, calendarID valueobject.CalendarID) error {
 
 	_, err := tx.tx.ExecContext(ctx,
@@ -131,14 +294,47 @@ func (tx *mysqlTransaction) LockCalendar(ctx context.Context, calendarID valueob
 	return nil
 }
 
+// GetCalendarWithLock returns the calendar without its tags, and locks it until the end of the transaction.
+func (tx *mysqlTransaction) GetCalendarWithLock(ctx context.Context, calendarID valueobject.CalendarID) (*entity.Calendar, error) {
+	return getCalendar(ctx, tx.tx, tx.cryptService, calendarID, true)
+}
+
+func (tx *mysqlTransaction) IncrementConsecutiveSyncFailureCount(ctx context.Context, calendarID valueobject.CalendarID) error {
+
+	_, err := tx.tx.ExecContext(ctx,
+		"UPDATE calendars SET consecutive_sync_failure_count = consecutive_sync_failure_count + 1 WHERE id = ?",
+		calendarID)
+	if err != nil {
+		return fmt.Errorf("fail to increment consecutive sync failure count: %w", err)
+	}
+
+	return nil
+}
+
+func (tx *mysqlTransaction) ResetConsecutiveSyncFailureCount(ctx context.Context, calendarID valueobject.CalendarID) error {
+
+	_, err := tx.tx.ExecContext(ctx,
+		"UPDATE calendars SET consecutive_sync_failure_count = 0 WHERE id = ?",
+		calendarID)
+	if err != nil {
+		return fmt.Errorf("fail to reset consecutive sync failure count: %w", err)
+	}
+
+	return nil
+}
+
 func (r *MysqlRepository) CreateCalendar(ctx context.Context, t *testing.T, calendar entity.Calendar) error {
 	t.Helper()
 
-	err := createCalendar(ctx, r.db, calendar.ID, calendar.Name, calendar.RefreshToken)
+	err := createCalendar(ctx, r.db, calendar, calendar.RefreshToken)
 	if err != nil {
 		return fmt.Errorf("fail to create calendar: %w", err)
 	}
 
+	if err := createCalendarTags(ctx, r.db, calendar.ID, calendar.Tags); err != nil {
+		return fmt.Errorf("fail to create calendar tags: %w", err)
+	}
+
 	return nil
 }
 
@@ -153,25 +349,181 @@ func (tx *mysqlTransaction) CreateCalendar(ctx context.Context, calendar entity.
 		refreshToken = &encrypted
 	}
 
-	err := createCalendar(ctx, tx.tx, calendar.ID, calendar.Name, refreshToken)
+	err := createCalendar(ctx, tx.tx, calendar, refreshToken)
 	if err != nil {
 		return fmt.Errorf("fail to create calendar: %w", err)
 	}
 
-	if calendar.RefreshToken != nil {
-		refreshTokenCache.Set(calendar.ID, *calendar.RefreshToken)
+	if err := createCalendarTags(ctx, tx.tx, calendar.ID, calendar.Tags); err != nil {
+		return fmt.Errorf("fail to create calendar tags: %w", err)
+	}
+
+	tx.onCommit(func() {
+		if calendar.RefreshToken != nil {
+			refreshTokenCache.Set(calendar.ID, *calendar.RefreshToken)
+		}
+		authTypeCache.Set(calendar.ID, authTypeOf(calendar))
+		if calendar.Subject != nil {
+			subjectCache.Set(calendar.ID, *calendar.Subject)
+		}
+	})
+
+	return nil
+}
+
+func (tx *mysqlTransaction) UpdateCalendar(ctx context.Context, calendar entity.Calendar) error {
+
+	refreshToken := calendar.RefreshToken
+	if tx.cryptService != nil && refreshToken != nil {
+		encrypted, err := tx.cryptService.Encrypt(*refreshToken)
+		if err != nil {
+			return fmt.Errorf("fail to encrypt refresh token: %w", err)
+		}
+		refreshToken = &encrypted
+	}
+
+	_, err := tx.tx.ExecContext(ctx,
+		"UPDATE calendars SET name = ?, refresh_token = ?, subject = ?, auth_type = ?, summary = ?, time_zone = ?, "+
+			"access_role = ?, needs_reauth_at = ? WHERE id = ?",
+		calendar.Name, refreshToken, calendar.Subject, authTypeOf(calendar), calendar.Summary, calendar.TimeZone, calendar.AccessRole,
+		calendar.NeedsReauthAt, calendar.ID)
+	if err != nil {
+		return fmt.Errorf("fail to update calendar: %w", err)
+	}
+
+	tx.onCommit(func() {
+		if calendar.RefreshToken != nil {
+			refreshTokenCache.Set(calendar.ID, *calendar.RefreshToken)
+		} else {
+			refreshTokenCache.Delete(calendar.ID)
+		}
+		authTypeCache.Set(calendar.ID, authTypeOf(calendar))
+		if calendar.Subject != nil {
+			subjectCache.Set(calendar.ID, *calendar.Subject)
+		} else {
+			subjectCache.Delete(calendar.ID)
+		}
+	})
+
+	return nil
+}
+
+func (tx *mysqlTransaction) UpdateCalendarPaused(ctx context.Context, calendarID valueobject.CalendarID, isPaused bool) error {
+
+	_, err := tx.tx.ExecContext(ctx,
+		"UPDATE calendars SET is_paused = ? WHERE id = ?",
+		isPaused, calendarID)
+	if err != nil {
+		return fmt.Errorf("fail to update calendar paused: %w", err)
+	}
+
+	return nil
+}
+
+func (tx *mysqlTransaction) UpdateFeedTokenHash(ctx context.Context, calendarID valueobject.CalendarID, hash *string) error {
+
+	_, err := tx.tx.ExecContext(ctx,
+		"UPDATE calendars SET feed_token_hash = ? WHERE id = ?",
+		hash, calendarID)
+	if err != nil {
+		return fmt.Errorf("fail to update feed token hash: %w", err)
 	}
 
 	return nil
 }
 
+func (tx *mysqlTransaction) MarkCalendarNeedsReauth(ctx context.Context, calendarID valueobject.CalendarID) error {
+
+	// 既に記録されている場合は、最初に検知した日時を維持する
+	_, err := tx.tx.ExecContext(ctx,
+		"UPDATE calendars SET needs_reauth_at = COALESCE(needs_reauth_at, ?) WHERE id = ?",
+		tx.clockService.Now(), calendarID)
+	if err != nil {
+		return fmt.Errorf("fail to mark calendar needs reauth: %w", err)
+	}
+
+	return nil
+}
+
+func (tx *mysqlTransaction) ReencryptRefreshTokens(ctx context.Context) (int, error) {
+
+	if tx.cryptService == nil {
+		return 0, errors.New("crypt service is not configured")
+	}
+
+	rows, err := tx.tx.QueryContext(ctx,
+		"SELECT id, refresh_token FROM calendars WHERE refresh_token IS NOT NULL FOR UPDATE")
+	if err != nil {
+		return 0, fmt.Errorf("fail to select refresh tokens: %w", err)
+	}
+
+	encryptedTokens := map[valueobject.CalendarID]string{}
+	for rows.Next() {
+		var calendarID valueobject.CalendarID
+		var refreshToken string
+		if err := rows.Scan(&calendarID, &refreshToken); err != nil {
+			_ = rows.Close()
+			return 0, fmt.Errorf("fail to scan refresh token: %w", err)
+		}
+		encryptedTokens[calendarID] = refreshToken
+	}
+	if err := rows.Close(); err != nil {
+		return 0, fmt.Errorf("fail to close rows: %w", err)
+	}
+
+	// 取得中の行を閉じてから更新する
+	for calendarID, encrypted := range encryptedTokens {
+		decrypted, err := tx.cryptService.Decrypt(encrypted)
+		if err != nil {
+			return 0, fmt.Errorf("fail to decrypt refresh token (calendarID: %q): %w", calendarID, err)
+		}
+
+		reencrypted, err := tx.cryptService.Encrypt(decrypted)
+		if err != nil {
+			return 0, fmt.Errorf("fail to encrypt refresh token (calendarID: %q): %w", calendarID, err)
+		}
+
+		_, err = tx.tx.ExecContext(ctx,
+			"UPDATE calendars SET refresh_token = ? WHERE id = ?",
+			reencrypted, calendarID)
+		if err != nil {
+			return 0, fmt.Errorf("fail to update refresh token (calendarID: %q): %w", calendarID, err)
+		}
+	}
+
+	return len(encryptedTokens), nil
+}
+
+func (tx *mysqlTransaction) DeleteCalendar(ctx context.Context, calendarID valueobject.CalendarID) error {
+
+	_, err := tx.tx.ExecContext(ctx,
+		"DELETE FROM calendars WHERE id = ?",
+		calendarID)
+	if err != nil {
+		return fmt.Errorf("fail to delete calendar: %w", err)
+	}
+
+	tx.onCommit(func() {
+		refreshTokenCache.Delete(calendarID)
+		authTypeCache.Delete(calendarID)
+		subjectCache.Delete(calendarID)
+	})
+
+	return nil
+}
+
+// createCalendar inserts the calendar with refreshToken instead of calendar.RefreshToken,
+// so that the caller can pass the encrypted one.
 func createCalendar(ctx context.Context, db database,
-	calendarID valueobject.CalendarID, name string, refreshToken *string) error {
+	calendar entity.Calendar, refreshToken *string) error {
 
 	_, err := db.ExecContext(
 		ctx,
-		"INSERT INTO calendars (id, name, refresh_token) VALUES (?, ?, ?)",
-		calendarID, name, refreshToken,
+		"INSERT INTO calendars "+
+			"(id, name, refresh_token, subject, auth_type, summary, time_zone, access_role, is_paused, needs_reauth_at) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		calendar.ID, calendar.Name, refreshToken, calendar.Subject, authTypeOf(calendar), calendar.Summary, calendar.TimeZone, calendar.AccessRole,
+		calendar.IsPaused, calendar.NeedsReauthAt,
 	)
 
 	if err != nil {
@@ -185,6 +537,15 @@ func createCalendar(ctx context.Context, db database,
 	return nil
 }
 
+// authTypeOf returns the auth type of the calendar,
+// or the one derived from the refresh token and the subject if it is not set.
+func authTypeOf(calendar entity.Calendar) valueobject.AuthType {
+	if calendar.AuthType != "" {
+		return calendar.AuthType
+	}
+	return calendar.Credential().AuthType()
+}
+
 func (r *MysqlRepository) DeleteAllCalendarsForMain(ctx context.Context, m *testing.M) (updatedCount int, err error) {
 	updatedCount, err = r.deleteAllCalendars(ctx)
 	if err != nil {
@@ -0,0 +1,147 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+)
+
+func (r *MysqlRepository) listCalendarTags(ctx context.Context, calendarID valueobject.CalendarID) ([]string, error) {
+	rows, err := r.db.QueryContext(
+		ctx,
+		"SELECT tag FROM calendar_tags WHERE calendar_id = ? ORDER BY tag",
+		calendarID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("fail to select calendar tags: %w", err)
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			r.logger.Errorf(ctx, "fail to close rows: %s", closeErr)
+		}
+	}()
+
+	tags := []string{}
+	for rows.Next() {
+		var tag string
+		if err := rows.Scan(&tag); err != nil {
+			return nil, fmt.Errorf("fail to scan calendar tag: %w", err)
+		}
+		tags = append(tags, tag)
+	}
+
+	return tags, nil
+}
+
+func (r *MysqlRepository) listAllCalendarTags(ctx context.Context) (map[valueobject.CalendarID][]string, error) {
+	rows, err := r.db.QueryContext(
+		ctx,
+		"SELECT calendar_id, tag FROM calendar_tags ORDER BY calendar_id, tag",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("fail to select calendar tags: %w", err)
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			r.logger.Errorf(ctx, "fail to close rows: %s", closeErr)
+		}
+	}()
+
+	tagMap := map[valueobject.CalendarID][]string{}
+	for rows.Next() {
+		var calendarID valueobject.CalendarID
+		var tag string
+		if err := rows.Scan(&calendarID, &tag); err != nil {
+			return nil, fmt.Errorf("fail to scan calendar tag: %w", err)
+		}
+		tagMap[calendarID] = append(tagMap[calendarID], tag)
+	}
+
+	return tagMap, nil
+}
+
+func (tx *mysqlTransaction) ReplaceCalendarTags(ctx context.Context, calendarID valueobject.CalendarID, tags []string) error {
+
+	if err := tx.DeleteCalendarTags(ctx, calendarID); err != nil {
+		return fmt.Errorf("fail to delete calendar tags: %w", err)
+	}
+
+	if err := createCalendarTags(ctx, tx.tx, calendarID, tags); err != nil {
+		return fmt.Errorf("fail to create calendar tags: %w", err)
+	}
+
+	return nil
+}
+
+func (tx *mysqlTransaction) DeleteCalendarTags(ctx context.Context, calendarID valueobject.CalendarID) error {
+
+	_, err := tx.tx.ExecContext(ctx,
+		"DELETE FROM calendar_tags WHERE calendar_id = ?",
+		calendarID)
+	if err != nil {
+		return fmt.Errorf("fail to delete calendar tags: %w", err)
+	}
+
+	return nil
+}
+
+func createCalendarTags(ctx context.Context, db database, calendarID valueobject.CalendarID, tags []string) error {
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, 0, len(tags))
+	args := make([]any, 0, len(tags)*2)
+	for _, tag := range tags {
+		placeholders = append(placeholders, "(?, ?)")
+		args = append(args, calendarID, tag)
+	}
+
+	_, err := db.ExecContext(ctx,
+		"INSERT INTO calendar_tags (calendar_id, tag) VALUES "+strings.Join(placeholders, ", "),
+		args...)
+	if err != nil {
+		return fmt.Errorf("fail to insert calendar tags: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MysqlRepository) DeleteAllCalendarTagsForMain(ctx context.Context, m *testing.M) (updatedCount int, err error) {
+	updatedCount, err = r.deleteAllCalendarTags(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("fail to delete all calendar tags: %w", err)
+	}
+
+	return updatedCount, nil
+}
+
+func (r *MysqlRepository) DeleteAllCalendarTags(ctx context.Context, t *testing.T) (updatedCount int, err error) {
+	t.Helper()
+
+	updatedCount, err = r.deleteAllCalendarTags(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("fail to delete all calendar tags: %w", err)
+	}
+
+	return updatedCount, nil
+}
+
+func (r *MysqlRepository) deleteAllCalendarTags(ctx context.Context) (updatedCount int, err error) {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM calendar_tags")
+	if err != nil {
+		return 0, fmt.Errorf("fail to delete calendar tags: %w", err)
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("fail to get affected rows: %w", err)
+	}
+	updatedCount = int(affectedRows)
+
+	return updatedCount, nil
+}
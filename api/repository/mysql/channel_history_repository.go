@@ -2,6 +2,7 @@ package mysql
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"testing"
 	"time"
@@ -52,6 +53,31 @@ func (r *MysqlRepository) GetChannelHistory(ctx context.Context, t *testing.T,
 	return &channel, nil
 }
 
+func (r *MysqlRepository) GetActiveChannelHistory(
+	ctx context.Context, calendarID valueobject.CalendarID) (*entity.Channel, error) {
+
+	var channel entity.Channel
+
+	err := r.db.QueryRowContext(
+		ctx,
+		"SELECT calendar_id, start_time, resource_id, expiration, is_stopped "+
+			"FROM channel_histories "+
+			"WHERE calendar_id = ? AND expiration > ? AND is_stopped = FALSE "+
+			"ORDER BY start_time DESC LIMIT 1",
+		calendarID, r.clockService.Now(),
+	).Scan(&channel.CalendarID, &channel.StartTime, &channel.ResourceID,
+		&channel.Expiration, &channel.IsStopped)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("fail to select active channel history: %w", err)
+	}
+
+	return &channel, nil
+}
+
 func (tx *mysqlTransaction) ListActiveChannelHistoriesWithLock(
 	ctx context.Context, calendarID valueobject.CalendarID) ([]entity.Channel, error) {
 
@@ -139,6 +165,18 @@ func (tx *mysqlTransaction) StopActiveChannels(
 	return nil
 }
 
+func (tx *mysqlTransaction) DeleteChannelHistories(ctx context.Context, calendarID valueobject.CalendarID) error {
+
+	_, err := tx.tx.ExecContext(ctx,
+		"DELETE FROM channel_histories WHERE calendar_id = ?",
+		calendarID)
+	if err != nil {
+		return fmt.Errorf("fail to delete channel histories: %w", err)
+	}
+
+	return nil
+}
+
 func (r *MysqlRepository) DeleteAllChannelHistoriesForMain(ctx context.Context, m *testing.M) (updatedCount int, err error) {
 	updatedCount, err = r.deleteAllChannelHistories(ctx)
 	if err != nil {
@@ -48,6 +48,277 @@ func (r *MysqlRepository) ListEvents(ctx context.Context, t *testing.T,
 	return events, nil
 }
 
+// ListEventsWithFilter returns events in ascending order of start time and ID.
+// Events without start time come first. If after is specified, only events after the cursor are returned.
+func (r *MysqlRepository) ListEventsWithFilter(ctx context.Context, calendarID valueobject.CalendarID,
+	filter entity.EventFilter, after *entity.EventCursor, limit int) ([]entity.Event, error) {
+
+	condition, args := eventFilterCondition(filter)
+	query := "SELECT " + eventColumns + " FROM events WHERE calendar_id = ?" + condition
+	args = append([]any{calendarID}, args...)
+
+	// NULL は先頭に並ぶため、カーソルの開始日時が NULL かどうかで条件を分ける
+	if after != nil {
+		if after.Start == nil {
+			query += " AND (start IS NOT NULL OR id > ?)"
+			args = append(args, after.ID)
+		} else {
+			query += " AND (start > ? OR (start = ? AND id > ?))"
+			args = append(args, *after.Start, *after.Start, after.ID)
+		}
+	}
+
+	query += " ORDER BY start, id LIMIT ?"
+	args = append(args, limit)
+
+	events, err := r.selectEvents(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("fail to select events: %w", err)
+	}
+
+	return events, nil
+}
+
+// ListEventInstances returns the instances of the recurring events in ascending order of start time and ID.
+// RecurringEventID of the filter is ignored.
+func (r *MysqlRepository) ListEventInstances(ctx context.Context, calendarID valueobject.CalendarID,
+	recurringEventIDs []valueobject.EventID, filter entity.EventFilter) ([]entity.Event, error) {
+
+	if len(recurringEventIDs) == 0 {
+		return []entity.Event{}, nil
+	}
+
+	filter.RecurringEventID = nil
+	condition, conditionArgs := eventFilterCondition(filter)
+
+	placeholders := make([]string, 0, len(recurringEventIDs))
+	args := make([]any, 0, len(recurringEventIDs)+len(conditionArgs)+1)
+	args = append(args, calendarID)
+	for _, id := range recurringEventIDs {
+		placeholders = append(placeholders, "?")
+		args = append(args, id)
+	}
+	args = append(args, conditionArgs...)
+
+	query := "SELECT " + eventColumns + " FROM events " +
+		"WHERE calendar_id = ? AND recurring_event_id IN (" + strings.Join(placeholders, ", ") + ")" + condition +
+		" ORDER BY start, id"
+
+	events, err := r.selectEvents(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("fail to select event instances: %w", err)
+	}
+
+	return events, nil
+}
+
+// ListBusyEvents returns the events blocking time in the period [from, to) of the calendars,
+// in ascending order of calendar ID and start time. Cancelled and transparent events are excluded.
+func (r *MysqlRepository) ListBusyEvents(ctx context.Context,
+	calendarIDs []valueobject.CalendarID, from, to time.Time) ([]entity.Event, error) {
+
+	if len(calendarIDs) == 0 {
+		return []entity.Event{}, nil
+	}
+
+	placeholders := make([]string, 0, len(calendarIDs))
+	args := make([]any, 0, len(calendarIDs)+4)
+	for _, id := range calendarIDs {
+		placeholders = append(placeholders, "?")
+		args = append(args, id)
+	}
+	args = append(args, constant.EventStatusCancelled, constant.EventTransparencyTransparent, to, from)
+
+	query := "SELECT " + eventColumns + " FROM events " +
+		"WHERE calendar_id IN (" + strings.Join(placeholders, ", ") + ") " +
+		"AND status <> ? AND transparency <> ? AND start < ? AND end > ? " +
+		"ORDER BY calendar_id, start, id"
+
+	events, err := r.selectEvents(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("fail to select busy events: %w", err)
+	}
+
+	return events, nil
+}
+
+const eventColumns = "calendar_id, id, recurring_event_id, summary, description, location, start, end, original_start, " +
+	"transparency, all_day, ical_uid, status"
+
+// eventFilterCondition returns the conditions of the filter joined with AND, to be appended to a WHERE clause.
+func eventFilterCondition(filter entity.EventFilter) (string, []any) {
+
+	var condition string
+	var args []any
+
+	// 期間と重なるイベントを対象とする
+	// キャンセルされたインスタンスは開始日時と終了日時を持たないため、元の開始日時で判定する
+	if filter.From != nil {
+		condition += " AND (end > ? OR (end IS NULL AND (original_start IS NULL OR original_start >= ?)))"
+		args = append(args, *filter.From, *filter.From)
+	}
+	if filter.To != nil {
+		condition += " AND (start < ? OR (start IS NULL AND (original_start IS NULL OR original_start < ?)))"
+		args = append(args, *filter.To, *filter.To)
+	}
+	if filter.Status != nil {
+		condition += " AND status = ?"
+		args = append(args, *filter.Status)
+	} else if !filter.IncludeCancelled {
+		condition += " AND status <> ?"
+		args = append(args, constant.EventStatusCancelled)
+	}
+	if filter.RecurringEventID != nil {
+		condition += " AND recurring_event_id = ?"
+		args = append(args, *filter.RecurringEventID)
+	}
+	if filter.Summary != nil {
+		condition += " AND summary LIKE ?"
+		args = append(args, "%"+escapeLike(*filter.Summary)+"%")
+	}
+
+	return condition, args
+}
+
+// eventScanDest returns the destinations to scan eventColumns into the event.
+func eventScanDest(event *entity.Event) []any {
+	return []any{&event.CalendarID, &event.ID, &event.RecurringEventID, &event.Summary, &event.Description, &event.Location,
+		&event.Start, &event.End, &event.OriginalStart, &event.Transparency, &event.AllDay, &event.ICalUID, &event.Status}
+}
+
+// selectEvents runs the query selecting eventColumns.
+func (r *MysqlRepository) selectEvents(ctx context.Context, query string, args ...any) ([]entity.Event, error) {
+
+	events := []entity.Event{}
+	err := r.forEachEvent(ctx, query, args, func(event entity.Event) error {
+		events = append(events, event)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// forEachEvent runs the query selecting eventColumns, and calls fn for each row while reading the rows.
+func (r *MysqlRepository) forEachEvent(ctx context.Context, query string, args []any, fn func(entity.Event) error) error {
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			r.logger.Errorf(ctx, "fail to close rows: %s", closeErr)
+		}
+	}()
+
+	for rows.Next() {
+		var event entity.Event
+		if err := rows.Scan(eventScanDest(&event)...); err != nil {
+			return fmt.Errorf("fail to scan row: %w", err)
+		}
+
+		if err := fn(event); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// ForEachEvent calls fn for each event in ascending order of calendar ID, start time and ID.
+// All calendars are targeted if calendarID is nil. Rows are read from the cursor one by one, so that they are not loaded into memory.
+func (r *MysqlRepository) ForEachEvent(ctx context.Context, calendarID *valueobject.CalendarID, filter entity.EventFilter,
+	fn func(entity.Event) error) error {
+
+	condition, args := eventFilterCondition(filter)
+	if calendarID != nil {
+		condition = " AND calendar_id = ?" + condition
+		args = append([]any{*calendarID}, args...)
+	}
+
+	query := "SELECT " + eventColumns + " FROM events"
+	if condition != "" {
+		query += " WHERE" + strings.TrimPrefix(condition, " AND")
+	}
+	query += " ORDER BY calendar_id, start, id"
+
+	if err := r.forEachEvent(ctx, query, args, fn); err != nil {
+		return fmt.Errorf("fail to select events: %w", err)
+	}
+
+	return nil
+}
+
+// SearchEvents returns the events containing all the terms in summary, description or location,
+// in descending order of relevance, using the FULLTEXT index with the ngram parser.
+func (r *MysqlRepository) SearchEvents(ctx context.Context, query entity.EventSearchQuery, offset, limit int) (
+	[]entity.EventSearchResult, error) {
+
+	if len(query.Terms) == 0 {
+		return []entity.EventSearchResult{}, nil
+	}
+
+	condition, conditionArgs := eventFilterCondition(query.Filter)
+	if query.CalendarID != nil {
+		condition = " AND calendar_id = ?" + condition
+		conditionArgs = append([]any{*query.CalendarID}, conditionArgs...)
+	}
+
+	// 同一の MATCH 式は一度だけ評価される
+	match := "MATCH(summary, description, location) AGAINST(? IN BOOLEAN MODE)"
+	text := booleanModeText(query.Terms)
+
+	args := make([]any, 0, len(conditionArgs)+4)
+	args = append(args, text, text)
+	args = append(args, conditionArgs...)
+	args = append(args, limit, offset)
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+eventColumns+", "+match+" AS score FROM events "+
+			"WHERE "+match+condition+" "+
+			"ORDER BY score DESC, calendar_id, start, id LIMIT ? OFFSET ?",
+		args...)
+	if err != nil {
+		return nil, fmt.Errorf("fail to search events: %w", err)
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			r.logger.Errorf(ctx, "fail to close rows: %s", closeErr)
+		}
+	}()
+
+	results := []entity.EventSearchResult{}
+	for rows.Next() {
+		var result entity.EventSearchResult
+		if err := rows.Scan(append(eventScanDest(&result.Event), &result.Score)...); err != nil {
+			return nil, fmt.Errorf("fail to scan row: %w", err)
+		}
+		results = append(results, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("fail to read events: %w", err)
+	}
+
+	return results, nil
+}
+
+// booleanModeText returns the search text of boolean mode requiring all the terms.
+// Each term is quoted, so that the operators in it are not interpreted and it is searched as a phrase of ngrams.
+func booleanModeText(terms []string) string {
+
+	phrases := make([]string, 0, len(terms))
+	for _, term := range terms {
+		// 二重引用符はフレーズの終端と解釈されるため除く
+		term = strings.ReplaceAll(term, `"`, " ")
+		phrases = append(phrases, `+"`+term+`"`)
+	}
+
+	return strings.Join(phrases, " ")
+}
+
 func (r *MysqlRepository) CreateEvent(ctx context.Context, t *testing.T,
 	event entity.Event) error {
 	t.Helper()
@@ -63,9 +334,11 @@ func (r *MysqlRepository) CreateEvent(ctx context.Context, t *testing.T,
 func createEvent(ctx context.Context, db database, event entity.Event) error {
 	_, err := db.ExecContext(ctx,
 		"INSERT INTO events "+
-			"(calendar_id, id, recurring_event_id, summary, start, end, status) "+
-			"VALUES (?, ?, ?, ?, ?, ?, ?)",
-		event.CalendarID, event.ID, event.RecurringEventID, event.Summary, event.Start, event.End, event.Status)
+			"(calendar_id, id, recurring_event_id, summary, description, location, start, end, original_start, "+
+			"transparency, all_day, ical_uid, status) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		event.CalendarID, event.ID, event.RecurringEventID, event.Summary, event.Description, event.Location,
+		event.Start, event.End, event.OriginalStart, event.Transparency, event.AllDay, event.ICalUID, event.Status)
 	if err != nil {
 		return fmt.Errorf("fail to insert event: %w", err)
 	}
@@ -142,7 +415,8 @@ func (tx *mysqlTransaction) fetchEventMap(ctx context.Context,
 	}
 
 	query := fmt.Sprintf(
-		"SELECT id, calendar_id, recurring_event_id, summary, start, end, status "+
+		"SELECT id, calendar_id, recurring_event_id, summary, description, location, start, end, original_start, "+
+			"transparency, all_day, ical_uid, status "+
 			"FROM events WHERE calendar_id = ? AND id IN (%s)",
 		strings.Join(placeholders, ", "),
 	)
@@ -165,8 +439,14 @@ func (tx *mysqlTransaction) fetchEventMap(ctx context.Context,
 			&event.CalendarID,
 			&event.RecurringEventID,
 			&event.Summary,
+			&event.Description,
+			&event.Location,
 			&event.Start,
 			&event.End,
+			&event.OriginalStart,
+			&event.Transparency,
+			&event.AllDay,
+			&event.ICalUID,
 			&event.Status,
 		)
 		if err != nil {
@@ -180,9 +460,12 @@ func (tx *mysqlTransaction) fetchEventMap(ctx context.Context,
 
 func (tx *mysqlTransaction) updateEvent(ctx context.Context, event entity.Event) (updatedCount int, err error) {
 	result, err := tx.tx.ExecContext(ctx,
-		"UPDATE events SET recurring_event_id = ?, summary = ?, start = ?, end = ?, status = ? "+
+		"UPDATE events SET recurring_event_id = ?, summary = ?, description = ?, location = ?, "+
+			"start = ?, end = ?, original_start = ?, transparency = ?, all_day = ?, ical_uid = ?, status = ? "+
 			"WHERE calendar_id = ? AND id = ?",
-		event.RecurringEventID, event.Summary, event.Start, event.End, event.Status, event.CalendarID, event.ID)
+		event.RecurringEventID, event.Summary, event.Description, event.Location,
+		event.Start, event.End, event.OriginalStart, event.Transparency, event.AllDay, event.ICalUID, event.Status,
+		event.CalendarID, event.ID)
 	if err != nil {
 		return 0, fmt.Errorf("fail to update event: %w", err)
 	}
@@ -237,6 +520,18 @@ func (tx *mysqlTransaction) cancelEventInstancesWithAfter(ctx context.Context,
 	return updatedCount, nil
 }
 
+func (tx *mysqlTransaction) DeleteEvents(ctx context.Context, calendarID valueobject.CalendarID) error {
+
+	_, err := tx.tx.ExecContext(ctx,
+		"DELETE FROM events WHERE calendar_id = ?",
+		calendarID)
+	if err != nil {
+		return fmt.Errorf("fail to delete events: %w", err)
+	}
+
+	return nil
+}
+
 func (r *MysqlRepository) DeleteAllEventsForMain(ctx context.Context, m *testing.M) (updatedCount int, err error) {
 	updatedCount, err = r.deleteAllEvents(ctx)
 	if err != nil {
@@ -0,0 +1,137 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/takuoki/google-calendar-sync/api/domain/entity"
+)
+
+func (r *MysqlRepository) AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (bool, error) {
+
+	// インスタンス間の時刻のずれで有効なリースを奪わないよう、有効期限は DB の時刻で判定する
+	ttlMicroseconds := ttl.Microseconds()
+
+	// 自身が保持しているリース、または有効期限が切れたリースのみ更新する
+	_, err := r.db.ExecContext(
+		ctx,
+		"UPDATE leases SET holder = ?, expiration = NOW(3) + INTERVAL ? MICROSECOND "+
+			"WHERE name = ? AND (holder = ? OR expiration <= NOW(3))",
+		holder, ttlMicroseconds, name, holder)
+	if err != nil {
+		return false, fmt.Errorf("fail to update lease: %w", err)
+	}
+
+	// リースが存在しない場合は作成する
+	// 複数インスタンスから同時に作成された場合も、いずれか一方のみが成功する
+	_, err = r.db.ExecContext(
+		ctx,
+		"INSERT IGNORE INTO leases (name, holder, expiration) VALUES (?, ?, NOW(3) + INTERVAL ? MICROSECOND)",
+		name, holder, ttlMicroseconds)
+	if err != nil {
+		return false, fmt.Errorf("fail to insert lease: %w", err)
+	}
+
+	lease, err := getLease(ctx, r.db, name)
+	if err != nil {
+		return false, fmt.Errorf("fail to get lease: %w", err)
+	}
+
+	return lease != nil && lease.Holder == holder, nil
+}
+
+func (r *MysqlRepository) ReleaseLease(ctx context.Context, name, holder string) error {
+
+	_, err := r.db.ExecContext(
+		ctx,
+		"DELETE FROM leases WHERE name = ? AND holder = ?",
+		name, holder)
+	if err != nil {
+		return fmt.Errorf("fail to delete lease: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MysqlRepository) GetLease(ctx context.Context, t *testing.T, name string) (*entity.Lease, error) {
+	t.Helper()
+
+	lease, err := getLease(ctx, r.db, name)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get lease: %w", err)
+	}
+
+	return lease, nil
+}
+
+func getLease(ctx context.Context, db database, name string) (*entity.Lease, error) {
+
+	var lease entity.Lease
+
+	err := db.QueryRowContext(
+		ctx,
+		"SELECT name, holder, expiration FROM leases WHERE name = ?",
+		name,
+	).Scan(&lease.Name, &lease.Holder, &lease.Expiration)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("fail to select lease: %w", err)
+	}
+
+	return &lease, nil
+}
+
+func (r *MysqlRepository) CreateLease(ctx context.Context, t *testing.T, lease entity.Lease) error {
+	t.Helper()
+
+	_, err := r.db.ExecContext(
+		ctx,
+		"INSERT INTO leases (name, holder, expiration) VALUES (?, ?, ?)",
+		lease.Name, lease.Holder, lease.Expiration)
+	if err != nil {
+		return fmt.Errorf("fail to insert lease: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MysqlRepository) DeleteAllLeasesForMain(ctx context.Context, m *testing.M) (updatedCount int, err error) {
+	updatedCount, err = r.deleteAllLeases(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("fail to delete all leases: %w", err)
+	}
+
+	return updatedCount, nil
+}
+
+func (r *MysqlRepository) DeleteAllLeases(ctx context.Context, t *testing.T) (updatedCount int, err error) {
+	t.Helper()
+
+	updatedCount, err = r.deleteAllLeases(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("fail to delete all leases: %w", err)
+	}
+
+	return updatedCount, nil
+}
+
+func (r *MysqlRepository) deleteAllLeases(ctx context.Context) (updatedCount int, err error) {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM leases")
+	if err != nil {
+		return 0, fmt.Errorf("fail to delete all leases: %w", err)
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("fail to get affected rows: %w", err)
+	}
+	updatedCount = int(affectedRows)
+
+	return updatedCount, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/takuoki/golib/applog"
@@ -78,6 +79,13 @@ func (r *MysqlRepository) RunTransaction(ctx context.Context, fn func(ctx contex
 		return fmt.Errorf("fail to begin transaction: %w", err)
 	}
 
+	mtx := &mysqlTransaction{
+		tx:           tx,
+		clockService: r.clockService,
+		cryptService: r.cryptService,
+		logger:       r.logger,
+	}
+
 	defer func() {
 		if p := recover(); p != nil {
 			if rerr := tx.Rollback(); rerr != nil {
@@ -94,16 +102,15 @@ func (r *MysqlRepository) RunTransaction(ctx context.Context, fn func(ctx contex
 		} else {
 			if cerr := tx.Commit(); cerr != nil {
 				er = fmt.Errorf("fail to commit: %w", cerr)
+				return
+			}
+			for _, f := range mtx.afterCommit {
+				f()
 			}
 		}
 	}()
 
-	return fn(ctx, &mysqlTransaction{
-		tx:           tx,
-		clockService: r.clockService,
-		cryptService: r.cryptService,
-		logger:       r.logger,
-	})
+	return fn(ctx, mtx)
 }
 
 type mysqlTransaction struct {
@@ -111,9 +118,23 @@ type mysqlTransaction struct {
 	clockService service.Clock
 	cryptService service.Crypt
 	logger       applog.Logger
+	// afterCommit holds the functions run after the transaction is committed, such as updating the caches,
+	// so that the values of a rolled back transaction are not left in them.
+	afterCommit []func()
+}
+
+func (tx *mysqlTransaction) onCommit(f func()) {
+	tx.afterCommit = append(tx.afterCommit, f)
 }
 
 func (r *MysqlRepository) Clock(t *testing.T) service.Clock {
 	t.Helper()
 	return r.clockService
 }
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// escapeLike escapes the wildcard characters of LIKE so that s is matched literally.
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
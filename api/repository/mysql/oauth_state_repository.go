@@ -0,0 +1,100 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/takuoki/google-calendar-sync/api/domain/entity"
+)
+
+func (r *MysqlRepository) CreateOAuthState(ctx context.Context, state entity.OAuthState) error {
+
+	_, err := r.db.ExecContext(
+		ctx,
+		"INSERT INTO oauth_states (state, code_verifier, calendar_id, name, expiration) VALUES (?, ?, ?, ?, ?)",
+		state.State, state.CodeVerifier, state.CalendarID, state.Name, state.Expiration)
+	if err != nil {
+		return fmt.Errorf("fail to insert oauth state: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MysqlRepository) DeleteExpiredOAuthStates(ctx context.Context, now time.Time) error {
+
+	_, err := r.db.ExecContext(ctx, "DELETE FROM oauth_states WHERE expiration <= ?", now)
+	if err != nil {
+		return fmt.Errorf("fail to delete expired oauth states: %w", err)
+	}
+
+	return nil
+}
+
+func (tx *mysqlTransaction) GetOAuthStateWithLock(ctx context.Context, state string) (*entity.OAuthState, error) {
+
+	var s entity.OAuthState
+
+	err := tx.tx.QueryRowContext(
+		ctx,
+		"SELECT state, code_verifier, calendar_id, name, expiration "+
+			"FROM oauth_states WHERE state = ? FOR UPDATE",
+		state,
+	).Scan(&s.State, &s.CodeVerifier, &s.CalendarID, &s.Name, &s.Expiration)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("fail to select oauth state: %w", err)
+	}
+
+	return &s, nil
+}
+
+func (tx *mysqlTransaction) DeleteOAuthState(ctx context.Context, state string) error {
+
+	_, err := tx.tx.ExecContext(ctx, "DELETE FROM oauth_states WHERE state = ?", state)
+	if err != nil {
+		return fmt.Errorf("fail to delete oauth state: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MysqlRepository) DeleteAllOAuthStatesForMain(ctx context.Context, m *testing.M) (updatedCount int, err error) {
+	updatedCount, err = r.deleteAllOAuthStates(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("fail to delete all oauth states: %w", err)
+	}
+
+	return updatedCount, nil
+}
+
+func (r *MysqlRepository) DeleteAllOAuthStates(ctx context.Context, t *testing.T) (updatedCount int, err error) {
+	t.Helper()
+
+	updatedCount, err = r.deleteAllOAuthStates(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("fail to delete all oauth states: %w", err)
+	}
+
+	return updatedCount, nil
+}
+
+func (r *MysqlRepository) deleteAllOAuthStates(ctx context.Context) (updatedCount int, err error) {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM oauth_states")
+	if err != nil {
+		return 0, fmt.Errorf("fail to delete all oauth states: %w", err)
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("fail to get affected rows: %w", err)
+	}
+	updatedCount = int(affectedRows)
+
+	return updatedCount, nil
+}
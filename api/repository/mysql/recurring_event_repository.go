@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/takuoki/google-calendar-sync/api/domain"
 	"github.com/takuoki/google-calendar-sync/api/domain/constant"
 	"github.com/takuoki/google-calendar-sync/api/domain/entity"
 	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
@@ -29,7 +30,7 @@ func (r *MysqlRepository) ListActiveRecurringEventsWithIDs(ctx context.Context,
 	args = append([]interface{}{calendarID}, args...)
 	args = append(args, constant.EventStatusCancelled)
 
-	query := "SELECT id, calendar_id, summary, recurrence, start, end, status " +
+	query := "SELECT id, calendar_id, summary, recurrence, start, end, ical_uid, status " +
 		"FROM recurring_events " +
 		"WHERE calendar_id = ? AND id IN (" + strings.Join(placeholders, ",") + ") AND status != ? " +
 		"ORDER BY id"
@@ -48,7 +49,7 @@ func (r *MysqlRepository) ListActiveRecurringEventsWithIDs(ctx context.Context,
 	for rows.Next() {
 		var recurringEvent entity.RecurringEvent
 		err := rows.Scan(&recurringEvent.ID, &recurringEvent.CalendarID, &recurringEvent.Summary,
-			&recurringEvent.Recurrence, &recurringEvent.Start, &recurringEvent.End, &recurringEvent.Status)
+			&recurringEvent.Recurrence, &recurringEvent.Start, &recurringEvent.End, &recurringEvent.ICalUID, &recurringEvent.Status)
 		if err != nil {
 			return nil, fmt.Errorf("fail to scan row: %w", err)
 		}
@@ -66,7 +67,7 @@ func (r *MysqlRepository) ListActiveRecurringEventsWithAfter(ctx context.Context
 	// 事前に UNTIL をカラムに保存しておく必要がある
 	rows, err := r.db.QueryContext(
 		ctx,
-		"SELECT id, calendar_id, summary, recurrence, start, end, status "+
+		"SELECT id, calendar_id, summary, recurrence, start, end, ical_uid, status "+
 			"FROM recurring_events "+
 			"WHERE calendar_id = ? AND status != ? "+
 			"ORDER BY id",
@@ -84,7 +85,7 @@ func (r *MysqlRepository) ListActiveRecurringEventsWithAfter(ctx context.Context
 	for rows.Next() {
 		var recurringEvent entity.RecurringEvent
 		err := rows.Scan(&recurringEvent.ID, &recurringEvent.CalendarID, &recurringEvent.Summary,
-			&recurringEvent.Recurrence, &recurringEvent.Start, &recurringEvent.End, &recurringEvent.Status)
+			&recurringEvent.Recurrence, &recurringEvent.Start, &recurringEvent.End, &recurringEvent.ICalUID, &recurringEvent.Status)
 		if err != nil {
 			return nil, fmt.Errorf("fail to scan row: %w", err)
 		}
@@ -95,6 +96,131 @@ func (r *MysqlRepository) ListActiveRecurringEventsWithAfter(ctx context.Context
 	return recurringEvents, nil
 }
 
+func (r *MysqlRepository) GetRecurringEvent(ctx context.Context,
+	calendarID valueobject.CalendarID, eventID valueobject.EventID) (*entity.RecurringEvent, error) {
+
+	var recurringEvent entity.RecurringEvent
+
+	err := r.db.QueryRowContext(
+		ctx,
+		"SELECT id, calendar_id, summary, recurrence, start, end, ical_uid, status "+
+			"FROM recurring_events "+
+			"WHERE calendar_id = ? AND id = ?",
+		calendarID, eventID,
+	).Scan(&recurringEvent.ID, &recurringEvent.CalendarID, &recurringEvent.Summary,
+		&recurringEvent.Recurrence, &recurringEvent.Start, &recurringEvent.End, &recurringEvent.ICalUID, &recurringEvent.Status)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.RecurringEventNotFoundError
+		}
+		return nil, fmt.Errorf("fail to select recurring event: %w", err)
+	}
+
+	return &recurringEvent, nil
+}
+
+// ListRecurringEvents returns recurring events in ascending order of ID.
+// If after is specified, only recurring events with an ID greater than it are returned.
+func (r *MysqlRepository) ListRecurringEvents(ctx context.Context, calendarID valueobject.CalendarID,
+	includeCancelled bool, after *valueobject.EventID, limit int) ([]entity.RecurringEvent, error) {
+
+	query := "SELECT id, calendar_id, summary, recurrence, start, end, ical_uid, status " +
+		"FROM recurring_events WHERE calendar_id = ?"
+	args := []any{calendarID}
+	if !includeCancelled {
+		query += " AND status != ?"
+		args = append(args, constant.EventStatusCancelled)
+	}
+	if after != nil {
+		query += " AND id > ?"
+		args = append(args, *after)
+	}
+	query += " ORDER BY id LIMIT ?"
+	args = append(args, limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("fail to select recurring events: %w", err)
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			r.logger.Errorf(ctx, "fail to close rows: %s", closeErr)
+		}
+	}()
+
+	var recurringEvents []entity.RecurringEvent
+	for rows.Next() {
+		var recurringEvent entity.RecurringEvent
+		err := rows.Scan(&recurringEvent.ID, &recurringEvent.CalendarID, &recurringEvent.Summary,
+			&recurringEvent.Recurrence, &recurringEvent.Start, &recurringEvent.End, &recurringEvent.ICalUID, &recurringEvent.Status)
+		if err != nil {
+			return nil, fmt.Errorf("fail to scan row: %w", err)
+		}
+
+		recurringEvents = append(recurringEvents, recurringEvent)
+	}
+
+	return recurringEvents, nil
+}
+
+// ForEachRecurringEvent calls fn for each recurring event in ascending order of calendar ID and ID.
+// All calendars are targeted if calendarID is nil. If to is specified, only recurring events starting before it are targeted.
+// Rows are read from the cursor one by one, so that they are not loaded into memory.
+func (r *MysqlRepository) ForEachRecurringEvent(ctx context.Context, calendarID *valueobject.CalendarID,
+	to *time.Time, includeCancelled bool, fn func(entity.RecurringEvent) error) error {
+
+	conditions := []string{}
+	args := []any{}
+	if calendarID != nil {
+		conditions = append(conditions, "calendar_id = ?")
+		args = append(args, *calendarID)
+	}
+	if to != nil {
+		conditions = append(conditions, "start < ?")
+		args = append(args, *to)
+	}
+	if !includeCancelled {
+		conditions = append(conditions, "status != ?")
+		args = append(args, constant.EventStatusCancelled)
+	}
+
+	query := "SELECT id, calendar_id, summary, recurrence, start, end, ical_uid, status FROM recurring_events"
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY calendar_id, id"
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("fail to select recurring events: %w", err)
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			r.logger.Errorf(ctx, "fail to close rows: %s", closeErr)
+		}
+	}()
+
+	for rows.Next() {
+		var recurringEvent entity.RecurringEvent
+		err := rows.Scan(&recurringEvent.ID, &recurringEvent.CalendarID, &recurringEvent.Summary,
+			&recurringEvent.Recurrence, &recurringEvent.Start, &recurringEvent.End, &recurringEvent.ICalUID, &recurringEvent.Status)
+		if err != nil {
+			return fmt.Errorf("fail to scan row: %w", err)
+		}
+
+		if err := fn(recurringEvent); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("fail to read recurring events: %w", err)
+	}
+
+	return nil
+}
+
 func (tx *mysqlTransaction) SyncRecurringEventAndInstancesWithAfter(ctx context.Context,
 	recurringEvent entity.RecurringEvent, instances []entity.Event, after time.Time) (updatedCount int, err error) {
 
@@ -142,11 +268,11 @@ func (tx *mysqlTransaction) syncRecurringEvent(ctx context.Context, recurringEve
 	var dbRecurringEvent entity.RecurringEvent
 	err = tx.tx.QueryRowContext(
 		ctx,
-		"SELECT id, calendar_id, summary, recurrence, start, end, status "+
+		"SELECT id, calendar_id, summary, recurrence, start, end, ical_uid, status "+
 			"FROM recurring_events WHERE calendar_id = ? AND id = ?",
 		recurringEvent.CalendarID, recurringEvent.ID,
 	).Scan(&dbRecurringEvent.ID, &dbRecurringEvent.CalendarID, &dbRecurringEvent.Summary,
-		&dbRecurringEvent.Recurrence, &dbRecurringEvent.Start, &dbRecurringEvent.End, &dbRecurringEvent.Status)
+		&dbRecurringEvent.Recurrence, &dbRecurringEvent.Start, &dbRecurringEvent.End, &dbRecurringEvent.ICalUID, &dbRecurringEvent.Status)
 
 	if err != nil && err != sql.ErrNoRows {
 		return 0, fmt.Errorf("fail to select recurring event: %w", err)
@@ -154,7 +280,7 @@ func (tx *mysqlTransaction) syncRecurringEvent(ctx context.Context, recurringEve
 
 	// DB に存在しない場合は挿入
 	if err == sql.ErrNoRows {
-		if err := tx.createRecurringEvent(ctx, recurringEvent); err != nil {
+		if err := createRecurringEvent(ctx, tx.tx, recurringEvent); err != nil {
 			return 0, fmt.Errorf("fail to create recurring event: %w", err)
 		}
 		return updatedCount + 1, nil
@@ -173,14 +299,26 @@ func (tx *mysqlTransaction) syncRecurringEvent(ctx context.Context, recurringEve
 	return updatedCount + 1, nil
 }
 
-func (tx *mysqlTransaction) createRecurringEvent(ctx context.Context, recurringEvent entity.RecurringEvent) error {
-	_, err := tx.tx.ExecContext(
+func (r *MysqlRepository) CreateRecurringEvent(ctx context.Context, t *testing.T,
+	recurringEvent entity.RecurringEvent) error {
+	t.Helper()
+
+	err := createRecurringEvent(ctx, r.db, recurringEvent)
+	if err != nil {
+		return fmt.Errorf("fail to create recurring event: %w", err)
+	}
+
+	return nil
+}
+
+func createRecurringEvent(ctx context.Context, db database, recurringEvent entity.RecurringEvent) error {
+	_, err := db.ExecContext(
 		ctx,
 		"INSERT INTO recurring_events "+
-			"(calendar_id, id, summary, recurrence, start, end, status) "+
-			"VALUES (?, ?, ?, ?, ?, ?, ?)",
+			"(calendar_id, id, summary, recurrence, start, end, ical_uid, status) "+
+			"VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
 		recurringEvent.CalendarID, recurringEvent.ID, recurringEvent.Summary,
-		recurringEvent.Recurrence, recurringEvent.Start, recurringEvent.End, recurringEvent.Status)
+		recurringEvent.Recurrence, recurringEvent.Start, recurringEvent.End, recurringEvent.ICalUID, recurringEvent.Status)
 	if err != nil {
 		return fmt.Errorf("fail to insert recurring event: %w", err)
 	}
@@ -192,10 +330,10 @@ func (tx *mysqlTransaction) updateRecurringEvent(ctx context.Context, recurringE
 	_, err := tx.tx.ExecContext(
 		ctx,
 		"UPDATE recurring_events "+
-			"SET summary = ?, recurrence = ?, start = ?, end = ?, status = ? "+
+			"SET summary = ?, recurrence = ?, start = ?, end = ?, ical_uid = ?, status = ? "+
 			"WHERE calendar_id = ? AND id = ?",
 		recurringEvent.Summary, recurringEvent.Recurrence, recurringEvent.Start,
-		recurringEvent.End, recurringEvent.Status, recurringEvent.CalendarID, recurringEvent.ID)
+		recurringEvent.End, recurringEvent.ICalUID, recurringEvent.Status, recurringEvent.CalendarID, recurringEvent.ID)
 	if err != nil {
 		return fmt.Errorf("fail to update recurring event: %w", err)
 	}
@@ -203,6 +341,18 @@ func (tx *mysqlTransaction) updateRecurringEvent(ctx context.Context, recurringE
 	return nil
 }
 
+func (tx *mysqlTransaction) DeleteRecurringEvents(ctx context.Context, calendarID valueobject.CalendarID) error {
+
+	_, err := tx.tx.ExecContext(ctx,
+		"DELETE FROM recurring_events WHERE calendar_id = ?",
+		calendarID)
+	if err != nil {
+		return fmt.Errorf("fail to delete recurring events: %w", err)
+	}
+
+	return nil
+}
+
 func (r *MysqlRepository) DeleteAllRecurringEventsForMain(ctx context.Context, m *testing.M) (updatedCount int, err error) {
 	updatedCount, err = r.deleteAllRecurringEvents(ctx)
 	if err != nil {
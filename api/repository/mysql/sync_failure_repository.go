@@ -0,0 +1,98 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/takuoki/google-calendar-sync/api/domain/entity"
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+)
+
+func (r *MysqlRepository) GetLatestSyncFailure(
+	ctx context.Context, calendarID valueobject.CalendarID) (*entity.SyncFailure, error) {
+
+	var failure entity.SyncFailure
+
+	err := r.db.QueryRowContext(
+		ctx,
+		"SELECT calendar_id, failure_time, stage, error_message "+
+			"FROM sync_failures "+
+			"WHERE calendar_id = ? "+
+			"ORDER BY failure_time DESC, id DESC LIMIT 1",
+		calendarID,
+	).Scan(&failure.CalendarID, &failure.FailureTime, &failure.Stage, &failure.ErrorMessage)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("fail to select latest sync failure: %w", err)
+	}
+
+	return &failure, nil
+}
+
+func (tx *mysqlTransaction) CreateSyncFailure(ctx context.Context, failure entity.SyncFailure) error {
+
+	_, err := tx.tx.ExecContext(
+		ctx,
+		"INSERT INTO sync_failures "+
+			"(calendar_id, failure_time, stage, error_message) "+
+			"VALUES (?, ?, ?, ?)",
+		failure.CalendarID, failure.FailureTime, failure.Stage, failure.ErrorMessage)
+
+	if err != nil {
+		return fmt.Errorf("fail to insert sync failure: %w", err)
+	}
+
+	return nil
+}
+
+func (tx *mysqlTransaction) DeleteSyncFailures(ctx context.Context, calendarID valueobject.CalendarID) error {
+
+	_, err := tx.tx.ExecContext(ctx,
+		"DELETE FROM sync_failures WHERE calendar_id = ?",
+		calendarID)
+	if err != nil {
+		return fmt.Errorf("fail to delete sync failures: %w", err)
+	}
+
+	return nil
+}
+
+func (r *MysqlRepository) DeleteAllSyncFailuresForMain(ctx context.Context, m *testing.M) (updatedCount int, err error) {
+	updatedCount, err = r.deleteAllSyncFailures(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("fail to delete all sync failures: %w", err)
+	}
+
+	return updatedCount, nil
+}
+
+func (r *MysqlRepository) DeleteAllSyncFailures(ctx context.Context, t *testing.T) (updatedCount int, err error) {
+	t.Helper()
+
+	updatedCount, err = r.deleteAllSyncFailures(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("fail to delete all sync failures: %w", err)
+	}
+
+	return updatedCount, nil
+}
+
+func (r *MysqlRepository) deleteAllSyncFailures(ctx context.Context) (updatedCount int, err error) {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM sync_failures")
+	if err != nil {
+		return 0, fmt.Errorf("fail to delete all sync failures: %w", err)
+	}
+
+	affectedRows, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("fail to get affected rows: %w", err)
+	}
+	updatedCount = int(affectedRows)
+
+	return updatedCount, nil
+}
@@ -2,13 +2,90 @@ package mysql
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/takuoki/google-calendar-sync/api/domain/entity"
 	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
 )
 
+func (r *MysqlRepository) GetLatestSyncFutureInstanceHistory(
+	ctx context.Context, calendarID valueobject.CalendarID) (*entity.SyncFutureInstanceHistory, error) {
+
+	var history entity.SyncFutureInstanceHistory
+
+	err := r.db.QueryRowContext(
+		ctx,
+		"SELECT calendar_id, sync_time, updated_event_count "+
+			"FROM sync_future_instance_histories "+
+			"WHERE calendar_id = ? "+
+			"ORDER BY sync_time DESC LIMIT 1",
+		calendarID,
+	).Scan(&history.CalendarID, &history.SyncTime, &history.UpdatedEventCount)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("fail to select latest sync future instance history: %w", err)
+	}
+
+	return &history, nil
+}
+
+// ListSyncFutureInstanceHistories returns sync future instance histories in descending order of sync time.
+// If before is specified, only histories synced before that time are returned.
+func (r *MysqlRepository) ListSyncFutureInstanceHistories(ctx context.Context,
+	calendarID valueobject.CalendarID, before *time.Time, limit int) ([]entity.SyncFutureInstanceHistory, error) {
+
+	query := "SELECT calendar_id, sync_time, updated_event_count " +
+		"FROM sync_future_instance_histories WHERE calendar_id = ?"
+	args := []any{calendarID}
+	if before != nil {
+		query += " AND sync_time < ?"
+		args = append(args, *before)
+	}
+	query += " ORDER BY sync_time DESC LIMIT ?"
+	args = append(args, limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("fail to select sync future instance histories: %w", err)
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			r.logger.Errorf(ctx, "fail to close rows: %s", closeErr)
+		}
+	}()
+
+	var histories []entity.SyncFutureInstanceHistory
+	for rows.Next() {
+		var history entity.SyncFutureInstanceHistory
+		err := rows.Scan(&history.CalendarID, &history.SyncTime, &history.UpdatedEventCount)
+		if err != nil {
+			return nil, fmt.Errorf("fail to scan row: %w", err)
+		}
+
+		histories = append(histories, history)
+	}
+
+	return histories, nil
+}
+
+func (r *MysqlRepository) CreateSyncFutureInstanceHistory(ctx context.Context, t *testing.T,
+	calendarID valueobject.CalendarID, syncTime time.Time, updatedEventCount int) error {
+	t.Helper()
+
+	err := createSyncFutureInstanceHistory(ctx, r.db, calendarID, syncTime, updatedEventCount)
+	if err != nil {
+		return fmt.Errorf("fail to create sync future instance history: %w", err)
+	}
+
+	return nil
+}
+
 func (tx *mysqlTransaction) CreateSyncFutureInstanceHistory(
 	ctx context.Context, calendarID valueobject.CalendarID, syncTime time.Time,
 	updatedEventCount int) error {
@@ -39,6 +116,18 @@ func createSyncFutureInstanceHistory(
 	return nil
 }
 
+func (tx *mysqlTransaction) DeleteSyncFutureInstanceHistories(ctx context.Context, calendarID valueobject.CalendarID) error {
+
+	_, err := tx.tx.ExecContext(ctx,
+		"DELETE FROM sync_future_instance_histories WHERE calendar_id = ?",
+		calendarID)
+	if err != nil {
+		return fmt.Errorf("fail to delete sync future instance histories: %w", err)
+	}
+
+	return nil
+}
+
 func (r *MysqlRepository) DeleteAllSyncFutureInstanceHistoriesForMain(ctx context.Context, m *testing.M) (updatedCount int, err error) {
 	updatedCount, err = r.deleteAllSyncFutureInstanceHistories(ctx)
 	if err != nil {
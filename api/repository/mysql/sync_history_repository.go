@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/takuoki/google-calendar-sync/api/domain/entity"
 	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
 )
 
@@ -33,6 +34,70 @@ func (r *MysqlRepository) GetLatestSyncToken(
 	return syncToken, nil
 }
 
+func (r *MysqlRepository) GetLatestSyncHistory(
+	ctx context.Context, calendarID valueobject.CalendarID) (*entity.SyncHistory, error) {
+
+	var history entity.SyncHistory
+
+	err := r.db.QueryRowContext(
+		ctx,
+		"SELECT calendar_id, sync_time, next_sync_token, updated_event_count "+
+			"FROM sync_histories "+
+			"WHERE calendar_id = ? "+
+			"ORDER BY sync_time DESC LIMIT 1",
+		calendarID,
+	).Scan(&history.CalendarID, &history.SyncTime, &history.NextSyncToken, &history.UpdatedEventCount)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("fail to select latest sync history: %w", err)
+	}
+
+	return &history, nil
+}
+
+// ListSyncHistories returns sync histories in descending order of sync time.
+// If before is specified, only histories synced before that time are returned.
+func (r *MysqlRepository) ListSyncHistories(ctx context.Context,
+	calendarID valueobject.CalendarID, before *time.Time, limit int) ([]entity.SyncHistory, error) {
+
+	query := "SELECT calendar_id, sync_time, next_sync_token, updated_event_count " +
+		"FROM sync_histories WHERE calendar_id = ?"
+	args := []any{calendarID}
+	if before != nil {
+		query += " AND sync_time < ?"
+		args = append(args, *before)
+	}
+	query += " ORDER BY sync_time DESC LIMIT ?"
+	args = append(args, limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("fail to select sync histories: %w", err)
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			r.logger.Errorf(ctx, "fail to close rows: %s", closeErr)
+		}
+	}()
+
+	var histories []entity.SyncHistory
+	for rows.Next() {
+		var history entity.SyncHistory
+		err := rows.Scan(&history.CalendarID, &history.SyncTime,
+			&history.NextSyncToken, &history.UpdatedEventCount)
+		if err != nil {
+			return nil, fmt.Errorf("fail to scan row: %w", err)
+		}
+
+		histories = append(histories, history)
+	}
+
+	return histories, nil
+}
+
 func (r *MysqlRepository) CreateSyncHistory(ctx context.Context, t *testing.T,
 	calendarID valueobject.CalendarID, syncTime time.Time,
 	nextSyncToken string, updatedEventCount int) error {
@@ -76,6 +141,18 @@ func createSyncHistory(
 	return nil
 }
 
+func (tx *mysqlTransaction) DeleteSyncHistories(ctx context.Context, calendarID valueobject.CalendarID) error {
+
+	_, err := tx.tx.ExecContext(ctx,
+		"DELETE FROM sync_histories WHERE calendar_id = ?",
+		calendarID)
+	if err != nil {
+		return fmt.Errorf("fail to delete sync histories: %w", err)
+	}
+
+	return nil
+}
+
 func (r *MysqlRepository) DeleteAllSyncHistoriesForMain(ctx context.Context, m *testing.M) (updatedCount int, err error) {
 	updatedCount, err = r.deleteAllSyncHistories(ctx)
 	if err != nil {
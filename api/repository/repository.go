@@ -9,6 +9,9 @@ import (
 )
 
 type GoogleCalendarRepository interface {
+	// calendars
+	GetCalendarMetadata(ctx context.Context, calendarID valueobject.CalendarID, credential entity.Credential) (*entity.CalendarMetadata, error)
+
 	// events
 	ListEventsWithAfter(ctx context.Context, calendarID valueobject.CalendarID, after time.Time) (
 		events []entity.Event, recurringEvents []entity.RecurringEvent, nextSyncToken string, err error)
@@ -23,6 +26,21 @@ type GoogleCalendarRepository interface {
 
 	// channels
 	StopWatch(ctx context.Context, channel entity.Channel) error
+
+	// calendarList
+	ListCalendarList(ctx context.Context, credential entity.Credential) ([]entity.CalendarListEntry, error)
+
+	// credentials
+	// InvalidateCredential discards the credential of the calendar cached by the repository,
+	// so that the next call uses the credential stored in the database.
+	InvalidateCredential(calendarID valueobject.CalendarID)
+}
+
+// OAuthRepository handles the OAuth 2.0 authorization code flow with PKCE.
+type OAuthRepository interface {
+	AuthCodeURL(state, codeVerifier string) string
+	// ExchangeRefreshToken exchanges the authorization code for a refresh token.
+	ExchangeRefreshToken(ctx context.Context, code, codeVerifier string) (string, error)
 }
 
 type DatabaseRepository interface {
@@ -30,33 +48,99 @@ type DatabaseRepository interface {
 
 	// calendars
 	GetCalendar(ctx context.Context, calendarID valueobject.CalendarID) (*entity.Calendar, error)
-	ListCalendars(ctx context.Context) ([]entity.Calendar, error)
+	// ListCalendars lists all calendars if tag is nil, otherwise the calendars with the tag.
+	ListCalendars(ctx context.Context, tag *string) ([]entity.Calendar, error)
+	// ListCalendarsWithIDs lists the calendars with the IDs. Calendars that do not exist are not included.
+	ListCalendarsWithIDs(ctx context.Context, calendarIDs []valueobject.CalendarID) ([]entity.Calendar, error)
 	GetRefreshToken(ctx context.Context, calendarID valueobject.CalendarID) (string, error)
+	GetAuthType(ctx context.Context, calendarID valueobject.CalendarID) (valueobject.AuthType, error)
+	GetSubject(ctx context.Context, calendarID valueobject.CalendarID) (string, error)
+	GetConsecutiveSyncFailureCount(ctx context.Context, calendarID valueobject.CalendarID) (int, error)
+	// GetFeedTokenHash returns the SHA-256 hash of the feed token in hex, or nil if the feed is not published.
+	GetFeedTokenHash(ctx context.Context, calendarID valueobject.CalendarID) (*string, error)
 
 	// recurring_events
 	ListActiveRecurringEventsWithIDs(ctx context.Context, calendarID valueobject.CalendarID, eventIDs []valueobject.EventID) ([]entity.RecurringEvent, error)
 	ListActiveRecurringEventsWithAfter(ctx context.Context, calendarID valueobject.CalendarID, after time.Time) ([]entity.RecurringEvent, error)
+	GetRecurringEvent(ctx context.Context, calendarID valueobject.CalendarID, eventID valueobject.EventID) (*entity.RecurringEvent, error)
+	// ListRecurringEvents returns recurring events in ascending order of ID, after the ID if after is specified.
+	ListRecurringEvents(ctx context.Context, calendarID valueobject.CalendarID, includeCancelled bool, after *valueobject.EventID, limit int) (
+		[]entity.RecurringEvent, error)
+	// ForEachRecurringEvent calls fn for each recurring event while reading the rows. All calendars are targeted if calendarID is nil.
+	ForEachRecurringEvent(ctx context.Context, calendarID *valueobject.CalendarID, to *time.Time, includeCancelled bool,
+		fn func(entity.RecurringEvent) error) error
+
+	// events
+	ListEventsWithFilter(ctx context.Context, calendarID valueobject.CalendarID, filter entity.EventFilter, after *entity.EventCursor, limit int) (
+		[]entity.Event, error)
+	ListEventInstances(ctx context.Context, calendarID valueobject.CalendarID, recurringEventIDs []valueobject.EventID, filter entity.EventFilter) (
+		[]entity.Event, error)
+	ListBusyEvents(ctx context.Context, calendarIDs []valueobject.CalendarID, from, to time.Time) ([]entity.Event, error)
+	// ForEachEvent calls fn for each event while reading the rows. All calendars are targeted if calendarID is nil.
+	ForEachEvent(ctx context.Context, calendarID *valueobject.CalendarID, filter entity.EventFilter, fn func(entity.Event) error) error
+	// SearchEvents returns the events matching the query in descending order of relevance, skipping offset events.
+	SearchEvents(ctx context.Context, query entity.EventSearchQuery, offset, limit int) ([]entity.EventSearchResult, error)
+
+	// channel_histories
+	GetActiveChannelHistory(ctx context.Context, calendarID valueobject.CalendarID) (*entity.Channel, error)
 
 	// sync_histories
 	GetLatestSyncToken(ctx context.Context, calendarID valueobject.CalendarID) (syncToken string, err error)
+	GetLatestSyncHistory(ctx context.Context, calendarID valueobject.CalendarID) (*entity.SyncHistory, error)
+	ListSyncHistories(ctx context.Context, calendarID valueobject.CalendarID, before *time.Time, limit int) ([]entity.SyncHistory, error)
+
+	// sync_future_instance_histories
+	GetLatestSyncFutureInstanceHistory(ctx context.Context, calendarID valueobject.CalendarID) (*entity.SyncFutureInstanceHistory, error)
+	ListSyncFutureInstanceHistories(ctx context.Context, calendarID valueobject.CalendarID, before *time.Time, limit int) (
+		[]entity.SyncFutureInstanceHistory, error)
+
+	// sync_failures
+	GetLatestSyncFailure(ctx context.Context, calendarID valueobject.CalendarID) (*entity.SyncFailure, error)
+
+	// leases
+	AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (acquired bool, err error)
+	ReleaseLease(ctx context.Context, name, holder string) error
+
+	// oauth_states
+	CreateOAuthState(ctx context.Context, state entity.OAuthState) error
+	DeleteExpiredOAuthStates(ctx context.Context, now time.Time) error
 }
 
 type DatabaseTransaction interface {
 	// calendars
 	LockCalendar(ctx context.Context, calendarID valueobject.CalendarID) error
+	// GetCalendarWithLock returns the calendar without its tags, and locks it until the end of the transaction.
+	GetCalendarWithLock(ctx context.Context, calendarID valueobject.CalendarID) (*entity.Calendar, error)
 	CreateCalendar(ctx context.Context, calendar entity.Calendar) error
+	UpdateCalendar(ctx context.Context, calendar entity.Calendar) error
+	UpdateCalendarPaused(ctx context.Context, calendarID valueobject.CalendarID, isPaused bool) error
+	MarkCalendarNeedsReauth(ctx context.Context, calendarID valueobject.CalendarID) error
+	// UpdateFeedTokenHash publishes the feed with the token of the hash, or unpublishes it if hash is nil.
+	UpdateFeedTokenHash(ctx context.Context, calendarID valueobject.CalendarID, hash *string) error
+	// ReencryptRefreshTokens decrypts every stored refresh token and encrypts it again with the active key.
+	ReencryptRefreshTokens(ctx context.Context) (count int, err error)
+	DeleteCalendar(ctx context.Context, calendarID valueobject.CalendarID) error
+
+	// calendar_tags
+	ReplaceCalendarTags(ctx context.Context, calendarID valueobject.CalendarID, tags []string) error
+	DeleteCalendarTags(ctx context.Context, calendarID valueobject.CalendarID) error
+	IncrementConsecutiveSyncFailureCount(ctx context.Context, calendarID valueobject.CalendarID) error
+	ResetConsecutiveSyncFailureCount(ctx context.Context, calendarID valueobject.CalendarID) error
 
 	// recurring_events
 	SyncRecurringEventAndInstancesWithAfter(ctx context.Context, recurringEvent entity.RecurringEvent, instances []entity.Event, after time.Time) (
 		updatedCount int, err error)
+	DeleteRecurringEvents(ctx context.Context, calendarID valueobject.CalendarID) error
 
 	// events
 	SyncEvents(ctx context.Context, calendarID valueobject.CalendarID, events []entity.Event) (updatedCount int, err error)
+	DeleteEvents(ctx context.Context, calendarID valueobject.CalendarID) error
 
 	// channel_histories
 	ListActiveChannelHistoriesWithLock(ctx context.Context, calendarID valueobject.CalendarID) ([]entity.Channel, error)
 	CreateChannelHistory(ctx context.Context, channel entity.Channel) error
 	StopActiveChannels(ctx context.Context, calendarID valueobject.CalendarID) error
+	DeleteChannelHistories(ctx context.Context, calendarID valueobject.CalendarID) error
 
 	// sync_histories
 	CreateSyncHistory(
@@ -66,6 +150,7 @@ type DatabaseTransaction interface {
 		nextSyncToken string,
 		updatedEventCount int,
 	) error
+	DeleteSyncHistories(ctx context.Context, calendarID valueobject.CalendarID) error
 
 	// sync_future_instance_histories
 	CreateSyncFutureInstanceHistory(
@@ -74,4 +159,13 @@ type DatabaseTransaction interface {
 		syncTime time.Time,
 		updatedEventCount int,
 	) error
+	DeleteSyncFutureInstanceHistories(ctx context.Context, calendarID valueobject.CalendarID) error
+
+	// sync_failures
+	CreateSyncFailure(ctx context.Context, failure entity.SyncFailure) error
+	DeleteSyncFailures(ctx context.Context, calendarID valueobject.CalendarID) error
+
+	// oauth_states
+	GetOAuthStateWithLock(ctx context.Context, state string) (*entity.OAuthState, error)
+	DeleteOAuthState(ctx context.Context, state string) error
 }
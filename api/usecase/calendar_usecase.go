@@ -3,6 +3,9 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"net/mail"
+	"slices"
+	"unicode/utf8"
 
 	"github.com/takuoki/golib/applog"
 	"github.com/takuoki/google-calendar-sync/api/domain"
@@ -12,44 +15,102 @@ import (
 )
 
 type CalendarUsecase interface {
-	Create(ctx context.Context, calendarID valueobject.CalendarID, name string, refreshToken *string) error
+	// List lists all calendars if tag is nil, otherwise the calendars with the tag.
+	List(ctx context.Context, tag *string) ([]entity.Calendar, error)
+	Get(ctx context.Context, calendarID valueobject.CalendarID) (*entity.Calendar, error)
+	// Create registers the calendar accessed via user OAuth if refreshToken is given,
+	// via domain-wide delegation if subject is given, and otherwise via the service account.
+	Create(ctx context.Context, calendarID valueobject.CalendarID, name string, refreshToken, subject *string, tags []string) error
+	// BulkCreate registers all calendars in one transaction, and starts watching them if watch is true.
+	// Watches are started after the registration is committed, so failing to start a watch does not roll it back
+	// and is returned as watchFailures instead of an error.
+	BulkCreate(ctx context.Context, calendars []entity.Calendar, watch bool) (watchFailures []entity.WatchFailure, err error)
+	// Update updates only the fields that are not nil.
+	// Tags are replaced with the given ones, and the auth type is switched if refreshToken or subject is given.
+	Update(ctx context.Context, calendarID valueobject.CalendarID, name, refreshToken, subject *string, tags *[]string) error
+	// Delete stops the active channel and deletes the calendar with all of its events and histories.
+	Delete(ctx context.Context, calendarID valueobject.CalendarID) error
+	// Pause stops syncing the calendar without deleting its events and histories.
+	Pause(ctx context.Context, calendarID valueobject.CalendarID) error
+	// Resume restarts syncing the calendar, and syncs the changes made while it was paused.
+	Resume(ctx context.Context, calendarID valueobject.CalendarID) error
+	// Discover lists the calendars accessible with the given credential.
+	Discover(ctx context.Context, credential entity.Credential) ([]entity.CalendarListEntry, error)
 }
 
 type calendarUsecase struct {
-	databaseRepo repository.DatabaseRepository
-	useOauth     bool
-	logger       applog.Logger
+	googleCalenderRepo repository.GoogleCalendarRepository
+	databaseRepo       repository.DatabaseRepository
+	syncUsecase        SyncUsecase
+	watchUsecase       WatchUsecase
+	authTypes          []valueobject.AuthType
+	logger             applog.Logger
 }
 
 func NewCalendarUsecase(
+	googleCalenderRepo repository.GoogleCalendarRepository,
 	databaseRepo repository.DatabaseRepository,
-	useOauth bool,
+	syncUsecase SyncUsecase,
+	watchUsecase WatchUsecase,
+	authTypes []valueobject.AuthType,
 	logger applog.Logger,
 ) CalendarUsecase {
 	return &calendarUsecase{
-		databaseRepo: databaseRepo,
-		useOauth:     useOauth,
-		logger:       logger,
+		googleCalenderRepo: googleCalenderRepo,
+		databaseRepo:       databaseRepo,
+		syncUsecase:        syncUsecase,
+		watchUsecase:       watchUsecase,
+		authTypes:          authTypes,
+		logger:             logger,
 	}
 }
 
+func (u *calendarUsecase) List(ctx context.Context, tag *string) ([]entity.Calendar, error) {
+
+	calendars, err := u.databaseRepo.ListCalendars(ctx, tag)
+	if err != nil {
+		return nil, fmt.Errorf("fail to list calendars: %w", err)
+	}
+
+	return calendars, nil
+}
+
+func (u *calendarUsecase) Get(ctx context.Context, calendarID valueobject.CalendarID) (*entity.Calendar, error) {
+
+	calendar, err := u.databaseRepo.GetCalendar(ctx, calendarID)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get calendar: %w", err)
+	}
+
+	return calendar, nil
+}
+
 func (u *calendarUsecase) Create(ctx context.Context, calendarID valueobject.CalendarID,
-	name string, refreshToken *string) error {
+	name string, refreshToken, subject *string, tags []string) error {
 
-	if u.useOauth && (refreshToken == nil || *refreshToken == "") {
-		return domain.RequiredError("refreshToken")
+	credential := entity.Credential{RefreshToken: refreshToken, Subject: subject}
+	if err := u.validateCredential(credential); err != nil {
+		return err
 	}
 
-	if !u.useOauth && refreshToken != nil {
-		return domain.NotAllowedError("refreshToken")
+	if err := validateTags(tags); err != nil {
+		return err
+	}
+
+	calendar := entity.Calendar{
+		ID:           calendarID,
+		Name:         name,
+		RefreshToken: refreshToken,
+		Subject:      subject,
+		AuthType:     credential.AuthType(),
+		Tags:         tags,
+	}
+
+	if err := u.fillMetadata(ctx, &calendar); err != nil {
+		return err
 	}
 
 	err := u.databaseRepo.RunTransaction(ctx, func(ctx context.Context, tx repository.DatabaseTransaction) error {
-		calendar := entity.Calendar{
-			ID:           calendarID,
-			Name:         name,
-			RefreshToken: refreshToken,
-		}
 		if err := tx.CreateCalendar(ctx, calendar); err != nil {
 			return fmt.Errorf("fail to create calendar: %w", err)
 		}
@@ -63,3 +124,360 @@ func (u *calendarUsecase) Create(ctx context.Context, calendarID valueobject.Cal
 
 	return nil
 }
+
+func (u *calendarUsecase) BulkCreate(ctx context.Context, calendars []entity.Calendar, watch bool) ([]entity.WatchFailure, error) {
+
+	if len(calendars) == 0 {
+		return nil, domain.RequiredError("calendars")
+	}
+
+	for _, calendar := range calendars {
+		if calendar.ID == "" {
+			return nil, domain.RequiredError("id")
+		}
+		if calendar.Name == "" {
+			return nil, domain.RequiredError("name")
+		}
+		if err := u.validateCredential(calendar.Credential()); err != nil {
+			return nil, err
+		}
+		if err := validateTags(calendar.Tags); err != nil {
+			return nil, err
+		}
+	}
+
+	// 登録前に全カレンダーへのアクセスを確認する
+	calendars = slices.Clone(calendars)
+	for i := range calendars {
+		calendars[i].AuthType = calendars[i].Credential().AuthType()
+		if err := u.fillMetadata(ctx, &calendars[i]); err != nil {
+			return nil, fmt.Errorf("fail to verify calendar (calendarID: %q): %w", calendars[i].ID, err)
+		}
+	}
+
+	// 1 件でも登録に失敗した場合は、全件ロールバックする
+	err := u.databaseRepo.RunTransaction(ctx, func(ctx context.Context, tx repository.DatabaseTransaction) error {
+		for _, calendar := range calendars {
+			if err := tx.CreateCalendar(ctx, calendar); err != nil {
+				return fmt.Errorf("fail to create calendar (calendarID: %q): %w", calendar.ID, err)
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("fail to run transaction: %w", err)
+	}
+
+	watchFailures := []entity.WatchFailure{}
+	if !watch {
+		return watchFailures, nil
+	}
+
+	// 外部 API 呼び出しを含むため、カレンダー登録のトランザクションとは分けて実行する
+	// 登録は確定しているため、1 件の失敗で他のカレンダーの監視開始を止めない
+	for _, calendar := range calendars {
+		if err := u.watchUsecase.Start(ctx, calendar.ID); err != nil {
+			u.logger.Errorf(ctx, "fail to start watch (calendarID: %q): %v", calendar.ID, err)
+			watchFailures = append(watchFailures, entity.WatchFailure{CalendarID: calendar.ID, Err: err})
+		}
+	}
+
+	return watchFailures, nil
+}
+
+func (u *calendarUsecase) Update(ctx context.Context, calendarID valueobject.CalendarID,
+	name, refreshToken, subject *string, tags *[]string) error {
+
+	if name == nil && refreshToken == nil && subject == nil && tags == nil {
+		return domain.RequiredError("name, refreshToken, subject or tags")
+	}
+
+	if name != nil && *name == "" {
+		return domain.InvalidParameterError("name")
+	}
+
+	if refreshToken != nil && !u.isAuthTypeEnabled(valueobject.AuthTypeOAuth) {
+		return domain.NotAllowedError("refreshToken")
+	}
+
+	if refreshToken != nil && *refreshToken == "" {
+		return domain.InvalidParameterError("refreshToken")
+	}
+
+	if subject != nil && !u.isAuthTypeEnabled(valueobject.AuthTypeDelegation) {
+		return domain.NotAllowedError("subject")
+	}
+
+	if subject != nil && !isEmail(*subject) {
+		return domain.InvalidParameterError("subject")
+	}
+
+	if refreshToken != nil && subject != nil {
+		return domain.OnlyOneAllowedError("refreshToken or subject")
+	}
+
+	if tags != nil {
+		if err := validateTags(*tags); err != nil {
+			return err
+		}
+	}
+
+	updated, err := u.databaseRepo.GetCalendar(ctx, calendarID)
+	if err != nil {
+		return fmt.Errorf("fail to get calendar: %w", err)
+	}
+
+	if refreshToken != nil || subject != nil {
+		// 新しい認証情報でアクセスできることを確認する
+		// Google Calendar へのアクセス中にロックを保持しないよう、トランザクションの前に行う
+		updated.RefreshToken = refreshToken
+		updated.Subject = subject
+		if err := u.fillMetadata(ctx, updated); err != nil {
+			return err
+		}
+	}
+
+	err = u.databaseRepo.RunTransaction(ctx, func(ctx context.Context, tx repository.DatabaseTransaction) error {
+		// 同期による再認可の要求や OAuth による認証情報の更新を上書きしないよう、ロックした上で最新の状態を読み込む
+		calendar, err := tx.GetCalendarWithLock(ctx, calendarID)
+		if err != nil {
+			return fmt.Errorf("fail to get calendar: %w", err)
+		}
+
+		if name != nil {
+			calendar.Name = *name
+		}
+		if refreshToken != nil || subject != nil {
+			// 指定された認証情報に応じて、認証方式を切り替える
+			calendar.RefreshToken = refreshToken
+			calendar.Subject = subject
+			calendar.AuthType = calendar.Credential().AuthType()
+			calendar.Summary = updated.Summary
+			calendar.TimeZone = updated.TimeZone
+			calendar.AccessRole = updated.AccessRole
+			// 新しい認証情報が指定されたため、再認可の要求を解除する
+			calendar.NeedsReauthAt = nil
+		}
+
+		if err := tx.UpdateCalendar(ctx, *calendar); err != nil {
+			return fmt.Errorf("fail to update calendar: %w", err)
+		}
+
+		if tags != nil {
+			if err := tx.ReplaceCalendarTags(ctx, calendarID, *tags); err != nil {
+				return fmt.Errorf("fail to replace calendar tags: %w", err)
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("fail to run transaction: %w", err)
+	}
+
+	// コミット後に破棄し、次回の呼び出しで新しい認証情報を使用させる
+	if refreshToken != nil || subject != nil {
+		u.googleCalenderRepo.InvalidateCredential(calendarID)
+	}
+
+	return nil
+}
+
+func (u *calendarUsecase) Delete(ctx context.Context, calendarID valueobject.CalendarID) error {
+
+	// 存在チェックも兼ねる
+	if err := u.watchUsecase.Stop(ctx, calendarID); err != nil {
+		return fmt.Errorf("fail to stop watch: %w", err)
+	}
+
+	err := u.databaseRepo.RunTransaction(ctx, func(ctx context.Context, tx repository.DatabaseTransaction) error {
+		if err := tx.LockCalendar(ctx, calendarID); err != nil {
+			return fmt.Errorf("fail to lock calendar: %w", err)
+		}
+
+		// 外部キー制約があるため、参照している側のテーブルから順に削除する
+		if err := tx.DeleteEvents(ctx, calendarID); err != nil {
+			return fmt.Errorf("fail to delete events: %w", err)
+		}
+		if err := tx.DeleteRecurringEvents(ctx, calendarID); err != nil {
+			return fmt.Errorf("fail to delete recurring events: %w", err)
+		}
+		if err := tx.DeleteChannelHistories(ctx, calendarID); err != nil {
+			return fmt.Errorf("fail to delete channel histories: %w", err)
+		}
+		if err := tx.DeleteSyncHistories(ctx, calendarID); err != nil {
+			return fmt.Errorf("fail to delete sync histories: %w", err)
+		}
+		if err := tx.DeleteSyncFutureInstanceHistories(ctx, calendarID); err != nil {
+			return fmt.Errorf("fail to delete sync future instance histories: %w", err)
+		}
+		if err := tx.DeleteSyncFailures(ctx, calendarID); err != nil {
+			return fmt.Errorf("fail to delete sync failures: %w", err)
+		}
+		if err := tx.DeleteCalendarTags(ctx, calendarID); err != nil {
+			return fmt.Errorf("fail to delete calendar tags: %w", err)
+		}
+		if err := tx.DeleteCalendar(ctx, calendarID); err != nil {
+			return fmt.Errorf("fail to delete calendar: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("fail to run transaction: %w", err)
+	}
+
+	u.googleCalenderRepo.InvalidateCredential(calendarID)
+
+	return nil
+}
+
+func (u *calendarUsecase) Pause(ctx context.Context, calendarID valueobject.CalendarID) error {
+
+	if err := u.updatePaused(ctx, calendarID, true); err != nil {
+		return fmt.Errorf("fail to update paused: %w", err)
+	}
+
+	return nil
+}
+
+func (u *calendarUsecase) Resume(ctx context.Context, calendarID valueobject.CalendarID) error {
+
+	if err := u.updatePaused(ctx, calendarID, false); err != nil {
+		return fmt.Errorf("fail to update paused: %w", err)
+	}
+
+	// 一時停止中にスキップした変更を取り込む
+	if err := u.syncUsecase.Sync(ctx, calendarID); err != nil {
+		return fmt.Errorf("fail to sync: %w", err)
+	}
+
+	return nil
+}
+
+func (u *calendarUsecase) updatePaused(ctx context.Context, calendarID valueobject.CalendarID, isPaused bool) error {
+
+	if _, err := u.databaseRepo.GetCalendar(ctx, calendarID); err != nil {
+		return fmt.Errorf("fail to get calendar: %w", err)
+	}
+
+	err := u.databaseRepo.RunTransaction(ctx, func(ctx context.Context, tx repository.DatabaseTransaction) error {
+		if err := tx.UpdateCalendarPaused(ctx, calendarID, isPaused); err != nil {
+			return fmt.Errorf("fail to update calendar paused: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("fail to run transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (u *calendarUsecase) Discover(ctx context.Context, credential entity.Credential) ([]entity.CalendarListEntry, error) {
+
+	if err := u.validateCredential(credential); err != nil {
+		return nil, err
+	}
+
+	entries, err := u.googleCalenderRepo.ListCalendarList(ctx, credential)
+	if err != nil {
+		return nil, fmt.Errorf("fail to list calendar list: %w", err)
+	}
+
+	return entries, nil
+}
+
+// fillMetadata confirms that the calendar is accessible, and sets the metadata obtained from Google Calendar.
+func (u *calendarUsecase) fillMetadata(ctx context.Context, calendar *entity.Calendar) error {
+
+	metadata, err := u.googleCalenderRepo.GetCalendarMetadata(ctx, calendar.ID, calendar.Credential())
+	if err != nil {
+		return fmt.Errorf("fail to get calendar metadata: %w", err)
+	}
+	if metadata == nil {
+		return fmt.Errorf("fail to get calendar metadata: metadata is nil")
+	}
+
+	calendar.Summary = metadata.Summary
+	calendar.TimeZone = metadata.TimeZone
+	calendar.AccessRole = metadata.AccessRole
+
+	return nil
+}
+
+// validateCredential checks that the auth type decided by the credential is enabled.
+func (u *calendarUsecase) validateCredential(credential entity.Credential) error {
+
+	if credential.RefreshToken != nil && credential.Subject != nil {
+		return domain.OnlyOneAllowedError("refreshToken or subject")
+	}
+
+	switch credential.AuthType() {
+	case valueobject.AuthTypeOAuth:
+		if !u.isAuthTypeEnabled(valueobject.AuthTypeOAuth) {
+			return domain.NotAllowedError("refreshToken")
+		}
+		if *credential.RefreshToken == "" {
+			return domain.RequiredError("refreshToken")
+		}
+
+	case valueobject.AuthTypeDelegation:
+		if !u.isAuthTypeEnabled(valueobject.AuthTypeDelegation) {
+			return domain.NotAllowedError("subject")
+		}
+		if *credential.Subject == "" {
+			return domain.RequiredError("subject")
+		}
+		if !isEmail(*credential.Subject) {
+			return domain.InvalidParameterError("subject")
+		}
+
+	default:
+		if !u.isAuthTypeEnabled(valueobject.AuthTypeServiceAccount) {
+			// サービスアカウント以外で有効な認証方式の認証情報を要求する
+			if u.isAuthTypeEnabled(valueobject.AuthTypeOAuth) {
+				return domain.RequiredError("refreshToken")
+			}
+			return domain.RequiredError("subject")
+		}
+	}
+
+	return nil
+}
+
+func (u *calendarUsecase) isAuthTypeEnabled(authType valueobject.AuthType) bool {
+	return slices.Contains(u.authTypes, authType)
+}
+
+// isEmail reports whether s is a bare email address, which is required for the subject of domain-wide delegation.
+func isEmail(s string) bool {
+	addr, err := mail.ParseAddress(s)
+	return err == nil && addr.Address == s
+}
+
+// validateTags checks that each tag is a non-empty string of up to 100 characters without duplicates.
+func validateTags(tags []string) error {
+
+	const maxTagLength = 100
+
+	seen := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		// カラムの長さは文字数で制限されるため、バイト数ではなく文字数で判定する
+		if tag == "" || utf8.RuneCountInString(tag) > maxTagLength {
+			return domain.InvalidParameterError("tags")
+		}
+		if _, ok := seen[tag]; ok {
+			return domain.InvalidParameterError("tags")
+		}
+		seen[tag] = struct{}{}
+	}
+
+	return nil
+}
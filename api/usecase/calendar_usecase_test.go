@@ -3,20 +3,37 @@ package usecase_test
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/takuoki/golib/applog"
 
+	"github.com/takuoki/google-calendar-sync/api/domain"
 	"github.com/takuoki/google-calendar-sync/api/domain/entity"
+	"github.com/takuoki/google-calendar-sync/api/domain/service"
 	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+	"github.com/takuoki/google-calendar-sync/api/repository"
 	"github.com/takuoki/google-calendar-sync/api/usecase"
 )
 
-func setupCalendarUsecase(useOauth bool) (usecase.CalendarUsecase, *bytes.Buffer) {
+var (
+	serviceAccountOnly = []valueobject.AuthType{valueobject.AuthTypeServiceAccount}
+	oauthOnly          = []valueobject.AuthType{valueobject.AuthTypeOAuth}
+	allAuthTypes       = []valueobject.AuthType{
+		valueobject.AuthTypeServiceAccount, valueobject.AuthTypeOAuth, valueobject.AuthTypeDelegation}
+)
+
+func setupCalendarUsecase(authTypes []valueobject.AuthType,
+	mockRepo repository.GoogleCalendarRepository) (usecase.CalendarUsecase, *bytes.Buffer) {
 	buf := new(bytes.Buffer)
 
 	logger, err := applog.NewSimpleLogger(buf)
@@ -24,31 +41,71 @@ func setupCalendarUsecase(useOauth bool) (usecase.CalendarUsecase, *bytes.Buffer
 		panic("failed to create logger: " + err.Error())
 	}
 
-	calendarUsecase := usecase.NewCalendarUsecase(mysqlRepo, useOauth, logger)
+	syncUsecase := usecase.NewSyncUsecase(service.NewMockClock(), mockRepo, mysqlRepo, logger)
+	watchUsecase := usecase.NewWatchUsecase(mockRepo, mysqlRepo, logger)
+	calendarUsecase := usecase.NewCalendarUsecase(mockRepo, mysqlRepo, syncUsecase, watchUsecase, authTypes, logger)
 
 	return calendarUsecase, buf
 }
 
+func newAccessibleCalendarMock() *GoogleCalendarRepositoryMock {
+	return &GoogleCalendarRepositoryMock{
+		GetCalendarMetadataFunc: func(ctx context.Context,
+			calendarID valueobject.CalendarID, credential entity.Credential) (*entity.CalendarMetadata, error) {
+			return &entity.CalendarMetadata{
+				Summary:    "Google Calendar Summary",
+				TimeZone:   "Asia/Tokyo",
+				AccessRole: "reader",
+			}, nil
+		},
+	}
+}
+
 func TestCalendarUsecase_Create_Success(t *testing.T) {
 	t.Parallel()
 
 	tests := map[string]struct {
-		useOauth     bool
+		authTypes    []valueobject.AuthType
 		calendarID   valueobject.CalendarID
 		name         string
 		refreshToken *string
+		subject      *string
+		authType     valueobject.AuthType
 	}{
-		"with refresh token and useOauth true": {
-			useOauth:     true,
+		"with refresh token and oauth only": {
+			authTypes:    oauthOnly,
 			calendarID:   "calendar-success-1",
 			name:         "Test Calendar 1",
 			refreshToken: func() *string { s := "test-refresh-token"; return &s }(),
+			authType:     valueobject.AuthTypeOAuth,
 		},
-		"without refresh token and useOauth false": {
-			useOauth:     false,
+		"without refresh token and service account only": {
+			authTypes:    serviceAccountOnly,
 			calendarID:   "calendar-success-2",
 			name:         "Test Calendar 2",
 			refreshToken: nil,
+			authType:     valueobject.AuthTypeServiceAccount,
+		},
+		"with refresh token and all auth types": {
+			authTypes:    allAuthTypes,
+			calendarID:   "calendar-success-3",
+			name:         "Test Calendar 3",
+			refreshToken: func() *string { s := "test-refresh-token"; return &s }(),
+			authType:     valueobject.AuthTypeOAuth,
+		},
+		"without refresh token and all auth types": {
+			authTypes:    allAuthTypes,
+			calendarID:   "calendar-success-4",
+			name:         "Test Calendar 4",
+			refreshToken: nil,
+			authType:     valueobject.AuthTypeServiceAccount,
+		},
+		"with subject and all auth types": {
+			authTypes:  allAuthTypes,
+			calendarID: "calendar-success-5",
+			name:       "Test Calendar 5",
+			subject:    func() *string { s := "user@example.com"; return &s }(),
+			authType:   valueobject.AuthTypeDelegation,
 		},
 	}
 
@@ -59,10 +116,10 @@ func TestCalendarUsecase_Create_Success(t *testing.T) {
 			ctx := context.Background()
 
 			// Given
-			calendarUsecase, _ := setupCalendarUsecase(tt.useOauth)
+			calendarUsecase, _ := setupCalendarUsecase(tt.authTypes, newAccessibleCalendarMock())
 
 			// When
-			err := calendarUsecase.Create(ctx, tt.calendarID, tt.name, tt.refreshToken)
+			err := calendarUsecase.Create(ctx, tt.calendarID, tt.name, tt.refreshToken, tt.subject, nil)
 			require.NoError(t, err)
 
 			// Then
@@ -71,6 +128,11 @@ func TestCalendarUsecase_Create_Success(t *testing.T) {
 
 			assert.Equal(t, tt.calendarID, calendar.ID)
 			assert.Equal(t, tt.name, calendar.Name)
+			assert.Equal(t, "Google Calendar Summary", calendar.Summary)
+			assert.Equal(t, "Asia/Tokyo", calendar.TimeZone)
+			assert.Equal(t, "reader", calendar.AccessRole)
+			assert.Equal(t, tt.authType, calendar.AuthType)
+			assert.Equal(t, tt.subject, calendar.Subject)
 			if tt.refreshToken != nil {
 				assert.Equal(t, *tt.refreshToken, *calendar.RefreshToken)
 			} else {
@@ -84,33 +146,56 @@ func TestCalendarUsecase_Create_Failure(t *testing.T) {
 	t.Parallel()
 
 	tests := map[string]struct {
-		useOauth     bool
+		authTypes    []valueobject.AuthType
 		calendarID   valueobject.CalendarID
 		name         string
 		refreshToken *string
+		subject      *string
 		errPrefix    string
 	}{
-		"missing refresh token with useOauth true": {
-			useOauth:     true,
+		"missing refresh token with oauth only": {
+			authTypes:    oauthOnly,
 			calendarID:   "calendar-failure-1",
 			name:         "Test Calendar 1",
 			refreshToken: nil,
 			errPrefix:    "refreshToken is required",
 		},
-		"empty refresh token with useOauth true": {
-			useOauth:     true,
+		"empty refresh token with oauth only": {
+			authTypes:    oauthOnly,
 			calendarID:   "calendar-failure-2",
 			name:         "Test Calendar 2",
 			refreshToken: func() *string { s := ""; return &s }(),
 			errPrefix:    "refreshToken is required",
 		},
-		"refresh token provided with useOauth false": {
-			useOauth:     false,
+		"refresh token provided with service account only": {
+			authTypes:    serviceAccountOnly,
 			calendarID:   "calendar-failure-3",
 			name:         "Test Calendar 3",
 			refreshToken: func() *string { s := "unexpected-token"; return &s }(),
 			errPrefix:    "refreshToken is not allowed",
 		},
+		"subject provided with service account only": {
+			authTypes:  serviceAccountOnly,
+			calendarID: "calendar-failure-4",
+			name:       "Test Calendar 4",
+			subject:    func() *string { s := "user@example.com"; return &s }(),
+			errPrefix:  "subject is not allowed",
+		},
+		"both refresh token and subject provided": {
+			authTypes:    allAuthTypes,
+			calendarID:   "calendar-failure-5",
+			name:         "Test Calendar 5",
+			refreshToken: func() *string { s := "test-refresh-token"; return &s }(),
+			subject:      func() *string { s := "user@example.com"; return &s }(),
+			errPrefix:    "only one of refreshToken or subject is allowed",
+		},
+		"subject is not an email": {
+			authTypes:  allAuthTypes,
+			calendarID: "calendar-failure-6",
+			name:       "Test Calendar 6",
+			subject:    func() *string { s := "User <user@example.com>"; return &s }(),
+			errPrefix:  "subject is invalid",
+		},
 	}
 
 	for name, tt := range tests {
@@ -120,10 +205,10 @@ func TestCalendarUsecase_Create_Failure(t *testing.T) {
 			ctx := context.Background()
 
 			// Given
-			calendarUsecase, _ := setupCalendarUsecase(tt.useOauth)
+			calendarUsecase, _ := setupCalendarUsecase(tt.authTypes, newAccessibleCalendarMock())
 
 			// When
-			err := calendarUsecase.Create(ctx, tt.calendarID, tt.name, tt.refreshToken)
+			err := calendarUsecase.Create(ctx, tt.calendarID, tt.name, tt.refreshToken, tt.subject, nil)
 			require.Error(t, err)
 
 			// Then
@@ -134,13 +219,41 @@ func TestCalendarUsecase_Create_Failure(t *testing.T) {
 	}
 }
 
+func TestCalendarUsecase_Create_NotAccessible(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	mockRepo := &GoogleCalendarRepositoryMock{
+		GetCalendarMetadataFunc: func(ctx context.Context,
+			calendarID valueobject.CalendarID, credential entity.Credential) (*entity.CalendarMetadata, error) {
+			return nil, domain.GoogleCalendarNotFoundError
+		},
+	}
+
+	calendarUsecase, _ := setupCalendarUsecase(serviceAccountOnly, mockRepo)
+
+	var calendarID valueobject.CalendarID = "calendar-not-accessible-1"
+
+	// When
+	err := calendarUsecase.Create(ctx, calendarID, "Test Calendar", nil, nil, nil)
+	require.Error(t, err)
+
+	// Then
+	assert.True(t, errors.Is(err, domain.GoogleCalendarNotFoundError))
+
+	_, err = mysqlRepo.GetCalendar(ctx, calendarID)
+	assert.True(t, errors.Is(err, domain.CalendarNotFoundError))
+}
+
 func TestCalendarUsecase_Create_DuplicateError(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
 
 	// Given
-	calendarUsecase, _ := setupCalendarUsecase(false)
+	calendarUsecase, _ := setupCalendarUsecase(serviceAccountOnly, newAccessibleCalendarMock())
 
 	var calendarID valueobject.CalendarID = "calendar-duplicate-id"
 	name := "Duplicate Calendar"
@@ -152,7 +265,7 @@ func TestCalendarUsecase_Create_DuplicateError(t *testing.T) {
 	require.NoError(t, err)
 
 	// When
-	err = calendarUsecase.Create(ctx, calendarID, name, nil)
+	err = calendarUsecase.Create(ctx, calendarID, name, nil, nil, nil)
 	require.Error(t, err)
 
 	// Then
@@ -160,3 +273,947 @@ func TestCalendarUsecase_Create_DuplicateError(t *testing.T) {
 		t.Errorf("error message does not match the expected prefix, got: %s", err.Error())
 	}
 }
+
+func TestCalendarUsecase_List_Success(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	calendarUsecase, _ := setupCalendarUsecase(serviceAccountOnly, newAccessibleCalendarMock())
+
+	var calendarID valueobject.CalendarID = "calendar-list-success-1"
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:   calendarID,
+		Name: "Test Calendar",
+	}))
+
+	// When
+	calendars, err := calendarUsecase.List(ctx, nil)
+	require.NoError(t, err)
+
+	// Then
+	found := false
+	for _, calendar := range calendars {
+		if calendar.ID == calendarID {
+			found = true
+			assert.Equal(t, "Test Calendar", calendar.Name)
+		}
+	}
+	assert.True(t, found)
+}
+
+func TestCalendarUsecase_List_Tag(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	calendarUsecase, _ := setupCalendarUsecase(serviceAccountOnly, newAccessibleCalendarMock())
+
+	var taggedCalendarID valueobject.CalendarID = "calendar-list-tag-1"
+	var untaggedCalendarID valueobject.CalendarID = "calendar-list-tag-2"
+	tag := "list-tag-team"
+
+	require.NoError(t, calendarUsecase.Create(ctx, taggedCalendarID, "Test Calendar 1", nil, nil, []string{tag, "list-tag-other"}))
+	require.NoError(t, calendarUsecase.Create(ctx, untaggedCalendarID, "Test Calendar 2", nil, nil, nil))
+
+	// When
+	calendars, err := calendarUsecase.List(ctx, &tag)
+	require.NoError(t, err)
+
+	// Then
+	if assert.Len(t, calendars, 1) {
+		assert.Equal(t, taggedCalendarID, calendars[0].ID)
+		assert.ElementsMatch(t, []string{tag, "list-tag-other"}, calendars[0].Tags)
+	}
+}
+
+func TestCalendarUsecase_Update_Tags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	calendarUsecase, _ := setupCalendarUsecase(serviceAccountOnly, newAccessibleCalendarMock())
+
+	var calendarID valueobject.CalendarID = "calendar-update-tags-1"
+	require.NoError(t, calendarUsecase.Create(ctx, calendarID, "Test Calendar", nil, nil, []string{"update-tags-a"}))
+
+	testcases := map[string]struct {
+		tags     []string
+		expected []string
+	}{
+		"replace": {
+			tags:     []string{"update-tags-b", "update-tags-c"},
+			expected: []string{"update-tags-b", "update-tags-c"},
+		},
+		"multibyte": {
+			tags:     []string{strings.Repeat("タ", 100)},
+			expected: []string{strings.Repeat("タ", 100)},
+		},
+		"remove all": {
+			tags:     []string{},
+			expected: []string{},
+		},
+	}
+
+	for name, tt := range testcases {
+		// サブテストは同じカレンダーを更新するため、並列実行しない
+		t.Run(name, func(t *testing.T) {
+			// When
+			err := calendarUsecase.Update(ctx, calendarID, nil, nil, nil, &tt.tags)
+			require.NoError(t, err)
+
+			// Then
+			calendar, err := mysqlRepo.GetCalendar(ctx, calendarID)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.expected, calendar.Tags)
+		})
+	}
+}
+
+func TestCalendarUsecase_Create_InvalidTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	calendarUsecase, _ := setupCalendarUsecase(serviceAccountOnly, newAccessibleCalendarMock())
+
+	testcases := map[string]struct {
+		calendarID valueobject.CalendarID
+		tags       []string
+	}{
+		"empty tag": {
+			calendarID: "calendar-invalid-tags-1",
+			tags:       []string{""},
+		},
+		"too long tag": {
+			calendarID: "calendar-invalid-tags-2",
+			tags:       []string{strings.Repeat("a", 101)},
+		},
+		"duplicated tag": {
+			calendarID: "calendar-invalid-tags-3",
+			tags:       []string{"a", "a"},
+		},
+	}
+
+	for name, tt := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// When
+			err := calendarUsecase.Create(ctx, tt.calendarID, "Test Calendar", nil, nil, tt.tags)
+
+			// Then
+			assert.ErrorContains(t, err, "tags is invalid")
+		})
+	}
+}
+
+func TestCalendarUsecase_Get_NotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	calendarUsecase, _ := setupCalendarUsecase(serviceAccountOnly, newAccessibleCalendarMock())
+
+	// When
+	_, err := calendarUsecase.Get(ctx, "calendar-get-not-found-1")
+	require.Error(t, err)
+
+	// Then
+	assert.True(t, errors.Is(err, domain.CalendarNotFoundError))
+}
+
+func TestCalendarUsecase_Update_Success(t *testing.T) {
+	t.Parallel()
+
+	p := func(s string) *string {
+		return &s
+	}
+
+	tests := map[string]struct {
+		authTypes            []valueobject.AuthType
+		calendarID           valueobject.CalendarID
+		name                 *string
+		refreshToken         *string
+		expectedName         string
+		expectedRefreshToken *string
+	}{
+		"update name only": {
+			authTypes:            oauthOnly,
+			calendarID:           "calendar-update-success-1",
+			name:                 p("Updated Calendar 1"),
+			refreshToken:         nil,
+			expectedName:         "Updated Calendar 1",
+			expectedRefreshToken: p("test-refresh-token"),
+		},
+		"update refresh token only": {
+			authTypes:            oauthOnly,
+			calendarID:           "calendar-update-success-2",
+			name:                 nil,
+			refreshToken:         p("updated-refresh-token"),
+			expectedName:         "Test Calendar",
+			expectedRefreshToken: p("updated-refresh-token"),
+		},
+		"update name without oauth": {
+			authTypes:            serviceAccountOnly,
+			calendarID:           "calendar-update-success-3",
+			name:                 p("Updated Calendar 3"),
+			refreshToken:         nil,
+			expectedName:         "Updated Calendar 3",
+			expectedRefreshToken: nil,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			// Given
+			calendarUsecase, _ := setupCalendarUsecase(tt.authTypes, newAccessibleCalendarMock())
+
+			calendar := entity.Calendar{
+				ID:   tt.calendarID,
+				Name: "Test Calendar",
+			}
+			if slices.Contains(tt.authTypes, valueobject.AuthTypeOAuth) {
+				calendar.RefreshToken = p("test-refresh-token")
+			}
+			require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, calendar))
+
+			// When
+			err := calendarUsecase.Update(ctx, tt.calendarID, tt.name, tt.refreshToken, nil, nil)
+			require.NoError(t, err)
+
+			// Then
+			updated, err := mysqlRepo.GetCalendar(ctx, tt.calendarID)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedName, updated.Name)
+			assert.Equal(t, tt.expectedRefreshToken, updated.RefreshToken)
+		})
+	}
+}
+
+func TestCalendarUsecase_Update_ClearsNeedsReauth(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	calendarUsecase, _ := setupCalendarUsecase(oauthOnly, newAccessibleCalendarMock())
+
+	var calendarID valueobject.CalendarID = "calendar-update-clears-needs-reauth-1"
+	oldRefreshToken := "old-refresh-token"
+	needsReauthAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:            calendarID,
+		Name:          "Test Calendar",
+		RefreshToken:  &oldRefreshToken,
+		NeedsReauthAt: &needsReauthAt,
+	}))
+
+	// When
+	newRefreshToken := "new-refresh-token"
+	err := calendarUsecase.Update(ctx, calendarID, nil, &newRefreshToken, nil, nil)
+	require.NoError(t, err)
+
+	// Then
+	calendar, err := mysqlRepo.GetCalendar(ctx, calendarID)
+	require.NoError(t, err)
+	assert.Nil(t, calendar.NeedsReauthAt)
+}
+
+func TestCalendarUsecase_Update_KeepsConcurrentChange(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	var calendarID valueobject.CalendarID = "calendar-update-keeps-concurrent-change-1"
+	oldRefreshToken := "old-refresh-token"
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:           calendarID,
+		Name:         "Test Calendar",
+		RefreshToken: &oldRefreshToken,
+	}))
+
+	mockRepo := newAccessibleCalendarMock()
+	getCalendarMetadata := mockRepo.GetCalendarMetadataFunc
+	calendarUsecase, _ := setupCalendarUsecase(oauthOnly, mockRepo)
+
+	// 新しい認証情報の確認中に、別のリクエストで名前が更新される
+	concurrentName := "Concurrent Calendar"
+	mockRepo.GetCalendarMetadataFunc = func(ctx context.Context,
+		calendarID valueobject.CalendarID, credential entity.Credential) (*entity.CalendarMetadata, error) {
+		if err := calendarUsecase.Update(ctx, calendarID, &concurrentName, nil, nil, nil); err != nil {
+			return nil, err
+		}
+		return getCalendarMetadata(ctx, calendarID, credential)
+	}
+
+	// When
+	newRefreshToken := "new-refresh-token"
+	err := calendarUsecase.Update(ctx, calendarID, nil, &newRefreshToken, nil, nil)
+	require.NoError(t, err)
+
+	// Then
+	calendar, err := mysqlRepo.GetCalendar(ctx, calendarID)
+	require.NoError(t, err)
+	assert.Equal(t, concurrentName, calendar.Name)
+	require.NotNil(t, calendar.RefreshToken)
+	assert.Equal(t, newRefreshToken, *calendar.RefreshToken)
+	assert.Equal(t, "Google Calendar Summary", calendar.Summary)
+}
+
+func TestCalendarUsecase_Update_InvalidatesCredential(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	var invalidatedCalendarIDs []valueobject.CalendarID
+	mockRepo := newAccessibleCalendarMock()
+	mockRepo.InvalidateCredentialFunc = func(calendarID valueobject.CalendarID) {
+		invalidatedCalendarIDs = append(invalidatedCalendarIDs, calendarID)
+	}
+	calendarUsecase, _ := setupCalendarUsecase(oauthOnly, mockRepo)
+
+	var calendarID valueobject.CalendarID = "calendar-update-invalidates-credential-1"
+	oldRefreshToken := "old-refresh-token"
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:           calendarID,
+		Name:         "Test Calendar",
+		RefreshToken: &oldRefreshToken,
+	}))
+
+	// When: only the name is updated
+	name := "Updated Calendar"
+	require.NoError(t, calendarUsecase.Update(ctx, calendarID, &name, nil, nil, nil))
+
+	// Then
+	assert.Empty(t, invalidatedCalendarIDs)
+
+	// When: the refresh token is updated
+	newRefreshToken := "new-refresh-token"
+	require.NoError(t, calendarUsecase.Update(ctx, calendarID, nil, &newRefreshToken, nil, nil))
+
+	// Then
+	assert.Equal(t, []valueobject.CalendarID{calendarID}, invalidatedCalendarIDs)
+}
+
+func TestCalendarUsecase_Update_SwitchesToOAuth(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	calendarUsecase, _ := setupCalendarUsecase(allAuthTypes, newAccessibleCalendarMock())
+
+	var calendarID valueobject.CalendarID = "calendar-update-switches-to-oauth-1"
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:       calendarID,
+		Name:     "Test Calendar",
+		AuthType: valueobject.AuthTypeServiceAccount,
+	}))
+
+	// When
+	refreshToken := "new-refresh-token"
+	err := calendarUsecase.Update(ctx, calendarID, nil, &refreshToken, nil, nil)
+	require.NoError(t, err)
+
+	// Then
+	calendar, err := mysqlRepo.GetCalendar(ctx, calendarID)
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.AuthTypeOAuth, calendar.AuthType)
+	assert.Equal(t, refreshToken, *calendar.RefreshToken)
+}
+
+func TestCalendarUsecase_Update_SwitchesToDelegation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	calendarUsecase, _ := setupCalendarUsecase(allAuthTypes, newAccessibleCalendarMock())
+
+	var calendarID valueobject.CalendarID = "calendar-update-switches-to-delegation-1"
+	refreshToken := "test-refresh-token"
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:           calendarID,
+		Name:         "Test Calendar",
+		RefreshToken: &refreshToken,
+		AuthType:     valueobject.AuthTypeOAuth,
+	}))
+
+	// When
+	subject := "user@example.com"
+	err := calendarUsecase.Update(ctx, calendarID, nil, nil, &subject, nil)
+	require.NoError(t, err)
+
+	// Then
+	calendar, err := mysqlRepo.GetCalendar(ctx, calendarID)
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.AuthTypeDelegation, calendar.AuthType)
+	assert.Equal(t, subject, *calendar.Subject)
+	assert.Nil(t, calendar.RefreshToken)
+}
+
+func TestMysqlRepository_UpdateCalendar_AuthTypeCache(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	var calendarID valueobject.CalendarID = "calendar-update-auth-type-cache-1"
+	refreshToken := "test-refresh-token"
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:           calendarID,
+		Name:         "Test Calendar",
+		RefreshToken: &refreshToken,
+		AuthType:     valueobject.AuthTypeOAuth,
+	}))
+
+	authType, err := mysqlRepo.GetAuthType(ctx, calendarID)
+	require.NoError(t, err)
+	require.Equal(t, valueobject.AuthTypeOAuth, authType)
+
+	subject := "user@example.com"
+	updated := entity.Calendar{ID: calendarID, Name: "Test Calendar", Subject: &subject}
+
+	// When: the transaction is rolled back
+	err = mysqlRepo.RunTransaction(ctx, func(ctx context.Context, tx repository.DatabaseTransaction) error {
+		require.NoError(t, tx.UpdateCalendar(ctx, updated))
+		return errors.New("rollback")
+	})
+	require.Error(t, err)
+
+	// Then: ロールバックされた認証方式はキャッシュに残らない
+	authType, err = mysqlRepo.GetAuthType(ctx, calendarID)
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.AuthTypeOAuth, authType)
+
+	// When: the transaction is committed
+	err = mysqlRepo.RunTransaction(ctx, func(ctx context.Context, tx repository.DatabaseTransaction) error {
+		return tx.UpdateCalendar(ctx, updated)
+	})
+	require.NoError(t, err)
+
+	// Then
+	authType, err = mysqlRepo.GetAuthType(ctx, calendarID)
+	require.NoError(t, err)
+	assert.Equal(t, valueobject.AuthTypeDelegation, authType)
+}
+
+func newEnvelopeCrypt(t *testing.T, key []byte) service.Crypt {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(path, []byte(base64.StdEncoding.EncodeToString(key)), 0o600))
+
+	keyManager, err := service.NewFileKeyManager(path)
+	require.NoError(t, err)
+
+	crypt, err := service.NewEnvelopeCrypt(keyManager, nil)
+	require.NoError(t, err)
+
+	return crypt
+}
+
+func TestMysqlRepository_CreateCalendar_EnvelopeCrypt(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	repo := newMysqlRepositoryWithCrypt(t, newEnvelopeCrypt(t, testCryptKey1))
+
+	var calendarID valueobject.CalendarID = "calendar-create-envelope-crypt-1"
+	// 実際のリフレッシュトークンと同程度の長さにする
+	refreshToken := "1//" + strings.Repeat("a", 100)
+
+	// When
+	err := repo.RunTransaction(ctx, func(ctx context.Context, tx repository.DatabaseTransaction) error {
+		return tx.CreateCalendar(ctx, entity.Calendar{
+			ID:           calendarID,
+			Name:         "Test Calendar",
+			RefreshToken: &refreshToken,
+		})
+	})
+	require.NoError(t, err)
+
+	// Then
+	stored := getStoredRefreshToken(ctx, t, calendarID)
+	assert.True(t, strings.HasPrefix(stored, "env1:"))
+	assert.NotContains(t, stored, refreshToken)
+
+	calendar, err := repo.GetCalendar(ctx, calendarID)
+	require.NoError(t, err)
+	require.NotNil(t, calendar.RefreshToken)
+	assert.Equal(t, refreshToken, *calendar.RefreshToken)
+}
+
+func TestMysqlRepository_GetCalendar_EnvelopeCrypt_Failure(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		calendarID valueobject.CalendarID
+		modify     func(stored string) string
+		key        []byte
+	}{
+		"tampered ciphertext": {
+			calendarID: "calendar-get-envelope-crypt-failure-1",
+			modify: func(stored string) string {
+				// 暗号文の先頭の 1 文字を書き換える
+				i := strings.LastIndex(stored, ":") + 1
+				replaced := "A"
+				if stored[i] == 'A' {
+					replaced = "B"
+				}
+				return stored[:i] + replaced + stored[i+1:]
+			},
+			key: testCryptKey1,
+		},
+		"wrong key": {
+			calendarID: "calendar-get-envelope-crypt-failure-2",
+			modify:     func(stored string) string { return stored },
+			key:        testCryptKey2,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Given
+			refreshToken := "test-refresh-token"
+			err := newMysqlRepositoryWithCrypt(t, newEnvelopeCrypt(t, testCryptKey1)).RunTransaction(ctx,
+				func(ctx context.Context, tx repository.DatabaseTransaction) error {
+					return tx.CreateCalendar(ctx, entity.Calendar{
+						ID:           tt.calendarID,
+						Name:         "Test Calendar",
+						RefreshToken: &refreshToken,
+					})
+				})
+			require.NoError(t, err)
+
+			_, err = testDB.ExecContext(ctx, "UPDATE calendars SET refresh_token = ? WHERE id = ?",
+				tt.modify(getStoredRefreshToken(ctx, t, tt.calendarID)), tt.calendarID)
+			require.NoError(t, err)
+
+			// When
+			_, err = newMysqlRepositoryWithCrypt(t, newEnvelopeCrypt(t, tt.key)).GetCalendar(ctx, tt.calendarID)
+
+			// Then
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestCalendarUsecase_Update_Failure(t *testing.T) {
+	t.Parallel()
+
+	p := func(s string) *string {
+		return &s
+	}
+
+	tests := map[string]struct {
+		authTypes    []valueobject.AuthType
+		calendarID   valueobject.CalendarID
+		name         *string
+		refreshToken *string
+		subject      *string
+		errPrefix    string
+	}{
+		"no fields": {
+			authTypes:  serviceAccountOnly,
+			calendarID: "calendar-update-failure-1",
+			errPrefix:  "name, refreshToken, subject or tags is required",
+		},
+		"empty name": {
+			authTypes:  serviceAccountOnly,
+			calendarID: "calendar-update-failure-2",
+			name:       p(""),
+			errPrefix:  "name is invalid",
+		},
+		"refresh token provided with service account only": {
+			authTypes:    serviceAccountOnly,
+			calendarID:   "calendar-update-failure-3",
+			refreshToken: p("unexpected-token"),
+			errPrefix:    "refreshToken is not allowed",
+		},
+		"subject is not an email": {
+			authTypes:  allAuthTypes,
+			calendarID: "calendar-update-failure-4",
+			subject:    p("user"),
+			errPrefix:  "subject is invalid",
+		},
+		"calendar not found": {
+			authTypes:  serviceAccountOnly,
+			calendarID: "calendar-update-failure-not-found",
+			name:       p("Updated Calendar"),
+			errPrefix:  "fail to get calendar",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			// Given
+			calendarUsecase, _ := setupCalendarUsecase(tt.authTypes, newAccessibleCalendarMock())
+
+			// When
+			err := calendarUsecase.Update(ctx, tt.calendarID, tt.name, tt.refreshToken, tt.subject, nil)
+			require.Error(t, err)
+
+			// Then
+			if !strings.HasPrefix(err.Error(), tt.errPrefix) {
+				t.Errorf("error message does not match the expected prefix, got: %s", err.Error())
+			}
+		})
+	}
+}
+
+func TestCalendarUsecase_Delete_Success(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	stopWatchCalled := false
+	mockRepo := &GoogleCalendarRepositoryMock{
+		StopWatchFunc: func(ctx context.Context, channel entity.Channel) error {
+			stopWatchCalled = true
+			return nil
+		},
+	}
+
+	calendarUsecase, _ := setupCalendarUsecase(serviceAccountOnly, mockRepo)
+
+	var calendarID valueobject.CalendarID = "calendar-delete-success-1"
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:   calendarID,
+		Name: "Test Calendar",
+	}))
+
+	now := mysqlRepo.Clock(t).Now()
+	startTime := now.Add(-1 * time.Hour)
+	require.NoError(t, mysqlRepo.CreateChannelHistory(ctx, t, entity.Channel{
+		CalendarID: calendarID,
+		ResourceID: "active-resource-id",
+		StartTime:  startTime,
+		Expiration: startTime.Add(2 * time.Hour),
+		IsStopped:  false,
+	}))
+	require.NoError(t, mysqlRepo.CreateEvent(ctx, t, entity.Event{
+		CalendarID: calendarID,
+		ID:         "event-1",
+		Summary:    "Test Event",
+		Start:      &now,
+		End:        &now,
+		Status:     "confirmed",
+	}))
+	require.NoError(t, mysqlRepo.CreateSyncHistory(ctx, t, calendarID, now, "sync-token", 1))
+	require.NoError(t, mysqlRepo.CreateSyncFutureInstanceHistory(ctx, t, calendarID, now, 1))
+
+	// When
+	err := calendarUsecase.Delete(ctx, calendarID)
+	require.NoError(t, err)
+
+	// Then
+	assert.True(t, stopWatchCalled)
+
+	_, err = mysqlRepo.GetCalendar(ctx, calendarID)
+	assert.True(t, errors.Is(err, domain.CalendarNotFoundError))
+
+	events, err := mysqlRepo.ListEvents(ctx, t, calendarID)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+
+	latestSync, err := mysqlRepo.GetLatestSyncHistory(ctx, calendarID)
+	require.NoError(t, err)
+	assert.Nil(t, latestSync)
+}
+
+func TestCalendarUsecase_Delete_NotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	calendarUsecase, _ := setupCalendarUsecase(serviceAccountOnly, newAccessibleCalendarMock())
+
+	// When
+	err := calendarUsecase.Delete(ctx, "calendar-delete-not-found-1")
+	require.Error(t, err)
+
+	// Then
+	assert.True(t, errors.Is(err, domain.CalendarNotFoundError))
+}
+
+func TestCalendarUsecase_BulkCreate_Success(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	watchedCalendarIDs := []valueobject.CalendarID{}
+	mockRepo := newAccessibleCalendarMock()
+	mockRepo.WatchFunc = func(ctx context.Context, calendarID valueobject.CalendarID) (*entity.Channel, error) {
+		watchedCalendarIDs = append(watchedCalendarIDs, calendarID)
+		now := time.Now()
+		return &entity.Channel{
+			CalendarID: calendarID,
+			ResourceID: "resource-id",
+			StartTime:  now,
+			Expiration: now.Add(1 * time.Hour),
+		}, nil
+	}
+
+	calendarUsecase, _ := setupCalendarUsecase(serviceAccountOnly, mockRepo)
+
+	calendars := []entity.Calendar{
+		{ID: "calendar-bulk-create-success-1", Name: "Test Calendar 1"},
+		{ID: "calendar-bulk-create-success-2", Name: "Test Calendar 2"},
+	}
+
+	// When
+	watchFailures, err := calendarUsecase.BulkCreate(ctx, calendars, true)
+	require.NoError(t, err)
+
+	// Then
+	assert.Empty(t, watchFailures)
+	for _, calendar := range calendars {
+		created, err := mysqlRepo.GetCalendar(ctx, calendar.ID)
+		require.NoError(t, err)
+		assert.Equal(t, calendar.Name, created.Name)
+
+		_, err = mysqlRepo.GetLatestChannelHistory(ctx, t, calendar.ID)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, []valueobject.CalendarID{calendars[0].ID, calendars[1].ID}, watchedCalendarIDs)
+}
+
+func TestCalendarUsecase_BulkCreate_WatchFailure(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	var failedCalendarID valueobject.CalendarID = "calendar-bulk-create-watch-failure-1"
+	watchErr := errors.New("fail to watch: googleapi: Error 403: forbidden")
+	mockRepo := newAccessibleCalendarMock()
+	mockRepo.WatchFunc = func(ctx context.Context, calendarID valueobject.CalendarID) (*entity.Channel, error) {
+		if calendarID == failedCalendarID {
+			return nil, watchErr
+		}
+		now := time.Now()
+		return &entity.Channel{
+			CalendarID: calendarID,
+			ResourceID: "resource-id",
+			StartTime:  now,
+			Expiration: now.Add(1 * time.Hour),
+		}, nil
+	}
+
+	calendarUsecase, _ := setupCalendarUsecase(serviceAccountOnly, mockRepo)
+
+	calendars := []entity.Calendar{
+		{ID: failedCalendarID, Name: "Test Calendar 1"},
+		{ID: "calendar-bulk-create-watch-failure-2", Name: "Test Calendar 2"},
+	}
+
+	// When
+	watchFailures, err := calendarUsecase.BulkCreate(ctx, calendars, true)
+	require.NoError(t, err)
+
+	// Then
+	require.Len(t, watchFailures, 1)
+	assert.Equal(t, failedCalendarID, watchFailures[0].CalendarID)
+	assert.ErrorIs(t, watchFailures[0].Err, watchErr)
+
+	// Verify all calendars are registered and the other calendar is watched
+	for _, calendar := range calendars {
+		_, err := mysqlRepo.GetCalendar(ctx, calendar.ID)
+		require.NoError(t, err)
+	}
+	_, err = mysqlRepo.GetLatestChannelHistory(ctx, t, calendars[1].ID)
+	require.NoError(t, err)
+}
+
+func TestCalendarUsecase_BulkCreate_DuplicateError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	calendarUsecase, _ := setupCalendarUsecase(serviceAccountOnly, newAccessibleCalendarMock())
+
+	var duplicateCalendarID valueobject.CalendarID = "calendar-bulk-create-duplicate-2"
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:   duplicateCalendarID,
+		Name: "Existing Calendar",
+	}))
+
+	calendars := []entity.Calendar{
+		{ID: "calendar-bulk-create-duplicate-1", Name: "Test Calendar 1"},
+		{ID: duplicateCalendarID, Name: "Test Calendar 2"},
+	}
+
+	// When
+	_, err := calendarUsecase.BulkCreate(ctx, calendars, false)
+	require.Error(t, err)
+
+	// Then
+	// Verify all registrations were rolled back
+	assert.True(t, errors.Is(err, domain.CalendarAlreadyExistError))
+
+	_, err = mysqlRepo.GetCalendar(ctx, calendars[0].ID)
+	assert.True(t, errors.Is(err, domain.CalendarNotFoundError))
+}
+
+func TestCalendarUsecase_Discover(t *testing.T) {
+	t.Parallel()
+
+	p := func(s string) *string {
+		return &s
+	}
+
+	tests := map[string]struct {
+		authTypes    []valueobject.AuthType
+		refreshToken *string
+		errPrefix    string
+	}{
+		"service account": {
+			authTypes:    serviceAccountOnly,
+			refreshToken: nil,
+		},
+		"oauth with refresh token": {
+			authTypes:    oauthOnly,
+			refreshToken: p("test-refresh-token"),
+		},
+		"oauth without refresh token": {
+			authTypes:    oauthOnly,
+			refreshToken: nil,
+			errPrefix:    "refreshToken is required",
+		},
+		"service account with refresh token": {
+			authTypes:    serviceAccountOnly,
+			refreshToken: p("unexpected-token"),
+			errPrefix:    "refreshToken is not allowed",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			// Given
+			entries := []entity.CalendarListEntry{
+				{ID: "discover-1", Summary: "Calendar 1", AccessRole: "owner", Primary: true, TimeZone: "Asia/Tokyo"},
+				{ID: "discover-2", Summary: "Calendar 2", AccessRole: "reader", TimeZone: "UTC"},
+			}
+			mockRepo := &GoogleCalendarRepositoryMock{
+				ListCalendarListFunc: func(ctx context.Context, credential entity.Credential) ([]entity.CalendarListEntry, error) {
+					return entries, nil
+				},
+			}
+
+			calendarUsecase, _ := setupCalendarUsecase(tt.authTypes, mockRepo)
+
+			// When
+			result, err := calendarUsecase.Discover(ctx, entity.Credential{RefreshToken: tt.refreshToken})
+
+			// Then
+			if tt.errPrefix != "" {
+				require.Error(t, err)
+				if !strings.HasPrefix(err.Error(), tt.errPrefix) {
+					t.Errorf("error message does not match the expected prefix, got: %s", err.Error())
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, entries, result)
+		})
+	}
+}
+
+func TestCalendarUsecase_PauseAndResume(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	listEventsCount := 0
+	mockRepo := newAccessibleCalendarMock()
+	mockRepo.ListEventsWithAfterFunc = func(ctx context.Context,
+		calendarID valueobject.CalendarID, after time.Time) ([]entity.Event, []entity.RecurringEvent, string, error) {
+		listEventsCount++
+		return []entity.Event{}, []entity.RecurringEvent{}, "new-sync-token", nil
+	}
+
+	calendarUsecase, _ := setupCalendarUsecase(serviceAccountOnly, mockRepo)
+	syncUsecase, _ := setupSyncUsecase(service.NewMockClock(), mockRepo)
+
+	var calendarID valueobject.CalendarID = "calendar-pause-and-resume-1"
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:   calendarID,
+		Name: "Test Calendar",
+	}))
+
+	// When
+	require.NoError(t, calendarUsecase.Pause(ctx, calendarID))
+
+	// Then
+	// Verify the sync is skipped while paused
+	calendar, err := mysqlRepo.GetCalendar(ctx, calendarID)
+	require.NoError(t, err)
+	assert.True(t, calendar.IsPaused)
+
+	require.NoError(t, syncUsecase.Sync(ctx, calendarID))
+	assert.Equal(t, 0, listEventsCount)
+
+	// When
+	require.NoError(t, calendarUsecase.Resume(ctx, calendarID))
+
+	// Then
+	// Verify the catch-up sync was executed
+	calendar, err = mysqlRepo.GetCalendar(ctx, calendarID)
+	require.NoError(t, err)
+	assert.False(t, calendar.IsPaused)
+	assert.Equal(t, 1, listEventsCount)
+
+	latestSync, err := mysqlRepo.GetLatestSyncHistory(ctx, calendarID)
+	require.NoError(t, err)
+	assert.NotNil(t, latestSync)
+}
+
+func TestCalendarUsecase_Pause_NotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	calendarUsecase, _ := setupCalendarUsecase(serviceAccountOnly, newAccessibleCalendarMock())
+
+	// When
+	err := calendarUsecase.Pause(ctx, "calendar-pause-not-found-1")
+	require.Error(t, err)
+
+	// Then
+	assert.True(t, errors.Is(err, domain.CalendarNotFoundError))
+}
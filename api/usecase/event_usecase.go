@@ -0,0 +1,381 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/takuoki/golib/applog"
+	"github.com/takuoki/google-calendar-sync/api/domain"
+	"github.com/takuoki/google-calendar-sync/api/domain/constant"
+	"github.com/takuoki/google-calendar-sync/api/domain/entity"
+	"github.com/takuoki/google-calendar-sync/api/domain/service"
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+	"github.com/takuoki/google-calendar-sync/api/repository"
+)
+
+// maxFreeBusyPeriod is the longest period of a free/busy query.
+const maxFreeBusyPeriod = 90 * 24 * time.Hour
+
+// maxInstancePeriod is the longest period of the instances returned with recurring events.
+const maxInstancePeriod = 366 * 24 * time.Hour
+
+// EventUsecase reads the events synced to the database.
+type EventUsecase interface {
+	// List returns events in ascending order of start time.
+	// Specify nextCursor of the previous call as cursor to get the next page. nextCursor is nil if there are no more events.
+	List(ctx context.Context, calendarID valueobject.CalendarID, filter entity.EventFilter, cursor *string, limit int) (
+		events []entity.Event, nextCursor *string, err error)
+	// ListRecurringEvents returns recurring events in ascending order of ID with their instances.
+	// From, To and IncludeCancelled of the filter are applied to the instances, and IncludeCancelled also to the recurring events.
+	ListRecurringEvents(ctx context.Context, calendarID valueobject.CalendarID, filter entity.EventFilter, cursor *string, limit int) (
+		series []entity.RecurringEventSeries, nextCursor *string, err error)
+	// GetRecurringEvent returns the recurring event with its instances. The filter is applied to the instances.
+	GetRecurringEvent(ctx context.Context, calendarID valueobject.CalendarID, eventID valueobject.EventID, filter entity.EventFilter) (
+		*entity.RecurringEventSeries, error)
+	// Search returns the events matching the query in descending order of relevance.
+	// Specify nextOffset of the previous call as offset to get the next page. nextOffset is nil if there are no more events.
+	Search(ctx context.Context, query entity.EventSearchQuery, offset, limit int) (
+		results []entity.EventSearchResult, nextOffset *int, err error)
+	// FreeBusy returns the merged busy intervals in [from, to) of each calendar, in the order of calendarIDs.
+	FreeBusy(ctx context.Context, calendarIDs []valueobject.CalendarID, from, to time.Time) ([]entity.FreeBusy, error)
+	// FindAvailability returns the ranked slots when all participants are free, computed from the synced events.
+	// Busy of the participants is ignored. If Location of the working hours is nil, the time zone of the calendar is used.
+	FindAvailability(ctx context.Context, participants []entity.AvailabilityParticipant,
+		from, to time.Time, duration, buffer time.Duration, limit int) ([]entity.AvailableSlot, error)
+}
+
+type eventUsecase struct {
+	clockService service.Clock
+	databaseRepo repository.DatabaseRepository
+	logger       applog.Logger
+}
+
+func NewEventUsecase(
+	clockService service.Clock,
+	databaseRepo repository.DatabaseRepository,
+	logger applog.Logger,
+) EventUsecase {
+	return &eventUsecase{
+		clockService: clockService,
+		databaseRepo: databaseRepo,
+		logger:       logger,
+	}
+}
+
+func (u *eventUsecase) List(ctx context.Context, calendarID valueobject.CalendarID,
+	filter entity.EventFilter, cursor *string, limit int) ([]entity.Event, *string, error) {
+
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return nil, nil, domain.InvalidParameterError("to")
+	}
+
+	if filter.Status != nil && !isEventStatus(*filter.Status) {
+		return nil, nil, domain.InvalidParameterError("status")
+	}
+
+	var after *entity.EventCursor
+	if cursor != nil {
+		var err error
+		after, err = entity.ParseEventCursor(*cursor)
+		if err != nil {
+			return nil, nil, domain.InvalidParameterError("cursor")
+		}
+	}
+
+	if _, err := u.databaseRepo.GetCalendar(ctx, calendarID); err != nil {
+		return nil, nil, fmt.Errorf("fail to get calendar: %w", err)
+	}
+
+	// 次のページの有無を判定するため、1 件多く取得する
+	events, err := u.databaseRepo.ListEventsWithFilter(ctx, calendarID, filter, after, limit+1)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to list events: %w", err)
+	}
+
+	if len(events) <= limit {
+		return events, nil, nil
+	}
+
+	events = events[:limit]
+	nextCursor := entity.NewEventCursor(events[limit-1]).String()
+
+	return events, &nextCursor, nil
+}
+
+func (u *eventUsecase) ListRecurringEvents(ctx context.Context, calendarID valueobject.CalendarID,
+	filter entity.EventFilter, cursor *string, limit int) ([]entity.RecurringEventSeries, *string, error) {
+
+	if err := validateInstancePeriod(filter); err != nil {
+		return nil, nil, err
+	}
+
+	var after *valueobject.EventID
+	if cursor != nil {
+		c, err := entity.ParseRecurringEventCursor(*cursor)
+		if err != nil {
+			return nil, nil, domain.InvalidParameterError("cursor")
+		}
+		after = &c.ID
+	}
+
+	if _, err := u.databaseRepo.GetCalendar(ctx, calendarID); err != nil {
+		return nil, nil, fmt.Errorf("fail to get calendar: %w", err)
+	}
+
+	// 次のページの有無を判定するため、1 件多く取得する
+	recurringEvents, err := u.databaseRepo.ListRecurringEvents(ctx, calendarID, filter.IncludeCancelled, after, limit+1)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to list recurring events: %w", err)
+	}
+
+	var nextCursor *string
+	if len(recurringEvents) > limit {
+		recurringEvents = recurringEvents[:limit]
+		c := entity.NewRecurringEventCursor(recurringEvents[limit-1]).String()
+		nextCursor = &c
+	}
+
+	series, err := u.attachInstances(ctx, calendarID, recurringEvents, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return series, nextCursor, nil
+}
+
+func (u *eventUsecase) GetRecurringEvent(ctx context.Context, calendarID valueobject.CalendarID,
+	eventID valueobject.EventID, filter entity.EventFilter) (*entity.RecurringEventSeries, error) {
+
+	if err := validateInstancePeriod(filter); err != nil {
+		return nil, err
+	}
+
+	if _, err := u.databaseRepo.GetCalendar(ctx, calendarID); err != nil {
+		return nil, fmt.Errorf("fail to get calendar: %w", err)
+	}
+
+	recurringEvent, err := u.databaseRepo.GetRecurringEvent(ctx, calendarID, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get recurring event: %w", err)
+	}
+
+	series, err := u.attachInstances(ctx, calendarID, []entity.RecurringEvent{*recurringEvent}, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &series[0], nil
+}
+
+func (u *eventUsecase) attachInstances(ctx context.Context, calendarID valueobject.CalendarID,
+	recurringEvents []entity.RecurringEvent, filter entity.EventFilter) ([]entity.RecurringEventSeries, error) {
+
+	recurringEventIDs := make([]valueobject.EventID, 0, len(recurringEvents))
+	for _, recurringEvent := range recurringEvents {
+		recurringEventIDs = append(recurringEventIDs, recurringEvent.ID)
+	}
+
+	instances, err := u.databaseRepo.ListEventInstances(ctx, calendarID, recurringEventIDs, filter)
+	if err != nil {
+		return nil, fmt.Errorf("fail to list event instances: %w", err)
+	}
+
+	instanceMap := make(map[valueobject.EventID][]entity.Event, len(recurringEvents))
+	for _, instance := range instances {
+		instanceMap[*instance.RecurringEventID] = append(instanceMap[*instance.RecurringEventID], instance)
+	}
+
+	series := make([]entity.RecurringEventSeries, 0, len(recurringEvents))
+	for _, recurringEvent := range recurringEvents {
+		recurrence, err := entity.ParseRecurrence(recurringEvent.Recurrence)
+		if err != nil {
+			return nil, fmt.Errorf("fail to parse recurrence (eventID: %q): %w", recurringEvent.ID, err)
+		}
+
+		eventInstances := instanceMap[recurringEvent.ID]
+		if eventInstances == nil {
+			eventInstances = []entity.Event{}
+		}
+
+		series = append(series, entity.RecurringEventSeries{
+			RecurringEvent: recurringEvent,
+			Recurrence:     *recurrence,
+			Instances:      eventInstances,
+		})
+	}
+
+	return series, nil
+}
+
+func (u *eventUsecase) Search(ctx context.Context, query entity.EventSearchQuery, offset, limit int) (
+	[]entity.EventSearchResult, *int, error) {
+
+	if len(query.Terms) == 0 || slices.ContainsFunc(query.Terms, entity.IsShortSearchTerm) {
+		return nil, nil, domain.InvalidParameterError("q")
+	}
+	if query.Filter.From != nil && query.Filter.To != nil && !query.Filter.From.Before(*query.Filter.To) {
+		return nil, nil, domain.InvalidParameterError("to")
+	}
+
+	if query.CalendarID != nil {
+		if _, err := u.databaseRepo.GetCalendar(ctx, *query.CalendarID); err != nil {
+			return nil, nil, fmt.Errorf("fail to get calendar: %w", err)
+		}
+	}
+
+	// 次のページの有無を判定するため、1 件多く取得する
+	results, err := u.databaseRepo.SearchEvents(ctx, query, offset, limit+1)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to search events: %w", err)
+	}
+
+	if len(results) <= limit {
+		return results, nil, nil
+	}
+
+	nextOffset := offset + limit
+
+	return results[:limit], &nextOffset, nil
+}
+
+func (u *eventUsecase) FreeBusy(ctx context.Context,
+	calendarIDs []valueobject.CalendarID, from, to time.Time) ([]entity.FreeBusy, error) {
+
+	if !from.Before(to) || to.Sub(from) > maxFreeBusyPeriod {
+		return nil, domain.InvalidParameterError("to")
+	}
+
+	if _, err := u.getCalendars(ctx, calendarIDs); err != nil {
+		return nil, err
+	}
+
+	events, err := u.databaseRepo.ListBusyEvents(ctx, calendarIDs, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("fail to list busy events: %w", err)
+	}
+
+	eventMap := make(map[valueobject.CalendarID][]entity.Event, len(calendarIDs))
+	for _, event := range events {
+		eventMap[event.CalendarID] = append(eventMap[event.CalendarID], event)
+	}
+
+	freeBusy := make([]entity.FreeBusy, 0, len(calendarIDs))
+	for _, calendarID := range calendarIDs {
+		freeBusy = append(freeBusy, entity.FreeBusy{
+			CalendarID: calendarID,
+			Busy:       entity.MergeBusyIntervals(eventMap[calendarID], from, to),
+		})
+	}
+
+	return freeBusy, nil
+}
+
+func (u *eventUsecase) FindAvailability(ctx context.Context, participants []entity.AvailabilityParticipant,
+	from, to time.Time, duration, buffer time.Duration, limit int) ([]entity.AvailableSlot, error) {
+
+	if !from.Before(to) || to.Sub(from) > maxFreeBusyPeriod {
+		return nil, domain.InvalidParameterError("to")
+	}
+
+	participants = slices.Clone(participants)
+	calendarIDs := make([]valueobject.CalendarID, 0, len(participants))
+	for _, participant := range participants {
+		calendarIDs = append(calendarIDs, participant.CalendarID)
+	}
+
+	calendarMap, err := u.getCalendars(ctx, calendarIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, participant := range participants {
+		if participant.WorkingHours != nil && participant.WorkingHours.Location == nil {
+			workingHours := *participant.WorkingHours
+			workingHours.Location = u.location(ctx, calendarMap[participant.CalendarID])
+			participants[i].WorkingHours = &workingHours
+		}
+	}
+
+	// バッファ分だけ前後の予定も考慮する
+	events, err := u.databaseRepo.ListBusyEvents(ctx, calendarIDs, from.Add(-buffer), to.Add(buffer))
+	if err != nil {
+		return nil, fmt.Errorf("fail to list busy events: %w", err)
+	}
+
+	eventMap := make(map[valueobject.CalendarID][]entity.Event, len(calendarIDs))
+	for _, event := range events {
+		eventMap[event.CalendarID] = append(eventMap[event.CalendarID], event)
+	}
+
+	for i, participant := range participants {
+		participants[i].Busy = entity.MergeBusyIntervals(eventMap[participant.CalendarID], from.Add(-buffer), to.Add(buffer))
+	}
+
+	return entity.FindAvailableSlots(participants, from, to, duration, buffer, limit), nil
+}
+
+// getCalendars gets the calendars with the IDs in one query.
+// It returns CalendarNotFoundError if any of the calendars does not exist.
+func (u *eventUsecase) getCalendars(ctx context.Context,
+	calendarIDs []valueobject.CalendarID) (map[valueobject.CalendarID]entity.Calendar, error) {
+
+	calendars, err := u.databaseRepo.ListCalendarsWithIDs(ctx, calendarIDs)
+	if err != nil {
+		return nil, fmt.Errorf("fail to list calendars: %w", err)
+	}
+
+	calendarMap := make(map[valueobject.CalendarID]entity.Calendar, len(calendars))
+	for _, calendar := range calendars {
+		calendarMap[calendar.ID] = calendar
+	}
+
+	for _, calendarID := range calendarIDs {
+		if _, ok := calendarMap[calendarID]; !ok {
+			return nil, fmt.Errorf("fail to get calendar (calendarID: %q): %w", calendarID, domain.CalendarNotFoundError)
+		}
+	}
+
+	return calendarMap, nil
+}
+
+// location returns the location of the time zone of the calendar.
+// If the time zone is unknown, the default location of the clock is used.
+func (u *eventUsecase) location(ctx context.Context, calendar entity.Calendar) *time.Location {
+
+	// タイムゾーン取得前に登録されたカレンダーは空となる
+	if calendar.TimeZone == "" {
+		return u.clockService.Now().Location()
+	}
+
+	loc, err := time.LoadLocation(calendar.TimeZone)
+	if err != nil {
+		u.logger.Warnf(ctx, "fail to load location of calendar, use default location (timeZone: %q): %v", calendar.TimeZone, err)
+		return u.clockService.Now().Location()
+	}
+
+	return loc
+}
+
+// isEventStatus reports whether the status is one of the statuses of Google Calendar events.
+func isEventStatus(status string) bool {
+	return slices.Contains([]string{
+		constant.EventStatusConfirmed, constant.EventStatusTentative, constant.EventStatusCancelled}, status)
+}
+
+// validateInstancePeriod requires the period of the instances, since a recurring event may have unlimited instances.
+func validateInstancePeriod(filter entity.EventFilter) error {
+
+	if filter.From == nil {
+		return domain.RequiredError("from")
+	}
+	if filter.To == nil {
+		return domain.RequiredError("to")
+	}
+	if !filter.From.Before(*filter.To) || filter.To.Sub(*filter.From) > maxInstancePeriod {
+		return domain.InvalidParameterError("to")
+	}
+
+	return nil
+}
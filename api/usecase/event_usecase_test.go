@@ -0,0 +1,618 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/takuoki/golib/applog"
+	"github.com/takuoki/google-calendar-sync/api/domain"
+	"github.com/takuoki/google-calendar-sync/api/domain/constant"
+	"github.com/takuoki/google-calendar-sync/api/domain/entity"
+	"github.com/takuoki/google-calendar-sync/api/domain/service"
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+	"github.com/takuoki/google-calendar-sync/api/usecase"
+)
+
+func setupEventUsecase() usecase.EventUsecase {
+	logger, err := applog.NewSimpleLogger(io.Discard)
+	if err != nil {
+		panic("failed to create logger: " + err.Error())
+	}
+
+	return usecase.NewEventUsecase(service.NewMockClock(), mysqlRepo, logger)
+}
+
+func createEventsForList(ctx context.Context, t *testing.T, calendarID valueobject.CalendarID, base time.Time) {
+	t.Helper()
+
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:   calendarID,
+		Name: "Test Calendar",
+	}))
+
+	events := []entity.Event{
+		{ID: "event-3", Summary: "Weekly meeting", Start: toPtr(base.Add(2 * time.Hour)), End: toPtr(base.Add(3 * time.Hour)), Status: "confirmed"},
+		{ID: "event-1", Summary: "Lunch", Start: toPtr(base), End: toPtr(base.Add(time.Hour)), Status: "confirmed"},
+		{ID: "event-2", Summary: "Team meeting", Start: toPtr(base), End: toPtr(base.Add(time.Hour)), Status: "tentative"},
+		{ID: "event-4", Summary: "Cancelled meeting", Start: toPtr(base.Add(4 * time.Hour)), End: toPtr(base.Add(5 * time.Hour)),
+			Status: constant.EventStatusCancelled},
+	}
+	for _, event := range events {
+		event.CalendarID = calendarID
+		require.NoError(t, mysqlRepo.CreateEvent(ctx, t, event))
+	}
+}
+
+func eventIDs(events []entity.Event) []valueobject.EventID {
+	ids := make([]valueobject.EventID, 0, len(events))
+	for _, event := range events {
+		ids = append(ids, event.ID)
+	}
+	return ids
+}
+
+func toPtr[T any](v T) *T {
+	return &v
+}
+
+func TestEventUsecase_List_Pagination(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	base := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	// Given
+	var calendarID valueobject.CalendarID = "list-events-pagination-1"
+	createEventsForList(ctx, t, calendarID, base)
+
+	eventUsecase := setupEventUsecase()
+
+	// When
+	firstPage, nextCursor, err := eventUsecase.List(ctx, calendarID, entity.EventFilter{}, nil, 2)
+	require.NoError(t, err)
+
+	// Then
+	assert.Equal(t, []valueobject.EventID{"event-1", "event-2"}, eventIDs(firstPage))
+	require.NotNil(t, nextCursor)
+
+	// When
+	secondPage, nextCursor, err := eventUsecase.List(ctx, calendarID, entity.EventFilter{}, nextCursor, 2)
+	require.NoError(t, err)
+
+	// Then
+	assert.Equal(t, []valueobject.EventID{"event-3"}, eventIDs(secondPage))
+	assert.Nil(t, nextCursor)
+}
+
+func TestEventUsecase_List_Filter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	base := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	// Given
+	var calendarID valueobject.CalendarID = "list-events-filter-1"
+	createEventsForList(ctx, t, calendarID, base)
+
+	// キャンセルされたインスタンスは開始日時と終了日時を持たない
+	for _, event := range []entity.Event{
+		{ID: "instance-1", RecurringEventID: valueobject.NewEventID("recurring-1"), OriginalStart: toPtr(base.Add(time.Hour)),
+			Status: constant.EventStatusCancelled},
+		{ID: "instance-2", RecurringEventID: valueobject.NewEventID("recurring-1"), OriginalStart: toPtr(base.Add(24 * time.Hour)),
+			Status: constant.EventStatusCancelled},
+	} {
+		event.CalendarID = calendarID
+		require.NoError(t, mysqlRepo.CreateEvent(ctx, t, event))
+	}
+
+	eventUsecase := setupEventUsecase()
+
+	tests := map[string]struct {
+		filter   entity.EventFilter
+		expected []valueobject.EventID
+	}{
+		"period": {
+			filter:   entity.EventFilter{From: toPtr(base.Add(30 * time.Minute)), To: toPtr(base.Add(2 * time.Hour))},
+			expected: []valueobject.EventID{"event-1", "event-2"},
+		},
+		"status": {
+			filter:   entity.EventFilter{Status: toPtr("tentative")},
+			expected: []valueobject.EventID{"event-2"},
+		},
+		"summary": {
+			filter:   entity.EventFilter{Summary: toPtr("meeting")},
+			expected: []valueobject.EventID{"event-2", "event-3"},
+		},
+		"summary with wildcard": {
+			filter:   entity.EventFilter{Summary: toPtr("%")},
+			expected: []valueobject.EventID{},
+		},
+		"include cancelled": {
+			filter:   entity.EventFilter{IncludeCancelled: true},
+			expected: []valueobject.EventID{"instance-1", "instance-2", "event-1", "event-2", "event-3", "event-4"},
+		},
+		"cancelled status": {
+			filter:   entity.EventFilter{Status: toPtr(constant.EventStatusCancelled)},
+			expected: []valueobject.EventID{"instance-1", "instance-2", "event-4"},
+		},
+		"cancelled status in period": {
+			filter: entity.EventFilter{From: toPtr(base), To: toPtr(base.Add(5 * time.Hour)),
+				Status: toPtr(constant.EventStatusCancelled)},
+			expected: []valueobject.EventID{"instance-1", "event-4"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// When
+			events, nextCursor, err := eventUsecase.List(ctx, calendarID, tt.filter, nil, 10)
+
+			// Then
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, eventIDs(events))
+			assert.Nil(t, nextCursor)
+		})
+	}
+}
+
+func TestEventUsecase_List_Failure(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	base := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	// Given
+	var calendarID valueobject.CalendarID = "list-events-failure-1"
+	createEventsForList(ctx, t, calendarID, base)
+
+	eventUsecase := setupEventUsecase()
+
+	tests := map[string]struct {
+		calendarID valueobject.CalendarID
+		filter     entity.EventFilter
+		cursor     *string
+		expected   *domain.ClientError
+	}{
+		"calendar not found": {
+			calendarID: "list-events-failure-not-found",
+			expected:   domain.CalendarNotFoundError,
+		},
+		"invalid cursor": {
+			calendarID: calendarID,
+			cursor:     toPtr("invalid"),
+			expected:   domain.InvalidParameterError("cursor"),
+		},
+		"to not after from": {
+			calendarID: calendarID,
+			filter:     entity.EventFilter{From: toPtr(base), To: toPtr(base)},
+			expected:   domain.InvalidParameterError("to"),
+		},
+		"unknown status": {
+			calendarID: calendarID,
+			filter:     entity.EventFilter{Status: toPtr("deleted")},
+			expected:   domain.InvalidParameterError("status"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// When
+			_, _, err := eventUsecase.List(ctx, tt.calendarID, tt.filter, tt.cursor, 10)
+
+			// Then
+			var clientErr *domain.ClientError
+			require.True(t, errors.As(err, &clientErr))
+			assert.Equal(t, tt.expected, clientErr)
+		})
+	}
+}
+
+func createRecurringEventsForList(ctx context.Context, t *testing.T, calendarID valueobject.CalendarID, base time.Time) {
+	t.Helper()
+
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:   calendarID,
+		Name: "Test Calendar",
+	}))
+
+	recurringEvents := []entity.RecurringEvent{
+		{ID: "recurring-1", Summary: "Weekly meeting", Recurrence: `["RRULE:FREQ=WEEKLY;BYDAY=WE"]`,
+			Start: toPtr(base), End: toPtr(base.Add(time.Hour)), Status: "confirmed"},
+		{ID: "recurring-2", Summary: "Daily standup", Recurrence: `["RRULE:FREQ=DAILY;COUNT=3"]`,
+			Start: toPtr(base), End: toPtr(base.Add(15 * time.Minute)), Status: "confirmed"},
+		{ID: "recurring-3", Summary: "Cancelled series", Recurrence: `["RRULE:FREQ=MONTHLY"]`,
+			Start: toPtr(base), End: toPtr(base.Add(time.Hour)), Status: constant.EventStatusCancelled},
+	}
+	for _, recurringEvent := range recurringEvents {
+		recurringEvent.CalendarID = calendarID
+		require.NoError(t, mysqlRepo.CreateRecurringEvent(ctx, t, recurringEvent))
+	}
+
+	week := 7 * 24 * time.Hour
+	instances := []entity.Event{
+		{ID: "recurring-1_1", RecurringEventID: valueobject.NewEventID("recurring-1"), Summary: "Weekly meeting",
+			Start: toPtr(base), End: toPtr(base.Add(time.Hour)), OriginalStart: toPtr(base), Status: "confirmed"},
+		{ID: "recurring-1_2", RecurringEventID: valueobject.NewEventID("recurring-1"), Summary: "Weekly meeting",
+			Start: toPtr(base.Add(week + time.Hour)), End: toPtr(base.Add(week + 2*time.Hour)), OriginalStart: toPtr(base.Add(week)),
+			Status: "confirmed"},
+		{ID: "recurring-1_3", RecurringEventID: valueobject.NewEventID("recurring-1"), Summary: "Weekly meeting",
+			Start: toPtr(base.Add(2 * week)), End: toPtr(base.Add(2*week + time.Hour)), OriginalStart: toPtr(base.Add(2 * week)),
+			Status: constant.EventStatusCancelled},
+		{ID: "recurring-2_1", RecurringEventID: valueobject.NewEventID("recurring-2"), Summary: "Daily standup",
+			Start: toPtr(base), End: toPtr(base.Add(15 * time.Minute)), OriginalStart: toPtr(base), Status: "confirmed"},
+	}
+	for _, instance := range instances {
+		instance.CalendarID = calendarID
+		require.NoError(t, mysqlRepo.CreateEvent(ctx, t, instance))
+	}
+}
+
+func TestEventUsecase_ListRecurringEvents_Pagination(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	base := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	// Given
+	var calendarID valueobject.CalendarID = "list-recurring-events-pagination-1"
+	createRecurringEventsForList(ctx, t, calendarID, base)
+
+	eventUsecase := setupEventUsecase()
+	filter := entity.EventFilter{From: toPtr(base), To: toPtr(base.Add(30 * 24 * time.Hour))}
+
+	// When
+	firstPage, nextCursor, err := eventUsecase.ListRecurringEvents(ctx, calendarID, filter, nil, 1)
+	require.NoError(t, err)
+
+	// Then
+	require.Len(t, firstPage, 1)
+	assert.Equal(t, valueobject.EventID("recurring-1"), firstPage[0].RecurringEvent.ID)
+	assert.Equal(t, []valueobject.EventID{"recurring-1_1", "recurring-1_2"}, eventIDs(firstPage[0].Instances))
+	assert.Equal(t, "WEEKLY", firstPage[0].Recurrence.Rules[0].Freq)
+	require.NotNil(t, nextCursor)
+
+	// When
+	secondPage, nextCursor, err := eventUsecase.ListRecurringEvents(ctx, calendarID, filter, nextCursor, 1)
+	require.NoError(t, err)
+
+	// Then
+	require.Len(t, secondPage, 1)
+	assert.Equal(t, valueobject.EventID("recurring-2"), secondPage[0].RecurringEvent.ID)
+	assert.Equal(t, []valueobject.EventID{"recurring-2_1"}, eventIDs(secondPage[0].Instances))
+	assert.Nil(t, nextCursor)
+}
+
+func TestEventUsecase_GetRecurringEvent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	base := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	// Given
+	var calendarID valueobject.CalendarID = "get-recurring-event-1"
+	createRecurringEventsForList(ctx, t, calendarID, base)
+
+	eventUsecase := setupEventUsecase()
+	filter := entity.EventFilter{From: toPtr(base), To: toPtr(base.Add(30 * 24 * time.Hour))}
+
+	// When
+	series, err := eventUsecase.GetRecurringEvent(ctx, calendarID, "recurring-1",
+		entity.EventFilter{From: filter.From, To: filter.To, IncludeCancelled: true})
+	require.NoError(t, err)
+
+	// Then
+	assert.Equal(t, "Weekly meeting", series.RecurringEvent.Summary)
+	require.Len(t, series.Instances, 3)
+	assert.False(t, series.Instances[0].IsModifiedFrom(series.RecurringEvent))
+	assert.True(t, series.Instances[1].IsModifiedFrom(series.RecurringEvent))
+	assert.Equal(t, constant.EventStatusCancelled, series.Instances[2].Status)
+
+	// When
+	_, err = eventUsecase.GetRecurringEvent(ctx, calendarID, "not-found", filter)
+
+	// Then
+	assert.ErrorIs(t, err, domain.RecurringEventNotFoundError)
+}
+
+func TestEventUsecase_ListRecurringEvents_Failure(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	base := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	// Given
+	var calendarID valueobject.CalendarID = "list-recurring-events-failure-1"
+	createRecurringEventsForList(ctx, t, calendarID, base)
+
+	eventUsecase := setupEventUsecase()
+
+	tests := map[string]struct {
+		filter   entity.EventFilter
+		cursor   *string
+		expected *domain.ClientError
+	}{
+		"without from": {
+			filter:   entity.EventFilter{To: toPtr(base)},
+			expected: domain.RequiredError("from"),
+		},
+		"without to": {
+			filter:   entity.EventFilter{From: toPtr(base)},
+			expected: domain.RequiredError("to"),
+		},
+		"too long period": {
+			filter:   entity.EventFilter{From: toPtr(base), To: toPtr(base.Add(367 * 24 * time.Hour))},
+			expected: domain.InvalidParameterError("to"),
+		},
+		"raw id as cursor": {
+			filter:   entity.EventFilter{From: toPtr(base), To: toPtr(base.Add(time.Hour))},
+			cursor:   toPtr("recurring-1"),
+			expected: domain.InvalidParameterError("cursor"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// When
+			_, _, err := eventUsecase.ListRecurringEvents(ctx, calendarID, tt.filter, tt.cursor, 10)
+
+			// Then
+			var clientErr *domain.ClientError
+			require.True(t, errors.As(err, &clientErr))
+			assert.Equal(t, tt.expected, clientErr)
+		})
+	}
+}
+
+func TestEventUsecase_FreeBusy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	base := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	at := func(hour int) *time.Time {
+		return toPtr(base.Add(time.Duration(hour) * time.Hour))
+	}
+
+	// Given
+	var calendarID1 valueobject.CalendarID = "free-busy-1"
+	var calendarID2 valueobject.CalendarID = "free-busy-2"
+	for _, calendarID := range []valueobject.CalendarID{calendarID1, calendarID2} {
+		require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+			ID:   calendarID,
+			Name: "Test Calendar",
+		}))
+	}
+
+	events := []entity.Event{
+		{CalendarID: calendarID1, ID: "busy-1", Start: at(9), End: at(11), Transparency: constant.EventTransparencyOpaque, Status: "confirmed"},
+		{CalendarID: calendarID1, ID: "busy-2", Start: at(10), End: at(12), Transparency: constant.EventTransparencyOpaque, Status: "confirmed"},
+		{CalendarID: calendarID1, ID: "transparent", Start: at(13), End: at(14), Transparency: constant.EventTransparencyTransparent,
+			Status: "confirmed"},
+		{CalendarID: calendarID1, ID: "cancelled", Start: at(15), End: at(16), Transparency: constant.EventTransparencyOpaque,
+			Status: constant.EventStatusCancelled},
+		{CalendarID: calendarID2, ID: "all-day", Start: at(0), End: at(24), Transparency: constant.EventTransparencyOpaque,
+			AllDay: true, Status: "confirmed"},
+	}
+	for _, event := range events {
+		require.NoError(t, mysqlRepo.CreateEvent(ctx, t, event))
+	}
+
+	eventUsecase := setupEventUsecase()
+
+	// When
+	freeBusy, err := eventUsecase.FreeBusy(ctx, []valueobject.CalendarID{calendarID1, calendarID2}, *at(8), *at(18))
+	require.NoError(t, err)
+
+	// Then
+	require.Len(t, freeBusy, 2)
+	assert.Equal(t, calendarID1, freeBusy[0].CalendarID)
+	require.Len(t, freeBusy[0].Busy, 1)
+	assert.True(t, assertEqualTime(t, at(9), &freeBusy[0].Busy[0].Start))
+	assert.True(t, assertEqualTime(t, at(12), &freeBusy[0].Busy[0].End))
+	assert.Equal(t, calendarID2, freeBusy[1].CalendarID)
+	require.Len(t, freeBusy[1].Busy, 1)
+	assert.True(t, assertEqualTime(t, at(8), &freeBusy[1].Busy[0].Start))
+	assert.True(t, assertEqualTime(t, at(18), &freeBusy[1].Busy[0].End))
+
+	// When
+	_, err = eventUsecase.FreeBusy(ctx, []valueobject.CalendarID{calendarID1, "free-busy-not-found"}, *at(8), *at(18))
+
+	// Then
+	assert.ErrorIs(t, err, domain.CalendarNotFoundError)
+}
+
+func TestEventUsecase_FindAvailability(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	base := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC) // 月曜日
+	at := func(hour int) *time.Time {
+		return toPtr(base.Add(time.Duration(hour) * time.Hour))
+	}
+
+	// Given
+	var calendarID1 valueobject.CalendarID = "availability-1"
+	var calendarID2 valueobject.CalendarID = "availability-2"
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:       calendarID1,
+		Name:     "Test Calendar",
+		TimeZone: "UTC",
+	}))
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:       calendarID2,
+		Name:     "Test Calendar",
+		TimeZone: "Asia/Tokyo",
+	}))
+
+	events := []entity.Event{
+		{CalendarID: calendarID1, ID: "busy", Start: at(2), End: at(3), Transparency: constant.EventTransparencyOpaque, Status: "confirmed"},
+		{CalendarID: calendarID2, ID: "transparent", Start: at(0), End: at(1), Transparency: constant.EventTransparencyTransparent,
+			Status: "confirmed"},
+	}
+	for _, event := range events {
+		require.NoError(t, mysqlRepo.CreateEvent(ctx, t, event))
+	}
+
+	// calendarID2 の勤務時間は 09:00-13:00 JST (00:00-04:00 UTC)
+	participants := []entity.AvailabilityParticipant{
+		{CalendarID: calendarID1},
+		{CalendarID: calendarID2, WorkingHours: &entity.WorkingHours{
+			Start: 9 * time.Hour, End: 13 * time.Hour, Weekdays: []time.Weekday{time.Monday},
+		}},
+	}
+
+	eventUsecase := setupEventUsecase()
+
+	// When
+	slots, err := eventUsecase.FindAvailability(ctx, participants, *at(0), *at(24), time.Hour, 30*time.Minute, 10)
+	require.NoError(t, err)
+
+	// Then
+	// 空き時間は 00:00-01:30 UTC のみ (予定の前後 30 分はバッファ)
+	require.Len(t, slots, 3)
+	assert.True(t, assertEqualTime(t, toPtr(at(0).Add(15*time.Minute)), &slots[0].Start))
+	assert.Equal(t, 15*time.Minute, slots[0].Margin)
+	assert.True(t, assertEqualTime(t, at(0), &slots[1].Start))
+	assert.True(t, assertEqualTime(t, toPtr(at(0).Add(30*time.Minute)), &slots[2].Start))
+	assert.Nil(t, participants[1].WorkingHours.Location)
+
+	// When
+	_, err = eventUsecase.FindAvailability(ctx, []entity.AvailabilityParticipant{{CalendarID: "availability-not-found"}},
+		*at(0), *at(24), time.Hour, 0, 10)
+
+	// Then
+	assert.ErrorIs(t, err, domain.CalendarNotFoundError)
+}
+
+func TestEventUsecase_Search(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	base := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	at := func(day, hour int) *time.Time {
+		return toPtr(base.AddDate(0, 0, day).Add(time.Duration(hour) * time.Hour))
+	}
+
+	// Given
+	var calendarID valueobject.CalendarID = "search"
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:   calendarID,
+		Name: "Test Calendar",
+	}))
+
+	events := []entity.Event{
+		{CalendarID: calendarID, ID: "search-summary", Summary: "Falcon review", Description: "Review the Falcon roadmap",
+			Start: at(0, 9), End: at(0, 10), Transparency: constant.EventTransparencyOpaque, Status: "confirmed"},
+		{CalendarID: calendarID, ID: "search-location", Summary: "Weekly sync", Location: "Falcon review room",
+			Start: at(1, 9), End: at(1, 10), Transparency: constant.EventTransparencyOpaque, Status: "confirmed"},
+		{CalendarID: calendarID, ID: "search-partial", Summary: "Falcon kickoff",
+			Start: at(2, 9), End: at(2, 10), Transparency: constant.EventTransparencyOpaque, Status: "confirmed"},
+		{CalendarID: calendarID, ID: "search-cancelled", Summary: "Falcon review",
+			Start: at(3, 9), End: at(3, 10), Transparency: constant.EventTransparencyOpaque, Status: constant.EventStatusCancelled},
+		{CalendarID: calendarID, ID: "search-later", Summary: "Falcon review",
+			Start: at(30, 9), End: at(30, 10), Transparency: constant.EventTransparencyOpaque, Status: "confirmed"},
+		{CalendarID: calendarID, ID: "search-japanese", Summary: "ファルコン定例会議",
+			Start: at(4, 9), End: at(4, 10), Transparency: constant.EventTransparencyOpaque, Status: "confirmed"},
+		{CalendarID: calendarID, ID: "search-stopword", Summary: "Main database migration",
+			Start: at(5, 9), End: at(5, 10), Transparency: constant.EventTransparencyOpaque, Status: "confirmed"},
+	}
+	for _, event := range events {
+		require.NoError(t, mysqlRepo.CreateEvent(ctx, t, event))
+	}
+
+	eventUsecase := setupEventUsecase()
+	query := entity.EventSearchQuery{
+		Terms:      entity.ParseSearchTerms("falcon REVIEW"),
+		CalendarID: &calendarID,
+		Filter:     entity.EventFilter{From: at(0, 0), To: at(7, 0)},
+	}
+
+	// When
+	results, nextOffset, err := eventUsecase.Search(ctx, query, 0, 10)
+	require.NoError(t, err)
+
+	// Then: 出現回数の多いイベントが先に並ぶ
+	require.Len(t, results, 2)
+	assert.Equal(t, valueobject.EventID("search-summary"), results[0].Event.ID)
+	assert.Equal(t, "Review the Falcon roadmap", results[0].Event.Description)
+	assert.Equal(t, valueobject.EventID("search-location"), results[1].Event.ID)
+	assert.Equal(t, "Falcon review room", results[1].Event.Location)
+	assert.Greater(t, results[0].Score, results[1].Score)
+	assert.Nil(t, nextOffset)
+
+	// When
+	results, nextOffset, err = eventUsecase.Search(ctx, query, 0, 1)
+	require.NoError(t, err)
+
+	// Then
+	require.Len(t, results, 1)
+	assert.Equal(t, valueobject.EventID("search-summary"), results[0].Event.ID)
+	require.NotNil(t, nextOffset)
+	assert.Equal(t, 1, *nextOffset)
+
+	// When
+	results, nextOffset, err = eventUsecase.Search(ctx, query, *nextOffset, 1)
+	require.NoError(t, err)
+
+	// Then
+	require.Len(t, results, 1)
+	assert.Equal(t, valueobject.EventID("search-location"), results[0].Event.ID)
+	assert.Nil(t, nextOffset)
+
+	// When
+	query.Filter.IncludeCancelled = true
+	results, _, err = eventUsecase.Search(ctx, query, 0, 10)
+	require.NoError(t, err)
+
+	// Then
+	assert.Len(t, results, 3)
+
+	// When
+	results, _, err = eventUsecase.Search(ctx, entity.EventSearchQuery{
+		Terms:      entity.ParseSearchTerms("定例"),
+		CalendarID: &calendarID,
+	}, 0, 10)
+	require.NoError(t, err)
+
+	// Then
+	require.Len(t, results, 1)
+	assert.Equal(t, valueobject.EventID("search-japanese"), results[0].Event.ID)
+
+	// When: "data" と "main" はストップワード ("at", "in") を含むトークンに分割される
+	results, _, err = eventUsecase.Search(ctx, entity.EventSearchQuery{
+		Terms:      entity.ParseSearchTerms("data main"),
+		CalendarID: &calendarID,
+	}, 0, 10)
+	require.NoError(t, err)
+
+	// Then
+	require.Len(t, results, 1)
+	assert.Equal(t, valueobject.EventID("search-stopword"), results[0].Event.ID)
+
+	for _, q := range []string{"a b", "falcon X", " "} {
+		// When: 短すぎる語は無視せずにエラーとする
+		_, _, err = eventUsecase.Search(ctx, entity.EventSearchQuery{Terms: entity.ParseSearchTerms(q)}, 0, 10)
+
+		// Then
+		var clientErr *domain.ClientError
+		require.ErrorAs(t, err, &clientErr, "q: %q", q)
+		assert.Equal(t, domain.ErrorCodeInvalidParameter, clientErr.Code, "q: %q", q)
+	}
+
+	// When
+	notFound := valueobject.CalendarID("search-not-found")
+	_, _, err = eventUsecase.Search(ctx, entity.EventSearchQuery{Terms: query.Terms, CalendarID: &notFound}, 0, 10)
+
+	// Then
+	assert.ErrorIs(t, err, domain.CalendarNotFoundError)
+}
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/takuoki/golib/applog"
+	"github.com/takuoki/google-calendar-sync/api/domain"
+	"github.com/takuoki/google-calendar-sync/api/domain/entity"
+	"github.com/takuoki/google-calendar-sync/api/repository"
+)
+
+// ExportUsecase exports the data synced to the database into files for analysis.
+type ExportUsecase interface {
+	// Export writes the events or recurring events matching the query to w.
+	// Rows are written while they are read from the database, so nothing is written if an error is returned before the first row.
+	Export(ctx context.Context, w io.Writer, query entity.ExportQuery) error
+}
+
+type exportUsecase struct {
+	databaseRepo repository.DatabaseRepository
+	logger       applog.Logger
+}
+
+func NewExportUsecase(
+	databaseRepo repository.DatabaseRepository,
+	logger applog.Logger,
+) ExportUsecase {
+	return &exportUsecase{
+		databaseRepo: databaseRepo,
+		logger:       logger,
+	}
+}
+
+func (u *exportUsecase) Export(ctx context.Context, w io.Writer, query entity.ExportQuery) error {
+
+	if entity.ExportColumns(query.Target) == nil {
+		return domain.InvalidParameterError("target")
+	}
+	if query.Format != entity.ExportFormatCSV && query.Format != entity.ExportFormatJSONL {
+		return domain.InvalidParameterError("format")
+	}
+	if query.Filter.From != nil && query.Filter.To != nil && !query.Filter.From.Before(*query.Filter.To) {
+		return domain.InvalidParameterError("to")
+	}
+
+	var calendars []entity.Calendar
+	if query.CalendarID != nil {
+		calendar, err := u.databaseRepo.GetCalendar(ctx, *query.CalendarID)
+		if err != nil {
+			return fmt.Errorf("fail to get calendar: %w", err)
+		}
+		calendars = []entity.Calendar{*calendar}
+	} else {
+		var err error
+		calendars, err = u.databaseRepo.ListCalendars(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("fail to list calendars: %w", err)
+		}
+	}
+
+	loc := query.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	ew, err := entity.NewExportWriter(w, query.Target, query.Format, query.Columns, loc)
+	if err != nil {
+		return domain.InvalidParameterError("columns")
+	}
+
+	// 終日の予定の日付は、カレンダーのタイムゾーンで書き出す
+	for _, calendar := range calendars {
+		// タイムゾーン取得前に登録されたカレンダーは空となる
+		if calendar.TimeZone == "" {
+			continue
+		}
+		calendarLoc, err := time.LoadLocation(calendar.TimeZone)
+		if err != nil {
+			u.logger.Warnf(ctx, "fail to load location of calendar, use the time zone of the export (timeZone: %q): %v",
+				calendar.TimeZone, err)
+			continue
+		}
+		ew.SetCalendarLocation(calendar.ID, calendarLoc)
+	}
+
+	switch query.Target {
+	case entity.ExportTargetEvents:
+		err = u.databaseRepo.ForEachEvent(ctx, query.CalendarID, query.Filter, ew.WriteEvent)
+	case entity.ExportTargetRecurringEvents:
+		err = u.databaseRepo.ForEachRecurringEvent(ctx, query.CalendarID, query.Filter.To, query.Filter.IncludeCancelled,
+			ew.WriteRecurringEvent)
+	}
+	if err != nil {
+		return fmt.Errorf("fail to export %s: %w", query.Target, err)
+	}
+
+	if err := ew.Flush(); err != nil {
+		return fmt.Errorf("fail to flush export: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package usecase_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/takuoki/golib/applog"
+	"github.com/takuoki/google-calendar-sync/api/domain"
+	"github.com/takuoki/google-calendar-sync/api/domain/constant"
+	"github.com/takuoki/google-calendar-sync/api/domain/entity"
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+	"github.com/takuoki/google-calendar-sync/api/usecase"
+)
+
+func setupExportUsecase() usecase.ExportUsecase {
+	logger, err := applog.NewSimpleLogger(io.Discard)
+	if err != nil {
+		panic("failed to create logger: " + err.Error())
+	}
+
+	return usecase.NewExportUsecase(mysqlRepo, logger)
+}
+
+func TestExportUsecase_Export(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	base := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	at := func(hour int) *time.Time {
+		return toPtr(base.Add(time.Duration(hour) * time.Hour))
+	}
+
+	// Given
+	var calendarID valueobject.CalendarID = "export"
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:       calendarID,
+		Name:     "Test Calendar",
+		TimeZone: "Pacific/Auckland",
+	}))
+
+	var recurringEventID valueobject.EventID = "export-recurring"
+	require.NoError(t, mysqlRepo.CreateRecurringEvent(ctx, t, entity.RecurringEvent{
+		CalendarID: calendarID,
+		ID:         recurringEventID,
+		Summary:    "Weekly",
+		Recurrence: `["RRULE:FREQ=WEEKLY"]`,
+		Start:      at(9),
+		End:        at(10),
+		Status:     "confirmed",
+	}))
+
+	events := []entity.Event{
+		{CalendarID: calendarID, ID: "export-2", Summary: "Second", Start: at(13), End: at(14),
+			Transparency: constant.EventTransparencyOpaque, Status: "confirmed"},
+		{CalendarID: calendarID, ID: "export-1", RecurringEventID: &recurringEventID, Summary: "Weekly", Start: at(9), End: at(10),
+			OriginalStart: at(9), Transparency: constant.EventTransparencyOpaque, Status: "confirmed"},
+		{CalendarID: calendarID, ID: "export-cancelled", Summary: "Cancelled", Start: at(11), End: at(12),
+			Transparency: constant.EventTransparencyOpaque, Status: constant.EventStatusCancelled},
+		// 2025-01-06 の終日の予定 (Pacific/Auckland は UTC+13)
+		{CalendarID: calendarID, ID: "export-all-day", Summary: "Holiday", Start: at(-13), End: at(11), AllDay: true,
+			Transparency: constant.EventTransparencyTransparent, Status: "confirmed"},
+		{CalendarID: calendarID, ID: "export-out-of-range", Summary: "Tomorrow", Start: at(33), End: at(34),
+			Transparency: constant.EventTransparencyOpaque, Status: "confirmed"},
+	}
+	for _, event := range events {
+		require.NoError(t, mysqlRepo.CreateEvent(ctx, t, event))
+	}
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	exportUsecase := setupExportUsecase()
+
+	t.Run("events as csv", func(t *testing.T) {
+		// When
+		var buf bytes.Buffer
+		err := exportUsecase.Export(ctx, &buf, entity.ExportQuery{
+			Target:     entity.ExportTargetEvents,
+			Format:     entity.ExportFormatCSV,
+			CalendarID: &calendarID,
+			Filter:     entity.EventFilter{From: at(0), To: at(24)},
+			Columns:    []string{"id", "recurring_event_id", "start"},
+			Location:   tokyo,
+		})
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, "id,recurring_event_id,start\n"+
+			"export-all-day,,2025-01-06\n"+
+			"export-1,export-recurring,2025-01-06T18:00:00+09:00\n"+
+			"export-2,,2025-01-06T22:00:00+09:00\n", buf.String())
+	})
+
+	t.Run("recurring events as jsonl", func(t *testing.T) {
+		// When
+		var buf bytes.Buffer
+		err := exportUsecase.Export(ctx, &buf, entity.ExportQuery{
+			Target:     entity.ExportTargetRecurringEvents,
+			Format:     entity.ExportFormatJSONL,
+			CalendarID: &calendarID,
+			Columns:    []string{"id", "recurrence"},
+		})
+
+		// Then
+		require.NoError(t, err)
+		assert.Equal(t, `{"id":"export-recurring","recurrence":"[\"RRULE:FREQ=WEEKLY\"]"}`+"\n", buf.String())
+	})
+
+	t.Run("invalid column", func(t *testing.T) {
+		// When
+		var buf bytes.Buffer
+		err := exportUsecase.Export(ctx, &buf, entity.ExportQuery{
+			Target:     entity.ExportTargetRecurringEvents,
+			Format:     entity.ExportFormatCSV,
+			CalendarID: &calendarID,
+			Columns:    []string{"original_start"},
+		})
+
+		// Then
+		var clientErr *domain.ClientError
+		require.ErrorAs(t, err, &clientErr)
+		assert.Equal(t, domain.ErrorCodeInvalidParameter, clientErr.Code)
+		assert.Empty(t, buf.String())
+	})
+
+	t.Run("calendar not found", func(t *testing.T) {
+		// When
+		notFound := valueobject.CalendarID("export-not-found")
+		err := exportUsecase.Export(ctx, io.Discard, entity.ExportQuery{
+			Target:     entity.ExportTargetEvents,
+			Format:     entity.ExportFormatCSV,
+			CalendarID: &notFound,
+		})
+
+		// Then
+		assert.ErrorIs(t, err, domain.CalendarNotFoundError)
+	})
+}
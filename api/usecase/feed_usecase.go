@@ -0,0 +1,218 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/takuoki/golib/applog"
+	"github.com/takuoki/google-calendar-sync/api/domain"
+	"github.com/takuoki/google-calendar-sync/api/domain/entity"
+	"github.com/takuoki/google-calendar-sync/api/domain/service"
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+	"github.com/takuoki/google-calendar-sync/api/repository"
+)
+
+const (
+	// feedPastPeriod is how far back the events are included in the feed.
+	// Recurring events are included regardless of their start time.
+	feedPastPeriod = 365 * 24 * time.Hour
+
+	feedPageSize = 1000
+)
+
+// FeedUsecase publishes the events synced to the database as an iCalendar feed.
+type FeedUsecase interface {
+	// IssueToken publishes the feed of the calendar, and returns the token to access it.
+	// The previous token is invalidated. Only the hash of the token is stored, so it cannot be shown again.
+	IssueToken(ctx context.Context, calendarID valueobject.CalendarID) (string, error)
+	// RevokeToken unpublishes the feed of the calendar.
+	RevokeToken(ctx context.Context, calendarID valueobject.CalendarID) error
+	// Get returns the feed of the calendar in iCalendar format.
+	// FeedNotFoundError is returned if the calendar does not exist, the feed is not published or the token is wrong.
+	Get(ctx context.Context, calendarID valueobject.CalendarID, token string) ([]byte, error)
+}
+
+type feedUsecase struct {
+	clockService service.Clock
+	databaseRepo repository.DatabaseRepository
+	logger       applog.Logger
+}
+
+func NewFeedUsecase(
+	clockService service.Clock,
+	databaseRepo repository.DatabaseRepository,
+	logger applog.Logger,
+) FeedUsecase {
+	return &feedUsecase{
+		clockService: clockService,
+		databaseRepo: databaseRepo,
+		logger:       logger,
+	}
+}
+
+func (u *feedUsecase) IssueToken(ctx context.Context, calendarID valueobject.CalendarID) (string, error) {
+
+	if _, err := u.databaseRepo.GetCalendar(ctx, calendarID); err != nil {
+		return "", fmt.Errorf("fail to get calendar: %w", err)
+	}
+
+	token, err := generateRandomString()
+	if err != nil {
+		return "", fmt.Errorf("fail to generate feed token: %w", err)
+	}
+
+	hash := hashFeedToken(token)
+	err = u.databaseRepo.RunTransaction(ctx, func(ctx context.Context, tx repository.DatabaseTransaction) error {
+		return tx.UpdateFeedTokenHash(ctx, calendarID, &hash)
+	})
+	if err != nil {
+		return "", fmt.Errorf("fail to update feed token hash: %w", err)
+	}
+
+	return token, nil
+}
+
+func (u *feedUsecase) RevokeToken(ctx context.Context, calendarID valueobject.CalendarID) error {
+
+	if _, err := u.databaseRepo.GetCalendar(ctx, calendarID); err != nil {
+		return fmt.Errorf("fail to get calendar: %w", err)
+	}
+
+	err := u.databaseRepo.RunTransaction(ctx, func(ctx context.Context, tx repository.DatabaseTransaction) error {
+		return tx.UpdateFeedTokenHash(ctx, calendarID, nil)
+	})
+	if err != nil {
+		return fmt.Errorf("fail to update feed token hash: %w", err)
+	}
+
+	return nil
+}
+
+func (u *feedUsecase) Get(ctx context.Context, calendarID valueobject.CalendarID, token string) ([]byte, error) {
+
+	if err := u.verifyToken(ctx, calendarID, token); err != nil {
+		return nil, err
+	}
+
+	calendar, err := u.databaseRepo.GetCalendar(ctx, calendarID)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get calendar: %w", err)
+	}
+
+	now := u.clockService.Now()
+
+	recurringEvents, err := u.listAllRecurringEvents(ctx, calendarID)
+	if err != nil {
+		return nil, fmt.Errorf("fail to list recurring events: %w", err)
+	}
+
+	// 終了日時を持たないキャンセルされたインスタンスは、元の開始日時で判定される
+	from := now.Add(-feedPastPeriod)
+	events, err := u.listAllEvents(ctx, calendarID, entity.EventFilter{From: &from, IncludeCancelled: true})
+	if err != nil {
+		return nil, fmt.Errorf("fail to list events: %w", err)
+	}
+
+	instanceMap := map[valueobject.EventID][]entity.Event{}
+	feed := entity.ICalendarFeed{
+		Calendar: *calendar,
+		Series:   make([]entity.RecurringEventSeries, 0, len(recurringEvents)),
+		Events:   []entity.Event{},
+	}
+	for _, event := range events {
+		if event.RecurringEventID != nil {
+			instanceMap[*event.RecurringEventID] = append(instanceMap[*event.RecurringEventID], event)
+		} else {
+			feed.Events = append(feed.Events, event)
+		}
+	}
+
+	for _, recurringEvent := range recurringEvents {
+		recurrence, err := entity.ParseRecurrence(recurringEvent.Recurrence)
+		if err != nil {
+			return nil, fmt.Errorf("fail to parse recurrence (eventID: %q): %w", recurringEvent.ID, err)
+		}
+
+		feed.Series = append(feed.Series, entity.RecurringEventSeries{
+			RecurringEvent: recurringEvent,
+			Recurrence:     *recurrence,
+			Instances:      instanceMap[recurringEvent.ID],
+		})
+	}
+
+	return feed.Encode(now), nil
+}
+
+// verifyToken returns FeedNotFoundError for any failure, so that the existence of the calendar is not revealed.
+func (u *feedUsecase) verifyToken(ctx context.Context, calendarID valueobject.CalendarID, token string) error {
+
+	if token == "" {
+		return domain.FeedNotFoundError
+	}
+
+	hash, err := u.databaseRepo.GetFeedTokenHash(ctx, calendarID)
+	if err != nil {
+		if errors.Is(err, domain.CalendarNotFoundError) {
+			return domain.FeedNotFoundError
+		}
+		return fmt.Errorf("fail to get feed token hash: %w", err)
+	}
+
+	// タイミング攻撃を避けるため、固定時間で比較する
+	if hash == nil || subtle.ConstantTimeCompare([]byte(hashFeedToken(token)), []byte(*hash)) != 1 {
+		return domain.FeedNotFoundError
+	}
+
+	return nil
+}
+
+func (u *feedUsecase) listAllRecurringEvents(ctx context.Context, calendarID valueobject.CalendarID) (
+	[]entity.RecurringEvent, error) {
+
+	result := []entity.RecurringEvent{}
+	var after *valueobject.EventID
+	for {
+		recurringEvents, err := u.databaseRepo.ListRecurringEvents(ctx, calendarID, true, after, feedPageSize)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, recurringEvents...)
+
+		if len(recurringEvents) < feedPageSize {
+			return result, nil
+		}
+		after = &recurringEvents[len(recurringEvents)-1].ID
+	}
+}
+
+func (u *feedUsecase) listAllEvents(ctx context.Context, calendarID valueobject.CalendarID, filter entity.EventFilter) (
+	[]entity.Event, error) {
+
+	result := []entity.Event{}
+	var after *entity.EventCursor
+	for {
+		events, err := u.databaseRepo.ListEventsWithFilter(ctx, calendarID, filter, after, feedPageSize)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, events...)
+
+		if len(events) < feedPageSize {
+			return result, nil
+		}
+		cursor := entity.NewEventCursor(events[len(events)-1])
+		after = &cursor
+	}
+}
+
+// hashFeedToken returns the SHA-256 hash of the token in hex.
+// The token is random enough, so a slow hash function for passwords is not needed.
+func hashFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,132 @@
+package usecase_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/takuoki/golib/applog"
+	"github.com/takuoki/google-calendar-sync/api/domain"
+	"github.com/takuoki/google-calendar-sync/api/domain/constant"
+	"github.com/takuoki/google-calendar-sync/api/domain/entity"
+	"github.com/takuoki/google-calendar-sync/api/domain/service"
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+	"github.com/takuoki/google-calendar-sync/api/usecase"
+)
+
+func setupFeedUsecase(now time.Time) usecase.FeedUsecase {
+	logger, err := applog.NewSimpleLogger(io.Discard)
+	if err != nil {
+		panic("failed to create logger: " + err.Error())
+	}
+
+	clock := service.NewMockClock()
+	clock.SetFixedTime(now)
+
+	return usecase.NewFeedUsecase(clock, mysqlRepo, logger)
+}
+
+func TestFeedUsecase_Get(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	base := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	at := func(day, hour int) *time.Time {
+		return toPtr(base.AddDate(0, 0, day).Add(time.Duration(hour) * time.Hour))
+	}
+
+	// Given
+	var calendarID valueobject.CalendarID = "feed-get"
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:   calendarID,
+		Name: "Test Calendar",
+	}))
+
+	var recurringEventID valueobject.EventID = "feed-weekly"
+	require.NoError(t, mysqlRepo.CreateRecurringEvent(ctx, t, entity.RecurringEvent{
+		CalendarID: calendarID,
+		ID:         recurringEventID,
+		Summary:    "Weekly",
+		Recurrence: `["RRULE:FREQ=WEEKLY;BYDAY=MO"]`,
+		Start:      at(0, 9),
+		End:        at(0, 10),
+		Status:     "confirmed",
+	}))
+
+	events := []entity.Event{
+		{CalendarID: calendarID, ID: "feed-weekly_1", RecurringEventID: &recurringEventID, Summary: "Weekly",
+			Start: at(7, 9), End: at(7, 10), OriginalStart: at(7, 9), Transparency: constant.EventTransparencyOpaque, Status: "confirmed"},
+		{CalendarID: calendarID, ID: "feed-weekly_2", RecurringEventID: &recurringEventID, Summary: "Weekly (moved)",
+			Start: at(14, 13), End: at(14, 14), OriginalStart: at(14, 9), Transparency: constant.EventTransparencyOpaque, Status: "confirmed"},
+		{CalendarID: calendarID, ID: "feed-weekly_3", RecurringEventID: &recurringEventID, Summary: "Weekly",
+			Start: at(21, 9), End: at(21, 10), OriginalStart: at(21, 9), Transparency: constant.EventTransparencyOpaque,
+			Status: constant.EventStatusCancelled},
+		{CalendarID: calendarID, ID: "feed-weekly_old", RecurringEventID: &recurringEventID,
+			OriginalStart: at(-400, 9), Transparency: constant.EventTransparencyOpaque, Status: constant.EventStatusCancelled},
+		{CalendarID: calendarID, ID: "feed-single", Summary: "Single",
+			Start: at(1, 9), End: at(1, 10), Transparency: constant.EventTransparencyOpaque,
+			ICalUID: "feed-single@google.com", Status: "confirmed"},
+		{CalendarID: calendarID, ID: "feed-old", Summary: "Old",
+			Start: at(-400, 9), End: at(-400, 10), Transparency: constant.EventTransparencyOpaque, Status: "confirmed"},
+	}
+	for _, event := range events {
+		require.NoError(t, mysqlRepo.CreateEvent(ctx, t, event))
+	}
+
+	feedUsecase := setupFeedUsecase(base)
+
+	// When
+	_, err := feedUsecase.Get(ctx, calendarID, "token")
+
+	// Then: 公開前は取得できない
+	assert.ErrorIs(t, err, domain.FeedNotFoundError)
+
+	// When
+	token, err := feedUsecase.IssueToken(ctx, calendarID)
+	require.NoError(t, err)
+	feed, err := feedUsecase.Get(ctx, calendarID, token)
+	require.NoError(t, err)
+
+	// Then
+	result := string(feed)
+	assert.Contains(t, result, "UID:feed-weekly\r\nDTSTAMP:20250106T000000Z\r\nDTSTART:20250106T090000Z\r\n"+
+		"DTEND:20250106T100000Z\r\nRRULE:FREQ=WEEKLY;BYDAY=MO\r\n")
+	assert.Contains(t, result, "RECURRENCE-ID:20250120T090000Z\r\nDTSTART:20250120T130000Z\r\n")
+	assert.Contains(t, result, "RECURRENCE-ID:20250127T090000Z\r\nDTSTART:20250127T090000Z\r\n"+
+		"DTEND:20250127T100000Z\r\nSUMMARY:Weekly\r\nSTATUS:CANCELLED\r\n")
+	assert.NotContains(t, result, "RECURRENCE-ID:20250113T090000Z")
+	assert.NotContains(t, result, "RECURRENCE-ID:20231203T090000Z")
+	assert.Contains(t, result, "UID:feed-single@google.com\r\n")
+	assert.NotContains(t, result, "UID:feed-old\r\n")
+
+	// When
+	_, err = feedUsecase.Get(ctx, calendarID, strings.ToUpper(token)+"x")
+
+	// Then
+	assert.ErrorIs(t, err, domain.FeedNotFoundError)
+
+	// When: 再発行すると以前のトークンは無効になる
+	newToken, err := feedUsecase.IssueToken(ctx, calendarID)
+	require.NoError(t, err)
+	_, err = feedUsecase.Get(ctx, calendarID, token)
+
+	// Then
+	assert.ErrorIs(t, err, domain.FeedNotFoundError)
+
+	// When
+	require.NoError(t, feedUsecase.RevokeToken(ctx, calendarID))
+	_, err = feedUsecase.Get(ctx, calendarID, newToken)
+
+	// Then
+	assert.ErrorIs(t, err, domain.FeedNotFoundError)
+
+	// When
+	_, err = feedUsecase.Get(ctx, "feed-not-found", newToken)
+
+	// Then
+	assert.ErrorIs(t, err, domain.FeedNotFoundError)
+}
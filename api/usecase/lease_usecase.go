@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/takuoki/golib/applog"
+	"github.com/takuoki/google-calendar-sync/api/repository"
+)
+
+const (
+	leaseTTL = 1 * time.Minute
+
+	// リースの有効期限が切れる前に、複数回更新を試みられる間隔とする
+	leaseHeartbeatInterval = leaseTTL / 3
+)
+
+type LeaseUsecase interface {
+	// RunExclusive runs fn only while this run holds the lease named jobName.
+	// It returns ran=false without calling fn if another instance or another run on this instance holds the lease.
+	RunExclusive(ctx context.Context, jobName string, fn func(ctx context.Context) error) (ran bool, err error)
+}
+
+type leaseUsecase struct {
+	databaseRepo repository.DatabaseRepository
+	// instanceID identifies this instance. Each run holds the lease with its own holder ID prefixed by it.
+	instanceID        string
+	ttl               time.Duration
+	heartbeatInterval time.Duration
+	logger            applog.Logger
+}
+
+func NewLeaseUsecase(
+	databaseRepo repository.DatabaseRepository,
+	instanceID string,
+	logger applog.Logger,
+) LeaseUsecase {
+	return &leaseUsecase{
+		databaseRepo:      databaseRepo,
+		instanceID:        instanceID,
+		ttl:               leaseTTL,
+		heartbeatInterval: leaseHeartbeatInterval,
+		logger:            logger,
+	}
+}
+
+func (u *leaseUsecase) RunExclusive(ctx context.Context, jobName string, fn func(ctx context.Context) error) (bool, error) {
+
+	// 同じインスタンスで重複して実行されないよう、実行ごとに異なる保持者とする
+	holderID, err := u.newHolderID()
+	if err != nil {
+		return false, fmt.Errorf("fail to generate holder id: %w", err)
+	}
+
+	acquired, err := u.databaseRepo.AcquireLease(ctx, jobName, holderID, u.ttl)
+	if err != nil {
+		return false, fmt.Errorf("fail to acquire lease: %w", err)
+	}
+	if !acquired {
+		u.logger.Infof(ctx, "lease is held by another instance: %s", jobName)
+		return false, nil
+	}
+
+	jobCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	heartbeatDone := make(chan struct{})
+	go func() {
+		defer close(heartbeatDone)
+		u.heartbeat(jobCtx, cancel, jobName, holderID)
+	}()
+
+	err = fn(jobCtx)
+
+	cancel()
+	<-heartbeatDone
+
+	// 呼び出し元の context がキャンセルされていてもリースは解放する
+	if rerr := u.databaseRepo.ReleaseLease(context.WithoutCancel(ctx), jobName, holderID); rerr != nil {
+		// 解放に失敗しても有効期限が切れれば他のインスタンスが取得できるため、ログ出力のみとする
+		u.logger.Errorf(ctx, "fail to release lease: %v", rerr)
+	}
+
+	if err != nil {
+		return true, fmt.Errorf("fail to run job (jobName: %q): %w", jobName, err)
+	}
+
+	return true, nil
+}
+
+// heartbeat extends the lease periodically until ctx is done.
+// If the lease cannot be extended, it cancels the job so that it never runs on two instances at once.
+func (u *leaseUsecase) heartbeat(ctx context.Context, cancel context.CancelFunc, jobName, holderID string) {
+
+	ticker := time.NewTicker(u.heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			acquired, err := u.databaseRepo.AcquireLease(ctx, jobName, holderID, u.ttl)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				u.logger.Errorf(ctx, "fail to extend lease, cancel the job (jobName: %q): %v", jobName, err)
+				cancel()
+				return
+			}
+			if !acquired {
+				u.logger.Warnf(ctx, "lease is lost, cancel the job (jobName: %q)", jobName)
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+// newHolderID returns the holder ID of a run, which is the instance ID with a random suffix.
+func (u *leaseUsecase) newHolderID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return u.instanceID + "-" + hex.EncodeToString(b), nil
+}
@@ -0,0 +1,247 @@
+package usecase_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/takuoki/golib/applog"
+
+	"github.com/takuoki/google-calendar-sync/api/domain/entity"
+	"github.com/takuoki/google-calendar-sync/api/domain/service"
+	"github.com/takuoki/google-calendar-sync/api/repository/mysql"
+	"github.com/takuoki/google-calendar-sync/api/usecase"
+)
+
+func setupLeaseUsecase(instanceID string) (usecase.LeaseUsecase, *bytes.Buffer) {
+	buf := new(bytes.Buffer)
+
+	logger, err := applog.NewSimpleLogger(buf)
+	if err != nil {
+		panic("failed to create logger: " + err.Error())
+	}
+
+	leaseUsecase := usecase.NewLeaseUsecase(mysqlRepo, instanceID, logger)
+
+	return leaseUsecase, buf
+}
+
+func TestLeaseUsecase_RunExclusive_Success(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	jobName := "run-exclusive-success-1"
+	leaseUsecase, _ := setupLeaseUsecase("holder-1")
+
+	// When
+	called := false
+	ran, err := leaseUsecase.RunExclusive(ctx, jobName, func(ctx context.Context) error {
+		called = true
+
+		// Verify the lease is held while the job is running
+		lease, err := mysqlRepo.GetLease(ctx, t, jobName)
+		require.NoError(t, err)
+		require.NotNil(t, lease)
+		assert.True(t, strings.HasPrefix(lease.Holder, "holder-1-"), "holder: %q", lease.Holder)
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	// Then
+	assert.True(t, ran)
+	assert.True(t, called)
+
+	// Verify the lease was released
+	lease, err := mysqlRepo.GetLease(ctx, t, jobName)
+	require.NoError(t, err)
+	assert.Nil(t, lease)
+}
+
+func TestLeaseUsecase_RunExclusive_HeldByAnotherInstance(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	jobName := "run-exclusive-held-1"
+	leaseUsecase, buf := setupLeaseUsecase("holder-1")
+
+	require.NoError(t, mysqlRepo.CreateLease(ctx, t, entity.Lease{
+		Name:       jobName,
+		Holder:     "holder-2",
+		Expiration: mysqlRepo.Clock(t).Now().Add(1 * time.Hour),
+	}))
+
+	// When
+	called := false
+	ran, err := leaseUsecase.RunExclusive(ctx, jobName, func(ctx context.Context) error {
+		called = true
+		return nil
+	})
+	require.NoError(t, err)
+
+	// Then
+	assert.False(t, ran)
+	assert.False(t, called)
+
+	// Verify the lease is still held by the other instance
+	lease, err := mysqlRepo.GetLease(ctx, t, jobName)
+	require.NoError(t, err)
+	require.NotNil(t, lease)
+	assert.Equal(t, "holder-2", lease.Holder)
+
+	// Verify log messages
+	logs := strings.Split(buf.String(), "\n")
+	require.Contains(t, logs, "lease is held by another instance: "+jobName)
+}
+
+func TestLeaseUsecase_RunExclusive_SameInstance(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given: 同じインスタンスで同じジョブが重複して実行される
+	jobName := "run-exclusive-same-instance-1"
+	leaseUsecase, _ := setupLeaseUsecase("holder-1")
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var calledCount atomic.Int32
+
+	type result struct {
+		ran bool
+		err error
+	}
+	results := make(chan result, 2)
+	run := func() {
+		ran, err := leaseUsecase.RunExclusive(ctx, jobName, func(ctx context.Context) error {
+			// 2 回目の呼び出しでは待機せず、件数の検証で失敗させる
+			if calledCount.Add(1) == 1 {
+				close(started)
+				<-release
+			}
+			return nil
+		})
+		results <- result{ran: ran, err: err}
+	}
+
+	// When
+	go run()
+	<-started
+	go run()
+	second := <-results
+	close(release)
+	first := <-results
+
+	// Then
+	require.NoError(t, first.err)
+	require.NoError(t, second.err)
+	assert.True(t, first.ran)
+	assert.False(t, second.ran)
+	assert.Equal(t, int32(1), calledCount.Load())
+
+	// Verify the lease was released after the first run finished
+	lease, err := mysqlRepo.GetLease(ctx, t, jobName)
+	require.NoError(t, err)
+	assert.Nil(t, lease)
+}
+
+func TestLeaseUsecase_RunExclusive_ExpiredLease(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	jobName := "run-exclusive-expired-1"
+	leaseUsecase, _ := setupLeaseUsecase("holder-1")
+
+	require.NoError(t, mysqlRepo.CreateLease(ctx, t, entity.Lease{
+		Name:       jobName,
+		Holder:     "holder-2",
+		Expiration: mysqlRepo.Clock(t).Now().Add(-1 * time.Minute),
+	}))
+
+	// When
+	called := false
+	ran, err := leaseUsecase.RunExclusive(ctx, jobName, func(ctx context.Context) error {
+		called = true
+		return nil
+	})
+	require.NoError(t, err)
+
+	// Then
+	assert.True(t, ran)
+	assert.True(t, called)
+}
+
+func TestLeaseUsecase_RunExclusive_ClockSkew(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given: 時刻が進んでいるインスタンスでも、DB の時刻で有効なリースは取得できない
+	jobName := "run-exclusive-clock-skew-1"
+
+	logger, err := applog.NewSimpleLogger(io.Discard)
+	require.NoError(t, err)
+	skewedClock := service.NewMockClock()
+	skewedClock.SetFixedTime(time.Now().Add(1 * time.Hour))
+	skewedRepo := mysql.NewMysqlRepository(testDB, skewedClock, nil, logger)
+
+	leaseUsecase1, _ := setupLeaseUsecase("holder-1")
+	leaseUsecase2 := usecase.NewLeaseUsecase(skewedRepo, "holder-2", logger)
+
+	// When
+	ran1, err := leaseUsecase1.RunExclusive(ctx, jobName, func(ctx context.Context) error {
+		ran2, err := leaseUsecase2.RunExclusive(ctx, jobName, func(ctx context.Context) error {
+			return nil
+		})
+		require.NoError(t, err)
+
+		// Then
+		assert.False(t, ran2)
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	// Then
+	assert.True(t, ran1)
+}
+
+func TestLeaseUsecase_RunExclusive_JobError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	jobName := "run-exclusive-job-error-1"
+	leaseUsecase, _ := setupLeaseUsecase("holder-1")
+
+	// When
+	ran, err := leaseUsecase.RunExclusive(ctx, jobName, func(ctx context.Context) error {
+		return errors.New("job error")
+	})
+	require.Error(t, err)
+
+	// Then
+	assert.True(t, ran)
+	if !strings.HasPrefix(err.Error(), "fail to run job") {
+		t.Errorf("error message does not match the expected prefix, got: %s", err.Error())
+	}
+
+	// Verify the lease was released even if the job failed
+	lease, err := mysqlRepo.GetLease(ctx, t, jobName)
+	require.NoError(t, err)
+	assert.Nil(t, lease)
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/takuoki/golib/applog"
+	"github.com/takuoki/google-calendar-sync/api/repository"
+)
+
+// MaintenanceUsecase provides operations run from the command line, not from the API.
+type MaintenanceUsecase interface {
+	// ReencryptRefreshTokens rewrites every stored refresh token under the active encryption key.
+	ReencryptRefreshTokens(ctx context.Context) (count int, err error)
+}
+
+type maintenanceUsecase struct {
+	databaseRepo repository.DatabaseRepository
+	logger       applog.Logger
+}
+
+func NewMaintenanceUsecase(
+	databaseRepo repository.DatabaseRepository,
+	logger applog.Logger,
+) MaintenanceUsecase {
+	return &maintenanceUsecase{
+		databaseRepo: databaseRepo,
+		logger:       logger,
+	}
+}
+
+func (u *maintenanceUsecase) ReencryptRefreshTokens(ctx context.Context) (int, error) {
+
+	var count int
+
+	// 1 件でも失敗した場合は全件ロールバックし、鍵を直してから再実行できるようにする
+	err := u.databaseRepo.RunTransaction(ctx, func(ctx context.Context, tx repository.DatabaseTransaction) error {
+		var err error
+		count, err = tx.ReencryptRefreshTokens(ctx)
+		if err != nil {
+			return fmt.Errorf("fail to reencrypt refresh tokens: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, fmt.Errorf("fail to run transaction: %w", err)
+	}
+
+	u.logger.Infof(ctx, "refresh tokens are reencrypted (count: %d)", count)
+
+	return count, nil
+}
@@ -0,0 +1,140 @@
+package usecase_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/takuoki/golib/applog"
+
+	"github.com/takuoki/google-calendar-sync/api/domain/entity"
+	"github.com/takuoki/google-calendar-sync/api/domain/service"
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+	"github.com/takuoki/google-calendar-sync/api/repository/mysql"
+	"github.com/takuoki/google-calendar-sync/api/usecase"
+)
+
+var (
+	testCryptKey1 = []byte("12345678901234567890123456789012")
+	testCryptKey2 = []byte("abcdefghijklmnopqrstuvwxyz012345")
+)
+
+// plaintextFallbackCrypt treats the refresh tokens that cannot be decrypted as plaintext,
+// since the other tests store refresh tokens without encryption in the same table.
+type plaintextFallbackCrypt struct {
+	service.Crypt
+}
+
+func (c plaintextFallbackCrypt) Decrypt(ciphertext string) (string, error) {
+	plaintext, err := c.Crypt.Decrypt(ciphertext)
+	if err != nil && !strings.HasPrefix(ciphertext, "v1:") {
+		return ciphertext, nil
+	}
+	return plaintext, err
+}
+
+func newMysqlRepositoryWithCrypt(t *testing.T, crypt service.Crypt) *mysql.MysqlRepository {
+	t.Helper()
+
+	logger, err := applog.NewSimpleLogger(io.Discard)
+	require.NoError(t, err)
+
+	return mysql.NewMysqlRepository(testDB, service.NewMockClock(), crypt, logger)
+}
+
+func newKeyringCrypt(t *testing.T, keys map[string][]byte, activeKeyID string) service.Crypt {
+	t.Helper()
+
+	crypt, err := service.NewKeyringCrypt(keys, activeKeyID)
+	require.NoError(t, err)
+
+	return crypt
+}
+
+func getStoredRefreshToken(ctx context.Context, t *testing.T, calendarID valueobject.CalendarID) string {
+	t.Helper()
+
+	var refreshToken string
+	err := testDB.QueryRowContext(ctx, "SELECT refresh_token FROM calendars WHERE id = ?", calendarID).Scan(&refreshToken)
+	require.NoError(t, err)
+
+	return refreshToken
+}
+
+// The tests below rewrite every refresh token in the table, so they do not run in parallel with the other tests.
+
+func TestMaintenanceUsecase_ReencryptRefreshTokens_KeyRotation(t *testing.T) {
+	ctx := context.Background()
+
+	// Given: the refresh token is encrypted with the old key
+	oldCrypt := newKeyringCrypt(t, map[string][]byte{"key1": testCryptKey1}, "key1")
+
+	var calendarID valueobject.CalendarID = "calendar-reencrypt-key-rotation-1"
+	refreshToken := "test-refresh-token"
+	encrypted, err := oldCrypt.Encrypt(refreshToken)
+	require.NoError(t, err)
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:           calendarID,
+		Name:         "Test Calendar",
+		RefreshToken: &encrypted,
+	}))
+
+	rotatedCrypt := newKeyringCrypt(t, map[string][]byte{"key1": testCryptKey1, "key2": testCryptKey2}, "key2")
+	maintenanceUsecase := usecase.NewMaintenanceUsecase(
+		newMysqlRepositoryWithCrypt(t, plaintextFallbackCrypt{rotatedCrypt}), applog.NewBasicLogger(io.Discard))
+
+	// When
+	count, err := maintenanceUsecase.ReencryptRefreshTokens(ctx)
+	require.NoError(t, err)
+
+	// Then: the refresh token can be decrypted without the old key
+	assert.GreaterOrEqual(t, count, 1)
+	assert.True(t, strings.HasPrefix(getStoredRefreshToken(ctx, t, calendarID), "v1:key2:"))
+
+	newCrypt := newKeyringCrypt(t, map[string][]byte{"key2": testCryptKey2}, "key2")
+	calendar, err := newMysqlRepositoryWithCrypt(t, newCrypt).GetCalendar(ctx, calendarID)
+	require.NoError(t, err)
+	require.NotNil(t, calendar.RefreshToken)
+	assert.Equal(t, refreshToken, *calendar.RefreshToken)
+}
+
+func TestMaintenanceUsecase_ReencryptRefreshTokens_LegacyKey(t *testing.T) {
+	ctx := context.Background()
+
+	// Given: the refresh token is encrypted before the keyring was introduced
+	legacyCrypt, err := service.NewAESCrypt(testCryptKey1)
+	require.NoError(t, err)
+
+	var calendarID valueobject.CalendarID = "calendar-reencrypt-legacy-key-1"
+	refreshToken := "test-refresh-token"
+	encrypted, err := legacyCrypt.Encrypt(refreshToken)
+	require.NoError(t, err)
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:           calendarID,
+		Name:         "Test Calendar",
+		RefreshToken: &encrypted,
+	}))
+
+	rotatedCrypt := newKeyringCrypt(t,
+		map[string][]byte{service.LegacyKeyID: testCryptKey1, "key1": testCryptKey1, "key2": testCryptKey2}, "key2")
+	maintenanceUsecase := usecase.NewMaintenanceUsecase(
+		newMysqlRepositoryWithCrypt(t, plaintextFallbackCrypt{rotatedCrypt}), applog.NewBasicLogger(io.Discard))
+
+	// When: the command can be run again with the tokens written by the first run
+	_, err = maintenanceUsecase.ReencryptRefreshTokens(ctx)
+	require.NoError(t, err)
+	_, err = maintenanceUsecase.ReencryptRefreshTokens(ctx)
+	require.NoError(t, err)
+
+	// Then
+	assert.True(t, strings.HasPrefix(getStoredRefreshToken(ctx, t, calendarID), "v1:key2:"))
+
+	newCrypt := newKeyringCrypt(t, map[string][]byte{"key2": testCryptKey2}, "key2")
+	calendar, err := newMysqlRepositoryWithCrypt(t, newCrypt).GetCalendar(ctx, calendarID)
+	require.NoError(t, err)
+	require.NotNil(t, calendar.RefreshToken)
+	assert.Equal(t, refreshToken, *calendar.RefreshToken)
+}
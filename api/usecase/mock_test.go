@@ -9,11 +9,21 @@ import (
 )
 
 type GoogleCalendarRepositoryMock struct {
+	GetCalendarMetadataFunc       func(ctx context.Context, calendarID valueobject.CalendarID, credential entity.Credential) (*entity.CalendarMetadata, error)
 	ListEventsWithAfterFunc       func(ctx context.Context, calendarID valueobject.CalendarID, after time.Time) ([]entity.Event, []entity.RecurringEvent, string, error)
 	ListEventsWithSyncTokenFunc   func(ctx context.Context, calendarID valueobject.CalendarID, syncToken string) ([]entity.Event, []entity.RecurringEvent, string, error)
 	ListEventInstancesBetweenFunc func(ctx context.Context, calendarID valueobject.CalendarID, eventID valueobject.EventID, from, to time.Time) ([]entity.Event, error)
 	WatchFunc                     func(ctx context.Context, calendarID valueobject.CalendarID) (*entity.Channel, error)
 	StopWatchFunc                 func(ctx context.Context, channel entity.Channel) error
+	ListCalendarListFunc          func(ctx context.Context, credential entity.Credential) ([]entity.CalendarListEntry, error)
+	InvalidateCredentialFunc      func(calendarID valueobject.CalendarID)
+}
+
+func (m *GoogleCalendarRepositoryMock) GetCalendarMetadata(ctx context.Context, calendarID valueobject.CalendarID, credential entity.Credential) (*entity.CalendarMetadata, error) {
+	if m.GetCalendarMetadataFunc != nil {
+		return m.GetCalendarMetadataFunc(ctx, calendarID, credential)
+	}
+	return nil, nil
 }
 
 func (m *GoogleCalendarRepositoryMock) ListEventsWithAfter(ctx context.Context, calendarID valueobject.CalendarID, after time.Time) ([]entity.Event, []entity.RecurringEvent, string, error) {
@@ -50,3 +60,35 @@ func (m *GoogleCalendarRepositoryMock) StopWatch(ctx context.Context, channel en
 	}
 	return nil
 }
+
+func (m *GoogleCalendarRepositoryMock) ListCalendarList(ctx context.Context, credential entity.Credential) ([]entity.CalendarListEntry, error) {
+	if m.ListCalendarListFunc != nil {
+		return m.ListCalendarListFunc(ctx, credential)
+	}
+	return nil, nil
+}
+
+func (m *GoogleCalendarRepositoryMock) InvalidateCredential(calendarID valueobject.CalendarID) {
+	if m.InvalidateCredentialFunc != nil {
+		m.InvalidateCredentialFunc(calendarID)
+	}
+}
+
+type OAuthRepositoryMock struct {
+	AuthCodeURLFunc          func(state, codeVerifier string) string
+	ExchangeRefreshTokenFunc func(ctx context.Context, code, codeVerifier string) (string, error)
+}
+
+func (m *OAuthRepositoryMock) AuthCodeURL(state, codeVerifier string) string {
+	if m.AuthCodeURLFunc != nil {
+		return m.AuthCodeURLFunc(state, codeVerifier)
+	}
+	return ""
+}
+
+func (m *OAuthRepositoryMock) ExchangeRefreshToken(ctx context.Context, code, codeVerifier string) (string, error) {
+	if m.ExchangeRefreshTokenFunc != nil {
+		return m.ExchangeRefreshTokenFunc(ctx, code, codeVerifier)
+	}
+	return "", nil
+}
@@ -0,0 +1,193 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/takuoki/golib/applog"
+	"github.com/takuoki/google-calendar-sync/api/domain"
+	"github.com/takuoki/google-calendar-sync/api/domain/entity"
+	"github.com/takuoki/google-calendar-sync/api/domain/service"
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+	"github.com/takuoki/google-calendar-sync/api/repository"
+)
+
+const (
+	oauthStateTTL = 10 * time.Minute
+
+	// 32 バイトを base64url でエンコードすると 43 文字となり、PKCE のコード検証子の要件（43〜128 文字）を満たす
+	oauthRandomByteLength = 32
+)
+
+type OAuthUsecase interface {
+	// Authorize starts the authorization code flow for the calendar, and returns the URL of the consent screen.
+	// name is required if the calendar is not registered yet.
+	Authorize(ctx context.Context, calendarID valueobject.CalendarID, name *string) (string, error)
+	// Callback exchanges the authorization code for a refresh token, and registers or updates the calendar with it.
+	Callback(ctx context.Context, state, code string) (valueobject.CalendarID, error)
+}
+
+type oauthUsecase struct {
+	oauthRepo       repository.OAuthRepository
+	databaseRepo    repository.DatabaseRepository
+	calendarUsecase CalendarUsecase
+	clockService    service.Clock
+	logger          applog.Logger
+}
+
+// NewOAuthUsecase creates an OAuthUsecase. oauthRepo is nil when OAuth 2.0 is not used.
+func NewOAuthUsecase(
+	oauthRepo repository.OAuthRepository,
+	databaseRepo repository.DatabaseRepository,
+	calendarUsecase CalendarUsecase,
+	clockService service.Clock,
+	logger applog.Logger,
+) OAuthUsecase {
+	return &oauthUsecase{
+		oauthRepo:       oauthRepo,
+		databaseRepo:    databaseRepo,
+		calendarUsecase: calendarUsecase,
+		clockService:    clockService,
+		logger:          logger,
+	}
+}
+
+func (u *oauthUsecase) Authorize(ctx context.Context, calendarID valueobject.CalendarID, name *string) (string, error) {
+
+	if u.oauthRepo == nil {
+		return "", domain.OAuthDisabledError
+	}
+
+	if calendarID == "" {
+		return "", domain.RequiredError("calendarId")
+	}
+
+	if name != nil && *name == "" {
+		return "", domain.InvalidParameterError("name")
+	}
+
+	// 未登録のカレンダーはコールバック時に新規登録するため、名前が必要となる
+	if _, err := u.databaseRepo.GetCalendar(ctx, calendarID); err != nil {
+		if !errors.Is(err, domain.CalendarNotFoundError) {
+			return "", fmt.Errorf("fail to get calendar: %w", err)
+		}
+		if name == nil {
+			return "", domain.RequiredError("name")
+		}
+	}
+
+	now := u.clockService.Now()
+
+	// 期限切れの state の削除に失敗しても、認可処理は継続する
+	if err := u.databaseRepo.DeleteExpiredOAuthStates(ctx, now); err != nil {
+		u.logger.Warnf(ctx, "fail to delete expired oauth states: %v", err)
+	}
+
+	state, err := generateRandomString()
+	if err != nil {
+		return "", fmt.Errorf("fail to generate state: %w", err)
+	}
+
+	codeVerifier, err := generateRandomString()
+	if err != nil {
+		return "", fmt.Errorf("fail to generate code verifier: %w", err)
+	}
+
+	err = u.databaseRepo.CreateOAuthState(ctx, entity.OAuthState{
+		State:        state,
+		CodeVerifier: codeVerifier,
+		CalendarID:   calendarID,
+		Name:         name,
+		Expiration:   now.Add(oauthStateTTL),
+	})
+	if err != nil {
+		return "", fmt.Errorf("fail to create oauth state: %w", err)
+	}
+
+	return u.oauthRepo.AuthCodeURL(state, codeVerifier), nil
+}
+
+func (u *oauthUsecase) Callback(ctx context.Context, state, code string) (valueobject.CalendarID, error) {
+
+	if u.oauthRepo == nil {
+		return "", domain.OAuthDisabledError
+	}
+
+	if state == "" {
+		return "", domain.RequiredError("state")
+	}
+
+	if code == "" {
+		return "", domain.RequiredError("code")
+	}
+
+	// state は一度だけ使用できるように、取得と同時に削除する
+	var oauthState *entity.OAuthState
+	err := u.databaseRepo.RunTransaction(ctx, func(ctx context.Context, tx repository.DatabaseTransaction) error {
+		var err error
+		oauthState, err = tx.GetOAuthStateWithLock(ctx, state)
+		if err != nil {
+			return fmt.Errorf("fail to get oauth state: %w", err)
+		}
+		if oauthState == nil {
+			return domain.InvalidParameterError("state")
+		}
+
+		if err := tx.DeleteOAuthState(ctx, state); err != nil {
+			return fmt.Errorf("fail to delete oauth state: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("fail to run transaction: %w", err)
+	}
+
+	if !oauthState.Expiration.After(u.clockService.Now()) {
+		return "", domain.InvalidParameterError("state")
+	}
+
+	refreshToken, err := u.oauthRepo.ExchangeRefreshToken(ctx, code, oauthState.CodeVerifier)
+	if err != nil {
+		return "", fmt.Errorf("fail to exchange refresh token: %w", err)
+	}
+
+	// リフレッシュトークンの暗号化は、カレンダーの保存時にリポジトリで行われる
+	_, err = u.databaseRepo.GetCalendar(ctx, oauthState.CalendarID)
+	switch {
+	case err == nil:
+		err = u.calendarUsecase.Update(ctx, oauthState.CalendarID, oauthState.Name, &refreshToken, nil, nil)
+		if err != nil {
+			return "", fmt.Errorf("fail to update calendar: %w", err)
+		}
+
+	case errors.Is(err, domain.CalendarNotFoundError):
+		// 認可の途中でカレンダーが削除された場合は名前が存在しない
+		if oauthState.Name == nil {
+			return "", domain.RequiredError("name")
+		}
+		err = u.calendarUsecase.Create(ctx, oauthState.CalendarID, *oauthState.Name, &refreshToken, nil, nil)
+		if err != nil {
+			return "", fmt.Errorf("fail to create calendar: %w", err)
+		}
+
+	default:
+		return "", fmt.Errorf("fail to get calendar: %w", err)
+	}
+
+	return oauthState.CalendarID, nil
+}
+
+// generateRandomString returns a URL-safe random string, which is used for the state, the PKCE code verifier and the feed token.
+func generateRandomString() (string, error) {
+	b := make([]byte, oauthRandomByteLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
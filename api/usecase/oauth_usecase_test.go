@@ -0,0 +1,205 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/takuoki/golib/applog"
+	"github.com/takuoki/google-calendar-sync/api/domain"
+	"github.com/takuoki/google-calendar-sync/api/domain/entity"
+	"github.com/takuoki/google-calendar-sync/api/domain/service"
+	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
+	"github.com/takuoki/google-calendar-sync/api/repository"
+	"github.com/takuoki/google-calendar-sync/api/usecase"
+)
+
+func setupOAuthUsecase(oauthRepo repository.OAuthRepository) usecase.OAuthUsecase {
+	logger, err := applog.NewSimpleLogger(io.Discard)
+	if err != nil {
+		panic("failed to create logger: " + err.Error())
+	}
+
+	authTypes := serviceAccountOnly
+	if oauthRepo != nil {
+		authTypes = allAuthTypes
+	}
+	calendarUsecase, _ := setupCalendarUsecase(authTypes, newAccessibleCalendarMock())
+
+	return usecase.NewOAuthUsecase(oauthRepo, mysqlRepo, calendarUsecase, service.NewMockClock(), logger)
+}
+
+// newOAuthRepositoryMock returns a mock that issues the refresh token only for the code verifier used in AuthCodeURL.
+func newOAuthRepositoryMock(refreshToken string) (*OAuthRepositoryMock, *string) {
+	var state, codeVerifier string
+	return &OAuthRepositoryMock{
+		AuthCodeURLFunc: func(s, v string) string {
+			state, codeVerifier = s, v
+			return fmt.Sprintf("https://accounts.google.com/o/oauth2/auth?state=%s", s)
+		},
+		ExchangeRefreshTokenFunc: func(ctx context.Context, code, v string) (string, error) {
+			if code != "auth-code" || v != codeVerifier {
+				return "", errors.New("invalid grant")
+			}
+			return refreshToken, nil
+		},
+	}, &state
+}
+
+func TestOAuthUsecase_AuthorizeAndCallback_Create(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	mockRepo, state := newOAuthRepositoryMock("new-refresh-token")
+	oauthUsecase := setupOAuthUsecase(mockRepo)
+
+	var calendarID valueobject.CalendarID = "oauth-callback-create-1"
+	name := "Test Calendar"
+
+	authURL, err := oauthUsecase.Authorize(ctx, calendarID, &name)
+	require.NoError(t, err)
+	assert.Contains(t, authURL, *state)
+
+	// When
+	resCalendarID, err := oauthUsecase.Callback(ctx, *state, "auth-code")
+	require.NoError(t, err)
+
+	// Then
+	assert.Equal(t, calendarID, resCalendarID)
+
+	calendar, err := mysqlRepo.GetCalendar(ctx, calendarID)
+	require.NoError(t, err)
+	assert.Equal(t, name, calendar.Name)
+
+	refreshToken, err := mysqlRepo.GetRefreshToken(ctx, calendarID)
+	require.NoError(t, err)
+	assert.Equal(t, "new-refresh-token", refreshToken)
+}
+
+func TestOAuthUsecase_AuthorizeAndCallback_Update(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	mockRepo, state := newOAuthRepositoryMock("new-refresh-token")
+	oauthUsecase := setupOAuthUsecase(mockRepo)
+
+	var calendarID valueobject.CalendarID = "oauth-callback-update-1"
+	oldRefreshToken := "old-refresh-token"
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:           calendarID,
+		Name:         "Test Calendar",
+		RefreshToken: &oldRefreshToken,
+	}))
+
+	_, err := oauthUsecase.Authorize(ctx, calendarID, nil)
+	require.NoError(t, err)
+
+	// When
+	_, err = oauthUsecase.Callback(ctx, *state, "auth-code")
+	require.NoError(t, err)
+
+	// Then
+	calendar, err := mysqlRepo.GetCalendar(ctx, calendarID)
+	require.NoError(t, err)
+	assert.Equal(t, "Test Calendar", calendar.Name)
+
+	refreshToken, err := mysqlRepo.GetRefreshToken(ctx, calendarID)
+	require.NoError(t, err)
+	assert.Equal(t, "new-refresh-token", refreshToken)
+}
+
+func TestOAuthUsecase_Callback_StateUsedTwice(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	mockRepo, state := newOAuthRepositoryMock("new-refresh-token")
+	oauthUsecase := setupOAuthUsecase(mockRepo)
+
+	name := "Test Calendar"
+	_, err := oauthUsecase.Authorize(ctx, "oauth-callback-state-twice-1", &name)
+	require.NoError(t, err)
+
+	_, err = oauthUsecase.Callback(ctx, *state, "auth-code")
+	require.NoError(t, err)
+
+	// When
+	_, err = oauthUsecase.Callback(ctx, *state, "auth-code")
+
+	// Then
+	assert.ErrorContains(t, err, "state is invalid")
+}
+
+func TestOAuthUsecase_Error(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	name := "Test Calendar"
+
+	testcases := map[string]struct {
+		oauthRepo repository.OAuthRepository
+		fn        func(u usecase.OAuthUsecase) error
+		errPrefix string
+	}{
+		"authorize when oauth is disabled": {
+			oauthRepo: nil,
+			fn: func(u usecase.OAuthUsecase) error {
+				_, err := u.Authorize(ctx, "oauth-error-1", &name)
+				return err
+			},
+			errPrefix: "oauth is not enabled",
+		},
+		"authorize unregistered calendar without name": {
+			oauthRepo: &OAuthRepositoryMock{},
+			fn: func(u usecase.OAuthUsecase) error {
+				_, err := u.Authorize(ctx, "oauth-error-2", nil)
+				return err
+			},
+			errPrefix: "name is required",
+		},
+		"callback without code": {
+			oauthRepo: &OAuthRepositoryMock{},
+			fn: func(u usecase.OAuthUsecase) error {
+				_, err := u.Callback(ctx, "state", "")
+				return err
+			},
+			errPrefix: "code is required",
+		},
+		"callback with unknown state": {
+			oauthRepo: &OAuthRepositoryMock{},
+			fn: func(u usecase.OAuthUsecase) error {
+				_, err := u.Callback(ctx, "unknown-state", "auth-code")
+				return err
+			},
+			errPrefix: "fail to run transaction: state is invalid",
+		},
+	}
+
+	for name, tt := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Given
+			oauthUsecase := setupOAuthUsecase(tt.oauthRepo)
+
+			// When
+			err := tt.fn(oauthUsecase)
+			require.Error(t, err)
+
+			// Then
+			var clientErr *domain.ClientError
+			assert.True(t, errors.As(err, &clientErr))
+			assert.ErrorContains(t, err, tt.errPrefix)
+		})
+	}
+}
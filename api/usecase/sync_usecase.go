@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -25,7 +26,18 @@ const (
 
 type SyncUsecase interface {
 	Sync(ctx context.Context, calendarID valueobject.CalendarID) error
-	SyncFutureInstanceAll(ctx context.Context) error
+	// SyncAll syncs all calendars if tag is nil, otherwise the calendars with the tag.
+	SyncAll(ctx context.Context, tag *string) error
+	// ResyncAll is the same as SyncAll, except that all events and the instances of all recurring events are listed again
+	// without the sync token, to backfill the columns added after the events were synced.
+	ResyncAll(ctx context.Context, tag *string) error
+	// SyncFutureInstanceAll syncs future instances of all calendars if tag is nil, otherwise the calendars with the tag.
+	SyncFutureInstanceAll(ctx context.Context, tag *string) error
+	GetStatus(ctx context.Context, calendarID valueobject.CalendarID) (*entity.SyncStatus, error)
+	ListSyncHistories(ctx context.Context, calendarID valueobject.CalendarID, before *time.Time, limit int) (
+		histories []entity.SyncHistory, nextBefore *time.Time, err error)
+	ListSyncFutureInstanceHistories(ctx context.Context, calendarID valueobject.CalendarID, before *time.Time, limit int) (
+		histories []entity.SyncFutureInstanceHistory, nextBefore *time.Time, err error)
 }
 
 type syncUsecase struct {
@@ -50,14 +62,62 @@ func NewSyncUsecase(
 }
 
 func (u *syncUsecase) Sync(ctx context.Context, calendarID valueobject.CalendarID) error {
+	return u.syncCalendar(ctx, calendarID, false)
+}
 
-	if _, err := u.databaseRepo.GetCalendar(ctx, calendarID); err != nil {
+func (u *syncUsecase) syncCalendar(ctx context.Context, calendarID valueobject.CalendarID, full bool) error {
+
+	calendar, err := u.databaseRepo.GetCalendar(ctx, calendarID)
+	if err != nil {
 		return fmt.Errorf("fail to get calendar: %w", err)
 	}
 
-	events, recurringEvents, nextSyncToken, err := u.listEventsFromGoogleCalendar(ctx, calendarID)
+	// 一時停止中は Webhook に正常応答するため、エラーとはせずスキップする
+	// 再開時に同期トークンを使って差分を取得する
+	if calendar.IsPaused {
+		u.logger.Infof(ctx, "calendar is paused, skip sync (calendarID: %q)", calendarID)
+		return nil
+	}
+
+	// 再認可されるまでは同期できないため、一時停止中と同様にスキップする
+	if calendar.NeedsReauthAt != nil {
+		u.logger.Warnf(ctx, "calendar needs reauth, skip sync (calendarID: %q)", calendarID)
+		return nil
+	}
+
+	if stage, err := u.sync(ctx, *calendar, full); err != nil {
+		u.recordSyncFailure(ctx, calendarID, stage, err)
+
+		// Webhook が再送され続けないよう、再認可が必要な場合は記録のみ行い正常終了とする
+		if errors.Is(err, domain.CalendarNeedsReauthError) {
+			u.logger.Warnf(ctx, "calendar needs reauth, skip sync (calendarID: %q): %v", calendarID, err)
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// sync synchronizes the events of the calendar.
+// If full is true, all events and the instances of all recurring events are listed again without the sync token.
+// If it fails, the stage where the error occurred is returned along with the error.
+func (u *syncUsecase) sync(ctx context.Context, calendar entity.Calendar, full bool) (string, error) {
+
+	calendarID := calendar.ID
+
+	var syncToken string
+	if !full {
+		var err error
+		syncToken, err = u.databaseRepo.GetLatestSyncToken(ctx, calendarID)
+		if err != nil {
+			return constant.SyncStageDatabase, fmt.Errorf("fail to get latest sync token: %w", err)
+		}
+	}
+
+	events, recurringEvents, nextSyncToken, err := u.listEventsFromGoogleCalendar(ctx, calendar, syncToken)
 	if err != nil {
-		return fmt.Errorf("fail to list events from Google Calendar: %w", err)
+		return constant.SyncStageListEvents, fmt.Errorf("fail to list events from Google Calendar: %w", err)
 	}
 
 	syncTime := u.clockService.Now()
@@ -65,13 +125,26 @@ func (u *syncUsecase) Sync(ctx context.Context, calendarID valueobject.CalendarI
 	// 定期イベントの削除や Recurrence の削除を考慮し、 events と recurringEvents を更新する
 	events, recurringEvents, err = u.moveOrCopyCancelledRecurringEvents(ctx, events, recurringEvents)
 	if err != nil {
-		return fmt.Errorf("fail to move cancelled recurring events: %w", err)
+		return constant.SyncStageDatabase, fmt.Errorf("fail to move cancelled recurring events: %w", err)
+	}
+
+	// ここでは終了日が到達していない定期イベントのみを取得する
+	// 終了日が到達した定期イベントの終了日が延期された場合はここでは取得されず、
+	// 新規定期イベントと同様の挙動となり、後続の SyncRecurringEventAndInstancesWithAfter が呼ばれる
+	// （登録時に再度、存在チェックを行なっているため、新規登録ではなく更新処理となる）
+	// 全件同期では、変更のない定期イベントもインスタンスを取得し直す
+	var dbRecurringEvents []entity.RecurringEvent
+	if len(recurringEvents) > 0 && !full {
+		dbRecurringEvents, err = u.databaseRepo.ListActiveRecurringEventsWithAfter(ctx, calendarID, syncTime.Add(syncEventFrom))
+		if err != nil {
+			return constant.SyncStageDatabase, fmt.Errorf("fail to list recurring events: %w", err)
+		}
 	}
 
 	shouldSaveRecurringEvents, eventInstanceMap, err := u.listEventInstancesFromGoogleCalendar(
-		ctx, calendarID, recurringEvents, syncTime)
+		ctx, calendarID, recurringEvents, dbRecurringEvents, syncTime)
 	if err != nil {
-		return fmt.Errorf("fail to list event instances from Google Calendar: %w", err)
+		return constant.SyncStageListInstances, fmt.Errorf("fail to list event instances from Google Calendar: %w", err)
 	}
 
 	err = u.databaseRepo.RunTransaction(ctx, func(ctx context.Context, tx repository.DatabaseTransaction) error {
@@ -110,19 +183,85 @@ func (u *syncUsecase) Sync(ctx context.Context, calendarID valueobject.CalendarI
 			return fmt.Errorf("fail to create sync history: %w", err)
 		}
 
+		if err := tx.ResetConsecutiveSyncFailureCount(ctx, calendarID); err != nil {
+			return fmt.Errorf("fail to reset consecutive sync failure count: %w", err)
+		}
+
 		return nil
 	})
 
 	if err != nil {
-		return fmt.Errorf("fail to run transaction: %w", err)
+		return constant.SyncStageTransaction, fmt.Errorf("fail to run transaction: %w", err)
 	}
 
-	return nil
+	return "", nil
+}
+
+// recordSyncFailure records the failure of the sync and increments the consecutive failure count.
+// Failing to record is only logged so that the original error is returned to the caller.
+func (u *syncUsecase) recordSyncFailure(ctx context.Context,
+	calendarID valueobject.CalendarID, stage string, syncErr error) {
+
+	// 同期処理のトランザクションはロールバックされているため、別のトランザクションで記録する
+	// リクエストがキャンセルされた場合も記録できるよう、キャンセルを伝播させない
+	ctx = context.WithoutCancel(ctx)
+
+	err := u.databaseRepo.RunTransaction(ctx, func(ctx context.Context, tx repository.DatabaseTransaction) error {
+
+		failure := entity.SyncFailure{
+			CalendarID:   calendarID,
+			FailureTime:  u.clockService.Now(),
+			Stage:        stage,
+			ErrorMessage: syncErr.Error(),
+		}
+		if err := tx.CreateSyncFailure(ctx, failure); err != nil {
+			return fmt.Errorf("fail to create sync failure: %w", err)
+		}
+
+		if err := tx.IncrementConsecutiveSyncFailureCount(ctx, calendarID); err != nil {
+			return fmt.Errorf("fail to increment consecutive sync failure count: %w", err)
+		}
+
+		if errors.Is(syncErr, domain.CalendarNeedsReauthError) {
+			if err := tx.MarkCalendarNeedsReauth(ctx, calendarID); err != nil {
+				return fmt.Errorf("fail to mark calendar needs reauth: %w", err)
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		u.logger.Errorf(ctx, "fail to record sync failure (calendarID: %q): %v", calendarID, err)
+	}
+}
+
+// markCalendarNeedsReauth records that the calendar needs re-authorization
+// if err indicates that its refresh token was revoked or expired.
+// It is run in its own transaction because the transaction that failed has been rolled back.
+func markCalendarNeedsReauth(ctx context.Context, databaseRepo repository.DatabaseRepository,
+	logger applog.Logger, calendarID valueobject.CalendarID, err error) {
+
+	if !errors.Is(err, domain.CalendarNeedsReauthError) {
+		return
+	}
+
+	ctx = context.WithoutCancel(ctx)
+
+	err = databaseRepo.RunTransaction(ctx, func(ctx context.Context, tx repository.DatabaseTransaction) error {
+		return tx.MarkCalendarNeedsReauth(ctx, calendarID)
+	})
+	if err != nil {
+		logger.Errorf(ctx, "fail to mark calendar needs reauth (calendarID: %q): %v", calendarID, err)
+		return
+	}
+
+	logger.Warnf(ctx, "calendar needs reauth (calendarID: %q)", calendarID)
 }
 
 // listEventsFromGoogleCalendar retrieves events and recurring events from a Google Calendar.
 //
-// It first attempts to fetch events using the latest sync token stored in the database.
+// It first attempts to fetch events using the latest sync token stored in the database, specified as syncToken.
 // If the sync token is outdated or does not exist, it falls back to fetching all events from the calendar.
 //
 // Recurring event and non-recurring event are determined by the presence or absence
@@ -135,13 +274,11 @@ func (u *syncUsecase) Sync(ctx context.Context, calendarID valueobject.CalendarI
 // - recurringEvents: A slice of recurring events retrieved from the calendar.
 // - nextSyncToken: A string representing the next sync token to be used for subsequent sync operations.
 // - error: An error object if the operation fails, or nil if successful.
-func (u *syncUsecase) listEventsFromGoogleCalendar(ctx context.Context, calendarID valueobject.CalendarID) (
+func (u *syncUsecase) listEventsFromGoogleCalendar(ctx context.Context, calendar entity.Calendar, syncToken string) (
 	[]entity.Event, []entity.RecurringEvent, string, error) {
-	syncToken, err := u.databaseRepo.GetLatestSyncToken(ctx, calendarID)
-	if err != nil {
-		return nil, nil, "", fmt.Errorf("fail to get latest sync token: %w", err)
-	}
+	calendarID := calendar.ID
 
+	var err error
 	var events []entity.Event
 	var recurringEvents []entity.RecurringEvent
 	var nextSyncToken string
@@ -151,7 +288,7 @@ func (u *syncUsecase) listEventsFromGoogleCalendar(ctx context.Context, calendar
 			if err == domain.SyncTokenIsOldError {
 				// syncToken が古い場合は、全件取得して更新する
 				u.logger.Info(ctx, "sync token is old, sync all events")
-				events, recurringEvents, nextSyncToken, err = u.listAllEventsFromGoogleCalendar(ctx, calendarID)
+				events, recurringEvents, nextSyncToken, err = u.listAllEventsFromGoogleCalendar(ctx, calendar)
 				if err != nil {
 					return nil, nil, "", fmt.Errorf("fail to sync all events (sync token is old): %w", err)
 				}
@@ -161,7 +298,7 @@ func (u *syncUsecase) listEventsFromGoogleCalendar(ctx context.Context, calendar
 		}
 	} else {
 		u.logger.Info(ctx, "sync all events")
-		events, recurringEvents, nextSyncToken, err = u.listAllEventsFromGoogleCalendar(ctx, calendarID)
+		events, recurringEvents, nextSyncToken, err = u.listAllEventsFromGoogleCalendar(ctx, calendar)
 		if err != nil {
 			return nil, nil, "", fmt.Errorf("fail to sync all events (sync token doesn't exist): %w", err)
 		}
@@ -170,11 +307,11 @@ func (u *syncUsecase) listEventsFromGoogleCalendar(ctx context.Context, calendar
 	return events, recurringEvents, nextSyncToken, nil
 }
 
-func (u *syncUsecase) listAllEventsFromGoogleCalendar(ctx context.Context, calendarID valueobject.CalendarID) (
+func (u *syncUsecase) listAllEventsFromGoogleCalendar(ctx context.Context, calendar entity.Calendar) (
 	[]entity.Event, []entity.RecurringEvent, string, error) {
-	after := u.clockService.Today().Add(syncEventFrom)
+	after := u.today(ctx, calendar).Add(syncEventFrom)
 
-	events, recurringEvents, nextSyncToken, err := u.googleCalenderRepo.ListEventsWithAfter(ctx, calendarID, after)
+	events, recurringEvents, nextSyncToken, err := u.googleCalenderRepo.ListEventsWithAfter(ctx, calendar.ID, after)
 	if err != nil {
 		return nil, nil, "", fmt.Errorf("fail to list events: %w", err)
 	}
@@ -182,6 +319,24 @@ func (u *syncUsecase) listAllEventsFromGoogleCalendar(ctx context.Context, calen
 	return events, recurringEvents, nextSyncToken, nil
 }
 
+// today returns the start of today in the time zone of the calendar.
+// If the time zone is unknown, the default location of the clock is used.
+func (u *syncUsecase) today(ctx context.Context, calendar entity.Calendar) time.Time {
+
+	// タイムゾーン取得前に登録されたカレンダーは空となる
+	if calendar.TimeZone == "" {
+		return u.clockService.Today()
+	}
+
+	loc, err := time.LoadLocation(calendar.TimeZone)
+	if err != nil {
+		u.logger.Warnf(ctx, "fail to load location of calendar, use default location (timeZone: %q): %v", calendar.TimeZone, err)
+		return u.clockService.Today()
+	}
+
+	return u.clockService.TodayIn(loc)
+}
+
 func (u *syncUsecase) moveOrCopyCancelledRecurringEvents(ctx context.Context,
 	events []entity.Event, recurringEvents []entity.RecurringEvent) (
 	[]entity.Event, []entity.RecurringEvent, error) {
@@ -230,7 +385,7 @@ func (u *syncUsecase) moveOrCopyCancelledRecurringEvents(ctx context.Context,
 }
 
 func (u *syncUsecase) listEventInstancesFromGoogleCalendar(ctx context.Context,
-	calendarID valueobject.CalendarID, recurringEvents []entity.RecurringEvent, syncTime time.Time) (
+	calendarID valueobject.CalendarID, recurringEvents, dbRecurringEvents []entity.RecurringEvent, syncTime time.Time) (
 	[]entity.RecurringEvent, map[valueobject.EventID][]entity.Event, error) {
 
 	if len(recurringEvents) == 0 {
@@ -240,14 +395,6 @@ func (u *syncUsecase) listEventInstancesFromGoogleCalendar(ctx context.Context,
 	shouldSaveRecurringEvents := make([]entity.RecurringEvent, 0, len(recurringEvents))
 	eventInstanceMap := map[valueobject.EventID][]entity.Event{}
 
-	// ここでは終了日が到達していない定期イベントのみを取得する
-	// 終了日が到達した定期イベントの終了日が延期された場合はここでは取得されず、
-	// 新規定期イベントと同様の挙動となり、後続の SyncRecurringEventAndInstancesWithAfter が呼ばれる
-	// （登録時に再度、存在チェックを行なっているため、新規登録ではなく更新処理となる）
-	dbRecurringEvents, err := u.databaseRepo.ListActiveRecurringEventsWithAfter(ctx, calendarID, syncTime.Add(syncEventFrom))
-	if err != nil {
-		return nil, nil, fmt.Errorf("fail to list recurring events: %w", err)
-	}
 	recurringEventMap, err := u.convertToRecurringEventMap(dbRecurringEvents)
 	if err != nil {
 		return nil, nil, fmt.Errorf("fail to convert recurring events: %w", err)
@@ -288,8 +435,43 @@ func (u *syncUsecase) convertToRecurringEventMap(recurringEvents []entity.Recurr
 	return recurringEventMap, nil
 }
 
-func (u *syncUsecase) SyncFutureInstanceAll(ctx context.Context) error {
-	calendars, err := u.databaseRepo.ListCalendars(ctx)
+func (u *syncUsecase) SyncAll(ctx context.Context, tag *string) error {
+	return u.syncAll(ctx, tag, false)
+}
+
+func (u *syncUsecase) ResyncAll(ctx context.Context, tag *string) error {
+	return u.syncAll(ctx, tag, true)
+}
+
+func (u *syncUsecase) syncAll(ctx context.Context, tag *string, full bool) error {
+	calendars, err := u.databaseRepo.ListCalendars(ctx, tag)
+	if err != nil {
+		return fmt.Errorf("fail to list calendars: %w", err)
+	}
+
+	failedCount, targetCount := 0, 0
+	for _, calendar := range calendars {
+		if calendar.IsPaused || calendar.NeedsReauthAt != nil {
+			continue
+		}
+		targetCount++
+
+		// 失敗は Sync 内で記録されるため、1 件の失敗で他のカレンダーの同期を止めない
+		if err := u.syncCalendar(ctx, calendar.ID, full); err != nil {
+			u.logger.Errorf(ctx, "fail to sync (calendarID: %q): %v", calendar.ID, err)
+			failedCount++
+		}
+	}
+
+	if failedCount > 0 {
+		return fmt.Errorf("fail to sync %d of %d calendars", failedCount, targetCount)
+	}
+
+	return nil
+}
+
+func (u *syncUsecase) SyncFutureInstanceAll(ctx context.Context, tag *string) error {
+	calendars, err := u.databaseRepo.ListCalendars(ctx, tag)
 	if err != nil {
 		return fmt.Errorf("fail to list calendars: %w", err)
 	}
@@ -297,8 +479,17 @@ func (u *syncUsecase) SyncFutureInstanceAll(ctx context.Context) error {
 	now := u.clockService.Now()
 
 	for _, calendar := range calendars {
+		if calendar.IsPaused || calendar.NeedsReauthAt != nil {
+			continue
+		}
+
 		// TODO: カレンダーの数によっては時間がかかるため、非同期化や分散処理の検討が必要
 		if err := u.syncFutureInstance(ctx, calendar.ID, now); err != nil {
+			// 再認可が必要なカレンダーのために、他のカレンダーの同期を止めない
+			if errors.Is(err, domain.CalendarNeedsReauthError) {
+				markCalendarNeedsReauth(ctx, u.databaseRepo, u.logger, calendar.ID, err)
+				continue
+			}
 			return fmt.Errorf("fail to sync future instance (calendarID: %q): %w", calendar.ID, err)
 		}
 	}
@@ -396,3 +587,100 @@ func (u *syncUsecase) listFutureInstancesFromGoogleCalendar(ctx context.Context,
 
 	return shouldSaveRecurringEvents, eventInstanceMap, nil
 }
+
+func (u *syncUsecase) GetStatus(ctx context.Context, calendarID valueobject.CalendarID) (*entity.SyncStatus, error) {
+
+	calendar, err := u.databaseRepo.GetCalendar(ctx, calendarID)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get calendar: %w", err)
+	}
+
+	latestSync, err := u.databaseRepo.GetLatestSyncHistory(ctx, calendarID)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get latest sync history: %w", err)
+	}
+
+	latestSyncFutureInstance, err := u.databaseRepo.GetLatestSyncFutureInstanceHistory(ctx, calendarID)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get latest sync future instance history: %w", err)
+	}
+
+	activeChannel, err := u.databaseRepo.GetActiveChannelHistory(ctx, calendarID)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get active channel history: %w", err)
+	}
+
+	latestFailure, err := u.databaseRepo.GetLatestSyncFailure(ctx, calendarID)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get latest sync failure: %w", err)
+	}
+
+	consecutiveFailureCount, err := u.databaseRepo.GetConsecutiveSyncFailureCount(ctx, calendarID)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get consecutive sync failure count: %w", err)
+	}
+
+	status := &entity.SyncStatus{
+		CalendarID:               calendarID,
+		LatestSync:               latestSync,
+		LatestSyncFutureInstance: latestSyncFutureInstance,
+		ActiveChannel:            activeChannel,
+		LatestFailure:            latestFailure,
+		ConsecutiveFailureCount:  consecutiveFailureCount,
+		NeedsReauthAt:            calendar.NeedsReauthAt,
+	}
+
+	// 同期トークンは最新の同期時に発行されたものが利用される
+	if latestSync != nil {
+		age := u.clockService.Now().Sub(latestSync.SyncTime)
+		status.SyncTokenAge = &age
+	}
+
+	return status, nil
+}
+
+func (u *syncUsecase) ListSyncHistories(ctx context.Context,
+	calendarID valueobject.CalendarID, before *time.Time, limit int) ([]entity.SyncHistory, *time.Time, error) {
+
+	if _, err := u.databaseRepo.GetCalendar(ctx, calendarID); err != nil {
+		return nil, nil, fmt.Errorf("fail to get calendar: %w", err)
+	}
+
+	// 次のページの有無を判定するため、1 件多く取得する
+	histories, err := u.databaseRepo.ListSyncHistories(ctx, calendarID, before, limit+1)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to list sync histories: %w", err)
+	}
+
+	if len(histories) <= limit {
+		return histories, nil, nil
+	}
+
+	histories = histories[:limit]
+	nextBefore := histories[limit-1].SyncTime
+
+	return histories, &nextBefore, nil
+}
+
+func (u *syncUsecase) ListSyncFutureInstanceHistories(ctx context.Context,
+	calendarID valueobject.CalendarID, before *time.Time, limit int) ([]entity.SyncFutureInstanceHistory, *time.Time, error) {
+
+	if _, err := u.databaseRepo.GetCalendar(ctx, calendarID); err != nil {
+		return nil, nil, fmt.Errorf("fail to get calendar: %w", err)
+	}
+
+	// 次のページの有無を判定するため、1 件多く取得する
+	histories, err := u.databaseRepo.ListSyncFutureInstanceHistories(ctx, calendarID, before, limit+1)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to list sync future instance histories: %w", err)
+	}
+
+	if len(histories) <= limit {
+		return histories, nil, nil
+	}
+
+	histories = histories[:limit]
+	nextBefore := histories[limit-1].SyncTime
+
+	return histories, &nextBefore, nil
+}
@@ -3,6 +3,9 @@ package usecase_test
 import (
 	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"strings"
 	"testing"
 	"time"
@@ -11,6 +14,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/takuoki/golib/applog"
 	"github.com/takuoki/google-calendar-sync/api/domain"
+	"github.com/takuoki/google-calendar-sync/api/domain/constant"
 	"github.com/takuoki/google-calendar-sync/api/domain/entity"
 	"github.com/takuoki/google-calendar-sync/api/domain/service"
 	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
@@ -256,3 +260,505 @@ func TestSyncUsecase_Sync_Success_OldSyncToken(t *testing.T) {
 	logs := strings.Split(buf.String(), "\n")
 	require.Contains(t, logs, "sync token is old, sync all events")
 }
+
+func TestSyncUsecase_Sync_Success_CalendarTimeZone(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		calendarID    valueobject.CalendarID
+		timeZone      string
+		expectedAfter time.Time
+	}{
+		"calendar time zone": {
+			calendarID:    "sync-success-calendar-time-zone-1",
+			timeZone:      "Asia/Tokyo",
+			expectedAfter: time.Date(2025, 1, 2, 0, 0, 0, 0, tokyo).Add(-7 * 24 * time.Hour),
+		},
+		"unknown time zone": {
+			calendarID:    "sync-success-calendar-time-zone-2",
+			timeZone:      "",
+			expectedAfter: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC).Add(-7 * 24 * time.Hour),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Given
+			// 20:00 in UTC is already the next day in Asia/Tokyo
+			mockClock := service.NewMockClock()
+			mockClock.SetFixedTime(time.Date(2025, 1, 1, 20, 0, 0, 0, time.UTC))
+
+			var actualAfter time.Time
+			mockRepo := &GoogleCalendarRepositoryMock{
+				ListEventsWithAfterFunc: func(ctx context.Context,
+					calendarID valueobject.CalendarID, after time.Time) ([]entity.Event, []entity.RecurringEvent, string, error) {
+					actualAfter = after
+					return []entity.Event{}, []entity.RecurringEvent{}, "new-sync-token", nil
+				},
+			}
+
+			syncUsecase, _ := setupSyncUsecase(mockClock, mockRepo)
+
+			require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+				ID:       tt.calendarID,
+				Name:     "Test Calendar",
+				TimeZone: tt.timeZone,
+			}))
+
+			// When
+			require.NoError(t, syncUsecase.Sync(ctx, tt.calendarID))
+
+			// Then
+			assert.True(t, tt.expectedAfter.Equal(actualAfter), "expected %v, got %v", tt.expectedAfter, actualAfter)
+		})
+	}
+}
+
+func TestSyncUsecase_Sync_Failure_RecordsFailure(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mockClock := service.NewMockClock()
+
+	// Given
+	var calendarID valueobject.CalendarID = "sync-failure-records-failure-1"
+
+	mockRepo := &GoogleCalendarRepositoryMock{
+		ListEventsWithAfterFunc: func(ctx context.Context,
+			calendarID valueobject.CalendarID, after time.Time) ([]entity.Event, []entity.RecurringEvent, string, error) {
+			return nil, nil, "", errors.New("google api error")
+		},
+	}
+
+	syncUsecase, _ := setupSyncUsecase(mockClock, mockRepo)
+
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:   calendarID,
+		Name: "Test Calendar",
+	}))
+
+	// When
+	require.Error(t, syncUsecase.Sync(ctx, calendarID))
+	require.Error(t, syncUsecase.Sync(ctx, calendarID))
+
+	// Then
+	status, err := syncUsecase.GetStatus(ctx, calendarID)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, status.ConsecutiveFailureCount)
+	require.NotNil(t, status.LatestFailure)
+	assert.Equal(t, constant.SyncStageListEvents, status.LatestFailure.Stage)
+	assert.Contains(t, status.LatestFailure.ErrorMessage, "google api error")
+	assert.Nil(t, status.LatestSync)
+
+	// When
+	mockRepo.ListEventsWithAfterFunc = func(ctx context.Context,
+		calendarID valueobject.CalendarID, after time.Time) ([]entity.Event, []entity.RecurringEvent, string, error) {
+		return []entity.Event{}, []entity.RecurringEvent{}, "new-sync-token", nil
+	}
+	require.NoError(t, syncUsecase.Sync(ctx, calendarID))
+
+	// Then
+	// Verify the consecutive failure count was reset
+	status, err = syncUsecase.GetStatus(ctx, calendarID)
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, status.ConsecutiveFailureCount)
+	assert.NotNil(t, status.LatestFailure)
+	assert.NotNil(t, status.LatestSync)
+}
+
+// failingSyncTokenRepository fails to read the sync token, to simulate a database failure.
+type failingSyncTokenRepository struct {
+	repository.DatabaseRepository
+}
+
+func (r *failingSyncTokenRepository) GetLatestSyncToken(ctx context.Context, calendarID valueobject.CalendarID) (string, error) {
+	return "", errors.New("database error")
+}
+
+func TestSyncUsecase_Sync_Failure_Database(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mockClock := service.NewMockClock()
+
+	// Given
+	var calendarID valueobject.CalendarID = "sync-failure-database-1"
+
+	logger, err := applog.NewSimpleLogger(io.Discard)
+	require.NoError(t, err)
+	syncUsecase := usecase.NewSyncUsecase(mockClock, &GoogleCalendarRepositoryMock{},
+		&failingSyncTokenRepository{DatabaseRepository: mysqlRepo}, logger)
+
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:   calendarID,
+		Name: "Test Calendar",
+	}))
+
+	// When
+	require.Error(t, syncUsecase.Sync(ctx, calendarID))
+
+	// Then
+	status, err := syncUsecase.GetStatus(ctx, calendarID)
+	require.NoError(t, err)
+
+	require.NotNil(t, status.LatestFailure)
+	assert.Equal(t, constant.SyncStageDatabase, status.LatestFailure.Stage)
+	assert.Contains(t, status.LatestFailure.ErrorMessage, "database error")
+}
+
+func TestSyncUsecase_SyncAll_FailureCount(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mockClock := service.NewMockClock()
+
+	// Given
+	tag := "sync-all-failure-count"
+	mockRepo := &GoogleCalendarRepositoryMock{
+		ListEventsWithAfterFunc: func(ctx context.Context,
+			calendarID valueobject.CalendarID, after time.Time) ([]entity.Event, []entity.RecurringEvent, string, error) {
+			return nil, nil, "", errors.New("google api error")
+		},
+	}
+
+	syncUsecase, _ := setupSyncUsecase(mockClock, mockRepo)
+
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:   "sync-all-failure-count-1",
+		Name: "Test Calendar",
+		Tags: []string{tag},
+	}))
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:       "sync-all-failure-count-2",
+		Name:     "Paused Calendar",
+		Tags:     []string{tag},
+		IsPaused: true,
+	}))
+
+	// When
+	err := syncUsecase.SyncAll(ctx, &tag)
+
+	// Then: 一時停止中のカレンダーは対象数に含めない
+	assert.EqualError(t, err, "fail to sync 1 of 1 calendars")
+}
+
+func TestSyncUsecase_ResyncAll_Success(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mockClock := service.NewMockClock()
+
+	p := func(t time.Time) *time.Time {
+		return &t
+	}
+
+	// Given
+	tag := "resync-all-success"
+	var calendarID valueobject.CalendarID = "resync-all-success-1"
+
+	recurringEvent := entity.RecurringEvent{
+		ID:         "recurring-event-1",
+		CalendarID: calendarID,
+		Summary:    "Test Recurring Event",
+		Recurrence: `["RRULE:FREQ=WEEKLY;BYDAY=MO"]`,
+		Start:      p(mockClock.Now().Add(12 * time.Hour)),
+		End:        p(mockClock.Now().Add(13 * time.Hour)),
+		Status:     "confirmed",
+	}
+	instance := entity.Event{
+		ID:               "recurring-event-1_20250101",
+		CalendarID:       calendarID,
+		RecurringEventID: &recurringEvent.ID,
+		Summary:          "Test Recurring Event",
+		Start:            recurringEvent.Start,
+		End:              recurringEvent.End,
+		OriginalStart:    recurringEvent.Start,
+		Status:           "confirmed",
+	}
+
+	mockRepo := &GoogleCalendarRepositoryMock{
+		ListEventsWithSyncTokenFunc: func(ctx context.Context,
+			calendarID valueobject.CalendarID, syncToken string) ([]entity.Event, []entity.RecurringEvent, string, error) {
+			t.Error("sync token must not be used")
+			return nil, nil, "", errors.New("unexpected call")
+		},
+		ListEventsWithAfterFunc: func(ctx context.Context,
+			calendarID valueobject.CalendarID, after time.Time) ([]entity.Event, []entity.RecurringEvent, string, error) {
+			return []entity.Event{}, []entity.RecurringEvent{recurringEvent}, "new-sync-token", nil
+		},
+		ListEventInstancesBetweenFunc: func(ctx context.Context, calendarID valueobject.CalendarID,
+			eventID valueobject.EventID, from, to time.Time) ([]entity.Event, error) {
+			return []entity.Event{instance}, nil
+		},
+	}
+
+	syncUsecase, _ := setupSyncUsecase(mockClock, mockRepo)
+
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:   calendarID,
+		Name: "Test Calendar",
+		Tags: []string{tag},
+	}))
+	require.NoError(t, mysqlRepo.CreateSyncHistory(ctx, t,
+		calendarID, mockClock.Now().Add(-1*time.Hour), "sync-token", 0))
+
+	// 同じ定期イベントが、元の開始日時を持たないインスタンスとともに同期済み
+	require.NoError(t, mysqlRepo.CreateRecurringEvent(ctx, t, recurringEvent))
+	syncedInstance := instance
+	syncedInstance.OriginalStart = nil
+	require.NoError(t, mysqlRepo.CreateEvent(ctx, t, syncedInstance))
+
+	// When
+	err := syncUsecase.ResyncAll(ctx, &tag)
+	require.NoError(t, err)
+
+	// Then: 変更のない定期イベントのインスタンスも取得し直される
+	events, err := mysqlRepo.ListEventsWithFilter(ctx, calendarID, entity.EventFilter{}, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assertEqualEvent(t, instance, events[0])
+	assertEqualTime(t, instance.OriginalStart, events[0].OriginalStart)
+
+	syncToken, err := mysqlRepo.GetLatestSyncToken(ctx, calendarID)
+	require.NoError(t, err)
+	assert.Equal(t, "new-sync-token", syncToken)
+}
+
+func TestSyncUsecase_Sync_Failure_NeedsReauth(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mockClock := service.NewMockClock()
+
+	// Given
+	var calendarID valueobject.CalendarID = "sync-failure-needs-reauth-1"
+
+	listCount := 0
+	mockRepo := &GoogleCalendarRepositoryMock{
+		ListEventsWithAfterFunc: func(ctx context.Context,
+			calendarID valueobject.CalendarID, after time.Time) ([]entity.Event, []entity.RecurringEvent, string, error) {
+			listCount++
+			return nil, nil, "", fmt.Errorf("%w: invalid_grant", domain.CalendarNeedsReauthError)
+		},
+	}
+
+	syncUsecase, _ := setupSyncUsecase(mockClock, mockRepo)
+
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:   calendarID,
+		Name: "Test Calendar",
+	}))
+
+	// When
+	err := syncUsecase.Sync(ctx, calendarID)
+
+	// Then
+	// Webhook に正常応答するため、エラーとはしない
+	require.NoError(t, err)
+
+	status, err := syncUsecase.GetStatus(ctx, calendarID)
+	require.NoError(t, err)
+	require.NotNil(t, status.NeedsReauthAt)
+	now := mockClock.Now()
+	assert.True(t, assertEqualTime(t, &now, status.NeedsReauthAt))
+
+	// When
+	// Syncs are skipped until the calendar is re-authorized
+	require.NoError(t, syncUsecase.Sync(ctx, calendarID))
+
+	// Then
+	assert.Equal(t, 1, listCount)
+}
+
+func TestSyncUsecase_Sync_Failure_ListInstancesStage(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mockClock := service.NewMockClock()
+
+	p := func(t time.Time) *time.Time {
+		return &t
+	}
+
+	// Given
+	var calendarID valueobject.CalendarID = "sync-failure-list-instances-stage-1"
+
+	recurringEvent := entity.RecurringEvent{
+		ID:         "recurring-event-1",
+		CalendarID: calendarID,
+		Summary:    "Test Recurring Event",
+		Recurrence: `["RRULE:FREQ=WEEKLY;BYDAY=MO"]`,
+		Start:      p(mockClock.Now().Add(12 * time.Hour)),
+		End:        p(mockClock.Now().Add(13 * time.Hour)),
+		Status:     "confirmed",
+	}
+
+	mockRepo := &GoogleCalendarRepositoryMock{
+		ListEventsWithAfterFunc: func(ctx context.Context,
+			calendarID valueobject.CalendarID, after time.Time) ([]entity.Event, []entity.RecurringEvent, string, error) {
+			return []entity.Event{}, []entity.RecurringEvent{recurringEvent}, "new-sync-token", nil
+		},
+		ListEventInstancesBetweenFunc: func(ctx context.Context, calendarID valueobject.CalendarID,
+			eventID valueobject.EventID, from, to time.Time) ([]entity.Event, error) {
+			return nil, errors.New("google api error")
+		},
+	}
+
+	syncUsecase, _ := setupSyncUsecase(mockClock, mockRepo)
+
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:   calendarID,
+		Name: "Test Calendar",
+	}))
+
+	// When
+	require.Error(t, syncUsecase.Sync(ctx, calendarID))
+
+	// Then
+	status, err := syncUsecase.GetStatus(ctx, calendarID)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, status.ConsecutiveFailureCount)
+	require.NotNil(t, status.LatestFailure)
+	assert.Equal(t, constant.SyncStageListInstances, status.LatestFailure.Stage)
+}
+
+func TestSyncUsecase_GetStatus_Success(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mockClock := service.NewMockClock()
+
+	// Given
+	var calendarID valueobject.CalendarID = "get-status-success-1"
+
+	syncUsecase, _ := setupSyncUsecase(mockClock, &GoogleCalendarRepositoryMock{})
+
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:   calendarID,
+		Name: "Test Calendar",
+	}))
+
+	latestSyncTime := mockClock.Now().Add(-1 * time.Hour)
+	require.NoError(t, mysqlRepo.CreateSyncHistory(ctx, t,
+		calendarID, latestSyncTime.Add(-1*time.Hour), "old-sync-token", 1))
+	require.NoError(t, mysqlRepo.CreateSyncHistory(ctx, t,
+		calendarID, latestSyncTime, "sync-token", 3))
+
+	futureInstanceSyncTime := mockClock.Now().Add(-24 * time.Hour)
+	require.NoError(t, mysqlRepo.CreateSyncFutureInstanceHistory(ctx, t,
+		calendarID, futureInstanceSyncTime, 5))
+
+	startTime := mysqlRepo.Clock(t).Now().Add(-1 * time.Hour)
+	activeChannel := entity.Channel{
+		CalendarID: calendarID,
+		ResourceID: "active-resource-id",
+		StartTime:  startTime,
+		Expiration: startTime.Add(2 * time.Hour),
+		IsStopped:  false,
+	}
+	require.NoError(t, mysqlRepo.CreateChannelHistory(ctx, t, activeChannel))
+
+	// When
+	status, err := syncUsecase.GetStatus(ctx, calendarID)
+	require.NoError(t, err)
+
+	// Then
+	assert.Equal(t, calendarID, status.CalendarID)
+
+	require.NotNil(t, status.LatestSync)
+	assert.True(t, assertEqualTime(t, &latestSyncTime, &status.LatestSync.SyncTime))
+	assert.Equal(t, 3, status.LatestSync.UpdatedEventCount)
+
+	require.NotNil(t, status.SyncTokenAge)
+	assert.Equal(t, 1*time.Hour, *status.SyncTokenAge)
+
+	require.NotNil(t, status.LatestSyncFutureInstance)
+	assert.True(t, assertEqualTime(t, &futureInstanceSyncTime, &status.LatestSyncFutureInstance.SyncTime))
+	assert.Equal(t, 5, status.LatestSyncFutureInstance.UpdatedEventCount)
+
+	require.NotNil(t, status.ActiveChannel)
+	assert.Equal(t, valueobject.ResourceID("active-resource-id"), status.ActiveChannel.ResourceID)
+}
+
+func TestSyncUsecase_GetStatus_NeverSynced(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	var calendarID valueobject.CalendarID = "get-status-never-synced-1"
+
+	syncUsecase, _ := setupSyncUsecase(service.NewMockClock(), &GoogleCalendarRepositoryMock{})
+
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:   calendarID,
+		Name: "Test Calendar",
+	}))
+
+	// When
+	status, err := syncUsecase.GetStatus(ctx, calendarID)
+	require.NoError(t, err)
+
+	// Then
+	assert.Equal(t, calendarID, status.CalendarID)
+	assert.Nil(t, status.LatestSync)
+	assert.Nil(t, status.SyncTokenAge)
+	assert.Nil(t, status.LatestSyncFutureInstance)
+	assert.Nil(t, status.ActiveChannel)
+}
+
+func TestSyncUsecase_ListSyncHistories_Pagination(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mockClock := service.NewMockClock()
+
+	// Given
+	var calendarID valueobject.CalendarID = "list-sync-histories-pagination-1"
+
+	syncUsecase, _ := setupSyncUsecase(mockClock, &GoogleCalendarRepositoryMock{})
+
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:   calendarID,
+		Name: "Test Calendar",
+	}))
+
+	syncTimes := []time.Time{
+		mockClock.Now().Add(-1 * time.Hour),
+		mockClock.Now().Add(-2 * time.Hour),
+		mockClock.Now().Add(-3 * time.Hour),
+	}
+	for i, syncTime := range syncTimes {
+		require.NoError(t, mysqlRepo.CreateSyncHistory(ctx, t,
+			calendarID, syncTime, "sync-token", i))
+	}
+
+	// When
+	firstPage, nextBefore, err := syncUsecase.ListSyncHistories(ctx, calendarID, nil, 2)
+	require.NoError(t, err)
+
+	// Then
+	require.Len(t, firstPage, 2)
+	assert.True(t, assertEqualTime(t, &syncTimes[0], &firstPage[0].SyncTime))
+	assert.True(t, assertEqualTime(t, &syncTimes[1], &firstPage[1].SyncTime))
+	require.NotNil(t, nextBefore)
+
+	// When
+	secondPage, nextBefore, err := syncUsecase.ListSyncHistories(ctx, calendarID, nextBefore, 2)
+	require.NoError(t, err)
+
+	// Then
+	require.Len(t, secondPage, 1)
+	assert.True(t, assertEqualTime(t, &syncTimes[2], &secondPage[0].SyncTime))
+	assert.Nil(t, nextBefore)
+}
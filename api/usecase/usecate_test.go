@@ -18,6 +18,9 @@ import (
 
 var mysqlRepo *mysql.MysqlRepository
 
+// testDB is the database connection of mysqlRepo, to create repositories with a different clock.
+var testDB *sql.DB
+
 func TestMain(m *testing.M) {
 
 	ctx := context.Background()
@@ -49,6 +52,7 @@ func TestMain(m *testing.M) {
 		panic("fail to create logger: " + err.Error())
 	}
 
+	testDB = db
 	mysqlRepo = mysql.NewMysqlRepository(db, service.NewMockClock(), nil, logger)
 
 	m.Run()
@@ -76,6 +80,15 @@ func waitForDatabaseReady(ctx context.Context, db *sql.DB) error {
 }
 
 func cleanupForMain(ctx context.Context, m *testing.M) {
+	if _, err := mysqlRepo.DeleteAllOAuthStatesForMain(ctx, m); err != nil {
+		panic("fail to delete all oauth states: " + err.Error())
+	}
+	if _, err := mysqlRepo.DeleteAllLeasesForMain(ctx, m); err != nil {
+		panic("fail to delete all leases: " + err.Error())
+	}
+	if _, err := mysqlRepo.DeleteAllSyncFailuresForMain(ctx, m); err != nil {
+		panic("fail to delete all sync failures: " + err.Error())
+	}
 	if _, err := mysqlRepo.DeleteAllSyncFutureInstanceHistoriesForMain(ctx, m); err != nil {
 		panic("fail to delete all sync future instance histories: " + err.Error())
 	}
@@ -91,6 +104,9 @@ func cleanupForMain(ctx context.Context, m *testing.M) {
 	if _, err := mysqlRepo.DeleteAllRecurringEventsForMain(ctx, m); err != nil {
 		panic("fail to delete all recurring events: " + err.Error())
 	}
+	if _, err := mysqlRepo.DeleteAllCalendarTagsForMain(ctx, m); err != nil {
+		panic("fail to delete all calendar tags: " + err.Error())
+	}
 	if _, err := mysqlRepo.DeleteAllCalendarsForMain(ctx, m); err != nil {
 		panic("fail to delete all calendars: " + err.Error())
 	}
@@ -99,6 +115,15 @@ func cleanupForMain(ctx context.Context, m *testing.M) {
 func cleanup(ctx context.Context, t *testing.T) {
 	t.Helper()
 
+	if _, err := mysqlRepo.DeleteAllOAuthStates(ctx, t); err != nil {
+		panic("fail to delete all oauth states: " + err.Error())
+	}
+	if _, err := mysqlRepo.DeleteAllLeases(ctx, t); err != nil {
+		panic("fail to delete all leases: " + err.Error())
+	}
+	if _, err := mysqlRepo.DeleteAllSyncFailures(ctx, t); err != nil {
+		panic("fail to delete all sync failures: " + err.Error())
+	}
 	if _, err := mysqlRepo.DeleteAllSyncFutureInstanceHistories(ctx, t); err != nil {
 		panic("fail to delete all sync future instance histories: " + err.Error())
 	}
@@ -114,6 +139,9 @@ func cleanup(ctx context.Context, t *testing.T) {
 	if _, err := mysqlRepo.DeleteAllRecurringEvents(ctx, t); err != nil {
 		panic("fail to delete all recurring events: " + err.Error())
 	}
+	if _, err := mysqlRepo.DeleteAllCalendarTags(ctx, t); err != nil {
+		panic("fail to delete all calendar tags: " + err.Error())
+	}
 	if _, err := mysqlRepo.DeleteAllCalendars(ctx, t); err != nil {
 		panic("fail to delete all calendars: " + err.Error())
 	}
@@ -2,15 +2,18 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/takuoki/golib/applog"
+	"github.com/takuoki/google-calendar-sync/api/domain"
 	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
 	"github.com/takuoki/google-calendar-sync/api/repository"
 )
 
 type WatchUsecase interface {
-	StartAll(ctx context.Context) error
+	// StartAll starts watching all calendars if tag is nil, otherwise the calendars with the tag.
+	StartAll(ctx context.Context, tag *string) error
 	Start(ctx context.Context, calendarID valueobject.CalendarID) error
 	Stop(ctx context.Context, calendarID valueobject.CalendarID) error
 }
@@ -33,15 +36,23 @@ func NewWatchUsecase(
 	}
 }
 
-func (u *watchUsecase) StartAll(ctx context.Context) error {
+func (u *watchUsecase) StartAll(ctx context.Context, tag *string) error {
 
-	calendars, err := u.databaseRepo.ListCalendars(ctx)
+	calendars, err := u.databaseRepo.ListCalendars(ctx, tag)
 	if err != nil {
 		return fmt.Errorf("fail to list calendars: %w", err)
 	}
 
 	for _, calendar := range calendars {
+		if calendar.IsPaused || calendar.NeedsReauthAt != nil {
+			continue
+		}
+
 		if err := u.Start(ctx, calendar.ID); err != nil {
+			// 再認可が必要なカレンダーのために、他のカレンダーの監視開始を止めない
+			if errors.Is(err, domain.CalendarNeedsReauthError) {
+				continue
+			}
 			return fmt.Errorf("fail to start (calendarID: %q): %w", calendar.ID, err)
 		}
 	}
@@ -51,11 +62,16 @@ func (u *watchUsecase) StartAll(ctx context.Context) error {
 
 func (u *watchUsecase) Start(ctx context.Context, calendarID valueobject.CalendarID) error {
 
-	if _, err := u.databaseRepo.GetCalendar(ctx, calendarID); err != nil {
+	calendar, err := u.databaseRepo.GetCalendar(ctx, calendarID)
+	if err != nil {
 		return fmt.Errorf("fail to get calendar: %w", err)
 	}
 
-	err := u.databaseRepo.RunTransaction(ctx, func(ctx context.Context, tx repository.DatabaseTransaction) error {
+	if calendar.NeedsReauthAt != nil {
+		return domain.CalendarNeedsReauthError
+	}
+
+	err = u.databaseRepo.RunTransaction(ctx, func(ctx context.Context, tx repository.DatabaseTransaction) error {
 
 		if err := u.stopIfExistActiveChannel(ctx, tx, calendarID); err != nil {
 			return fmt.Errorf("fail to stop: %w", err)
@@ -77,6 +93,7 @@ func (u *watchUsecase) Start(ctx context.Context, calendarID valueobject.Calenda
 	})
 
 	if err != nil {
+		markCalendarNeedsReauth(ctx, u.databaseRepo, u.logger, calendarID, err)
 		return fmt.Errorf("fail to run transaction: %w", err)
 	}
 
@@ -121,6 +138,11 @@ func (u *watchUsecase) stopIfExistActiveChannel(
 	// いずれ有効期限が切れるものなので許容する
 	for _, channel := range channels {
 		if err := u.googleCalenderRepo.StopWatch(ctx, channel); err != nil {
+			// アクセス権が取り消されたチャネルは停止できないが、いずれ有効期限が切れるため停止済みとして扱う
+			if errors.Is(err, domain.CalendarNeedsReauthError) {
+				u.logger.Warnf(ctx, "fail to stop watch because calendar needs reauth (calendarID: %q)", calendarID)
+				continue
+			}
 			return fmt.Errorf("fail to stop watch: %w", err)
 		}
 	}
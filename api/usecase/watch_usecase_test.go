@@ -3,6 +3,8 @@ package usecase_test
 import (
 	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -10,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/takuoki/golib/applog"
 
+	"github.com/takuoki/google-calendar-sync/api/domain"
 	"github.com/takuoki/google-calendar-sync/api/domain/entity"
 	"github.com/takuoki/google-calendar-sync/api/domain/valueobject"
 	"github.com/takuoki/google-calendar-sync/api/repository"
@@ -64,7 +67,7 @@ func TestWatchUsecase_StartAll_Success(t *testing.T) {
 	}))
 
 	// When
-	err := watchUsecase.StartAll(ctx)
+	err := watchUsecase.StartAll(ctx, nil)
 	require.NoError(t, err)
 
 	// Then
@@ -76,6 +79,97 @@ func TestWatchUsecase_StartAll_Success(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestWatchUsecase_StartAll_SkipPaused(t *testing.T) {
+	// This test cannot be executed in parallel because it modifies shared state in mysqlRepo.
+
+	ctx := context.Background()
+	cleanup(ctx, t)
+
+	// Given
+	watchedCalendarIDs := []valueobject.CalendarID{}
+	mockRepo := &GoogleCalendarRepositoryMock{
+		WatchFunc: func(ctx context.Context, calendarID valueobject.CalendarID) (*entity.Channel, error) {
+			watchedCalendarIDs = append(watchedCalendarIDs, calendarID)
+			now := time.Now()
+			return &entity.Channel{
+				CalendarID: calendarID,
+				ResourceID: "resource-id",
+				StartTime:  now,
+				Expiration: now.Add(1 * time.Hour),
+				IsStopped:  false,
+			}, nil
+		},
+	}
+
+	watchUsecase, _ := setupWatchUsecase(mockRepo)
+
+	var activeCalendarID valueobject.CalendarID = "start-all-skip-paused-1"
+	var pausedCalendarID valueobject.CalendarID = "start-all-skip-paused-2"
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:   activeCalendarID,
+		Name: "Test Calendar 1",
+	}))
+
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:       pausedCalendarID,
+		Name:     "Test Calendar 2",
+		IsPaused: true,
+	}))
+
+	// When
+	err := watchUsecase.StartAll(ctx, nil)
+	require.NoError(t, err)
+
+	// Then
+	assert.Equal(t, []valueobject.CalendarID{activeCalendarID}, watchedCalendarIDs)
+}
+
+func TestWatchUsecase_StartAll_Tag(t *testing.T) {
+	// This test cannot be executed in parallel because it modifies shared state in mysqlRepo.
+
+	ctx := context.Background()
+	cleanup(ctx, t)
+
+	// Given
+	watchedCalendarIDs := []valueobject.CalendarID{}
+	mockRepo := &GoogleCalendarRepositoryMock{
+		WatchFunc: func(ctx context.Context, calendarID valueobject.CalendarID) (*entity.Channel, error) {
+			watchedCalendarIDs = append(watchedCalendarIDs, calendarID)
+			now := time.Now()
+			return &entity.Channel{
+				CalendarID: calendarID,
+				ResourceID: "resource-id",
+				StartTime:  now,
+				Expiration: now.Add(1 * time.Hour),
+				IsStopped:  false,
+			}, nil
+		},
+	}
+
+	watchUsecase, _ := setupWatchUsecase(mockRepo)
+
+	var taggedCalendarID valueobject.CalendarID = "start-all-tag-1"
+	var untaggedCalendarID valueobject.CalendarID = "start-all-tag-2"
+	tag := "team-a"
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:   taggedCalendarID,
+		Name: "Test Calendar 1",
+		Tags: []string{tag},
+	}))
+
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:   untaggedCalendarID,
+		Name: "Test Calendar 2",
+	}))
+
+	// When
+	err := watchUsecase.StartAll(ctx, &tag)
+	require.NoError(t, err)
+
+	// Then
+	assert.Equal(t, []valueobject.CalendarID{taggedCalendarID}, watchedCalendarIDs)
+}
+
 func TestWatchUsecase_Start_Success(t *testing.T) {
 	t.Parallel()
 
@@ -131,6 +225,47 @@ func TestWatchUsecase_Start_Success(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestWatchUsecase_Start_NeedsReauth(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Given
+	watchCount := 0
+	mockRepo := &GoogleCalendarRepositoryMock{
+		WatchFunc: func(ctx context.Context, calendarID valueobject.CalendarID) (*entity.Channel, error) {
+			watchCount++
+			return nil, fmt.Errorf("%w: invalid_grant", domain.CalendarNeedsReauthError)
+		},
+	}
+
+	watchUsecase, _ := setupWatchUsecase(mockRepo)
+
+	var calendarID valueobject.CalendarID = "start-needs-reauth-1"
+	require.NoError(t, mysqlRepo.CreateCalendar(ctx, t, entity.Calendar{
+		ID:   calendarID,
+		Name: "Test Calendar",
+	}))
+
+	// When
+	err := watchUsecase.Start(ctx, calendarID)
+
+	// Then
+	assert.True(t, errors.Is(err, domain.CalendarNeedsReauthError))
+
+	calendar, err := mysqlRepo.GetCalendar(ctx, calendarID)
+	require.NoError(t, err)
+	assert.NotNil(t, calendar.NeedsReauthAt)
+
+	// When
+	// The calendar is not watched until it is re-authorized
+	err = watchUsecase.Start(ctx, calendarID)
+
+	// Then
+	assert.True(t, errors.Is(err, domain.CalendarNeedsReauthError))
+	assert.Equal(t, 1, watchCount)
+}
+
 func TestWatchUsecase_Stop_Success(t *testing.T) {
 	t.Parallel()
 
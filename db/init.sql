@@ -1,11 +1,33 @@
+-- 全文検索の ngram トークンがストップワードを含むと索引されないため、索引の作成前に無効にする
+SET SESSION innodb_ft_enable_stopword = 0;
+
 CREATE TABLE IF NOT EXISTS calendars (
     id VARCHAR(255) PRIMARY KEY,
     name VARCHAR(100) NOT NULL,
-    refresh_token VARCHAR(255),
+    refresh_token VARCHAR(1024),
+    subject VARCHAR(255),
+    auth_type VARCHAR(32) NOT NULL DEFAULT 'service_account',
+    summary VARCHAR(255) NOT NULL DEFAULT '',
+    time_zone VARCHAR(64) NOT NULL DEFAULT '',
+    access_role VARCHAR(32) NOT NULL DEFAULT '',
+    is_paused BOOLEAN NOT NULL DEFAULT FALSE,
+    needs_reauth_at TIMESTAMP(3) NULL,
+    consecutive_sync_failure_count INT NOT NULL DEFAULT 0,
+    feed_token_hash CHAR(64) NULL,
     created_at TIMESTAMP(3) DEFAULT CURRENT_TIMESTAMP(3),
     updated_at TIMESTAMP(3) DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3)
 );
 
+CREATE TABLE IF NOT EXISTS calendar_tags (
+    calendar_id VARCHAR(255) NOT NULL,
+    tag VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP(3) DEFAULT CURRENT_TIMESTAMP(3),
+    updated_at TIMESTAMP(3) DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3),
+    PRIMARY KEY (calendar_id, tag),
+    FOREIGN KEY (calendar_id) REFERENCES calendars(id),
+    INDEX idx_tag (tag)
+);
+
 CREATE TABLE IF NOT EXISTS recurring_events (
     calendar_id VARCHAR(255) NOT NULL,
     id VARCHAR(255) NOT NULL,
@@ -13,6 +35,7 @@ CREATE TABLE IF NOT EXISTS recurring_events (
     recurrence VARCHAR(255) NOT NULL,
     start TIMESTAMP,
     end TIMESTAMP,
+    ical_uid VARCHAR(1024) NOT NULL DEFAULT '',
     status VARCHAR(255) NOT NULL,
     created_at TIMESTAMP(3) DEFAULT CURRENT_TIMESTAMP(3),
     updated_at TIMESTAMP(3) DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3),
@@ -25,14 +48,23 @@ CREATE TABLE IF NOT EXISTS events (
     id VARCHAR(255) NOT NULL,
     recurring_event_id VARCHAR(255),
     summary VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT (''),
+    location VARCHAR(1024) NOT NULL DEFAULT '',
     start TIMESTAMP,
     end TIMESTAMP,
+    original_start TIMESTAMP NULL,
+    transparency VARCHAR(32) NOT NULL DEFAULT 'opaque',
+    all_day BOOLEAN NOT NULL DEFAULT FALSE,
+    ical_uid VARCHAR(1024) NOT NULL DEFAULT '',
     status VARCHAR(255) NOT NULL,
     created_at TIMESTAMP(3) DEFAULT CURRENT_TIMESTAMP(3),
     updated_at TIMESTAMP(3) DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3),
     PRIMARY KEY (calendar_id, id),
     FOREIGN KEY (calendar_id) REFERENCES calendars(id),
-    FOREIGN KEY (calendar_id, recurring_event_id) REFERENCES recurring_events(calendar_id, id)
+    FOREIGN KEY (calendar_id, recurring_event_id) REFERENCES recurring_events(calendar_id, id),
+    INDEX idx_calendar_start (calendar_id, start, id),
+    INDEX idx_calendar_recurring_event (calendar_id, recurring_event_id),
+    FULLTEXT INDEX ft_summary_description_location (summary, description, location) WITH PARSER ngram
 );
 
 CREATE TABLE IF NOT EXISTS channel_histories (
@@ -68,3 +100,34 @@ CREATE TABLE IF NOT EXISTS sync_future_instance_histories (
     PRIMARY KEY (calendar_id, sync_time),
     FOREIGN KEY (calendar_id) REFERENCES calendars(id)
 );
+
+CREATE TABLE IF NOT EXISTS sync_failures (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    calendar_id VARCHAR(255) NOT NULL,
+    failure_time TIMESTAMP(3) NOT NULL,
+    stage VARCHAR(50) NOT NULL,
+    error_message TEXT NOT NULL,
+    created_at TIMESTAMP(3) DEFAULT CURRENT_TIMESTAMP(3),
+    updated_at TIMESTAMP(3) DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3),
+    FOREIGN KEY (calendar_id) REFERENCES calendars(id),
+    INDEX idx_calendar_failure_time (calendar_id, failure_time)
+);
+
+CREATE TABLE IF NOT EXISTS leases (
+    name VARCHAR(255) PRIMARY KEY,
+    holder VARCHAR(255) NOT NULL,
+    expiration TIMESTAMP(3) NOT NULL,
+    created_at TIMESTAMP(3) DEFAULT CURRENT_TIMESTAMP(3),
+    updated_at TIMESTAMP(3) DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3)
+);
+
+CREATE TABLE IF NOT EXISTS oauth_states (
+    state VARCHAR(64) PRIMARY KEY,
+    code_verifier VARCHAR(128) NOT NULL,
+    calendar_id VARCHAR(255) NOT NULL,
+    name VARCHAR(100),
+    expiration TIMESTAMP(3) NOT NULL,
+    created_at TIMESTAMP(3) DEFAULT CURRENT_TIMESTAMP(3),
+    updated_at TIMESTAMP(3) DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3),
+    INDEX idx_expiration (expiration)
+);